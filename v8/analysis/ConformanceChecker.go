@@ -0,0 +1,361 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package analysis
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	goa "go/ast"
+	imp "go/importer"
+	gop "go/parser"
+	tok "go/token"
+	typ "go/types"
+	osx "os"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ConformanceCheckerClass() ConformanceCheckerClassLike {
+	return conformanceCheckerClass()
+}
+
+// Constructor Methods
+
+func (c *conformanceCheckerClass_) ConformanceChecker() ConformanceCheckerLike {
+	var instance = &conformanceChecker_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *conformanceChecker_) GetClass() ConformanceCheckerClassLike {
+	return conformanceCheckerClass()
+}
+
+func (v *conformanceChecker_) CheckConformance(
+	directory string,
+) com.Sequential[string] {
+	directory = sts.TrimSuffix(directory, "/")
+	var source = uti.ReadFile(directory + "/package_api.go")
+	var model = gra.ParserClass().Parser().ParseSource(source)
+	v.package_ = v.loadPackage(directory)
+	v.violations_ = com.List[string]()
+	var declared = v.collectNames(model)
+
+	// Check each class interface against its concrete class.
+	var interfaces = model.GetInterfaceDeclarations()
+	var classes = interfaces.GetClassSection().GetClassDeclarations().GetIterator()
+	for classes.HasNext() {
+		var name = classes.GetNext().GetDeclaration().GetName()
+		var base = sts.TrimSuffix(name, "ClassLike")
+		declared.AddValue(base + "Class")
+		v.checkAccessFunction(base+"Class", name)
+		v.checkMethods(name, v.makePrivate(base)+"Class_")
+	}
+
+	// Check each instance interface against its concrete class.
+	var instances = interfaces.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instances.HasNext() {
+		var name = instances.GetNext().GetDeclaration().GetName()
+		var base = sts.TrimSuffix(name, "Like")
+		v.checkMethods(name, v.makePrivate(base)+"_")
+	}
+
+	// Check for exported symbols that are missing from the class model.
+	var scope = v.package_.Scope()
+	for _, name := range scope.Names() {
+		if scope.Lookup(name).Exported() && !declared.ContainsValue(name) {
+			v.reportViolation(
+				"The exported %s symbol is not declared by the class model.",
+				name,
+			)
+		}
+	}
+	return v.violations_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *conformanceChecker_) checkAccessFunction(
+	name string,
+	class string,
+) {
+	var function, ok = v.package_.Scope().Lookup(name).(*typ.Func)
+	if !ok {
+		v.reportViolation(
+			"The %s() access function for the %s interface is missing.",
+			name,
+			class,
+		)
+		return
+	}
+	var signature = function.Type().(*typ.Signature)
+	var results = signature.Results()
+	if signature.Params().Len() > 0 || results.Len() != 1 ||
+		v.getName(results.At(0).Type()) != class {
+		v.reportViolation(
+			"The %s() access function must take no arguments and return a %s.",
+			name,
+			class,
+		)
+	}
+}
+
+func (v *conformanceChecker_) checkMethods(
+	abstract string,
+	concrete string,
+) {
+	var scope = v.package_.Scope()
+	var declaration, ok = scope.Lookup(abstract).Type().(*typ.Named)
+	if !ok {
+		return
+	}
+	var object = scope.Lookup(concrete)
+	if object == nil {
+		v.reportViolation(
+			"The %s type implementing the %s interface is missing.",
+			concrete,
+			abstract,
+		)
+		return
+	}
+
+	// Instantiate any generic concrete type using the interface type parameters.
+	var receiver = object.Type()
+	var parameters = declaration.TypeParams()
+	if parameters.Len() > 0 {
+		var arguments []typ.Type
+		for index := 0; index < parameters.Len(); index++ {
+			arguments = append(arguments, parameters.At(index))
+		}
+		var instance, err = typ.Instantiate(nil, receiver, arguments, true)
+		if err != nil {
+			v.reportViolation(
+				"The %s type cannot be instantiated like the %s interface: %v",
+				concrete,
+				abstract,
+				err,
+			)
+			return
+		}
+		receiver = instance
+	}
+
+	// Check that each declared method is implemented.
+	var methods = typ.NewMethodSet(typ.NewPointer(receiver))
+	var intrinsic = declaration.Underlying().(*typ.Interface)
+	for index := 0; index < intrinsic.NumMethods(); index++ {
+		var method = intrinsic.Method(index)
+		var selection = methods.Lookup(v.package_, method.Name())
+		switch {
+		case selection == nil:
+			v.reportViolation(
+				"The %s.%s() method is not implemented by the %s type.",
+				abstract,
+				method.Name(),
+				concrete,
+			)
+		case !typ.Identical(selection.Type(), method.Type()):
+			v.reportViolation(
+				"The %s.%s() method has a mismatched signature: expected %s but found %s",
+				concrete,
+				method.Name(),
+				v.formatSignature(method.Type()),
+				v.formatSignature(selection.Type()),
+			)
+		}
+	}
+
+	// Check for exported methods that are not declared by the interface.
+	for index := 0; index < methods.Len(); index++ {
+		var method = methods.At(index).Obj()
+		var declared, _, _ = typ.LookupFieldOrMethod(
+			intrinsic,
+			false,
+			v.package_,
+			method.Name(),
+		)
+		if method.Exported() && declared == nil {
+			v.reportViolation(
+				"The exported %s.%s() method is not declared by the %s interface.",
+				concrete,
+				method.Name(),
+				abstract,
+			)
+		}
+	}
+}
+
+func (v *conformanceChecker_) collectNames(
+	model ast.ModelLike,
+) com.SetLike[string] {
+	var names = com.Set[string]()
+	var primitives = model.GetPrimitiveDeclarations()
+	var types = primitives.GetTypeSection().GetTypeDeclarations().GetIterator()
+	for types.HasNext() {
+		var declaration = types.GetNext()
+		names.AddValue(declaration.GetDeclaration().GetName())
+		var enumeration = declaration.GetOptionalEnumeration()
+		if uti.IsUndefined(enumeration) {
+			continue
+		}
+		names.AddValue(enumeration.GetValue().GetName())
+		var values = enumeration.GetAdditionalValues().GetIterator()
+		for values.HasNext() {
+			names.AddValue(values.GetNext().GetName())
+		}
+	}
+	var functionals = primitives.GetFunctionalSection().GetFunctionalDeclarations().GetIterator()
+	for functionals.HasNext() {
+		names.AddValue(functionals.GetNext().GetDeclaration().GetName())
+	}
+	var interfaces = model.GetInterfaceDeclarations()
+	var classes = interfaces.GetClassSection().GetClassDeclarations().GetIterator()
+	for classes.HasNext() {
+		names.AddValue(classes.GetNext().GetDeclaration().GetName())
+	}
+	var instances = interfaces.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instances.HasNext() {
+		names.AddValue(instances.GetNext().GetDeclaration().GetName())
+	}
+	var aspects = interfaces.GetAspectSection().GetAspectDeclarations().GetIterator()
+	for aspects.HasNext() {
+		names.AddValue(aspects.GetNext().GetDeclaration().GetName())
+	}
+	return names
+}
+
+func (v *conformanceChecker_) formatSignature(
+	signature typ.Type,
+) string {
+	var qualifier = typ.RelativeTo(v.package_)
+	return sts.TrimPrefix(typ.TypeString(signature, qualifier), "func")
+}
+
+func (v *conformanceChecker_) getName(
+	type_ typ.Type,
+) string {
+	var named, ok = type_.(*typ.Named)
+	if !ok {
+		return ""
+	}
+	return named.Obj().Name()
+}
+
+func (v *conformanceChecker_) loadPackage(
+	directory string,
+) *typ.Package {
+	var entries, err = osx.ReadDir(directory)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The %q directory could not be read: %v",
+			directory,
+			err,
+		)
+		panic(message)
+	}
+	var files []*goa.File
+	var fileSet = tok.NewFileSet()
+	for _, entry := range entries {
+		var filename = entry.Name()
+		if entry.IsDir() || !sts.HasSuffix(filename, ".go") ||
+			sts.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		var file, err = gop.ParseFile(fileSet, directory+"/"+filename, nil, 0)
+		if err != nil {
+			var message = fmt.Sprintf(
+				"The %q file could not be parsed: %v",
+				filename,
+				err,
+			)
+			panic(message)
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		var message = fmt.Sprintf(
+			"The %q directory does not contain any Go source files.",
+			directory,
+		)
+		panic(message)
+	}
+	var configuration = typ.Config{
+		// Type errors are expected when the concrete classes do not conform so
+		// they are ignored and the violations are reported by the checker.
+		Error:    func(err error) {},
+		Importer: imp.ForCompiler(fileSet, "source", nil),
+	}
+	var name = files[0].Name.Name
+	var package_, _ = configuration.Check(name, fileSet, files, nil)
+	return package_
+}
+
+func (v *conformanceChecker_) makePrivate(
+	name string,
+) string {
+	var runes = []rune(name)
+	return sts.ToLower(string(runes[0])) + string(runes[1:])
+}
+
+func (v *conformanceChecker_) reportViolation(
+	format string,
+	arguments ...any,
+) {
+	v.violations_.AppendValue(fmt.Sprintf(format, arguments...))
+}
+
+// Instance Structure
+
+type conformanceChecker_ struct {
+	// Declare the instance attributes.
+	package_    *typ.Package
+	violations_ com.ListLike[string]
+}
+
+// Class Structure
+
+type conformanceCheckerClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func conformanceCheckerClass() *conformanceCheckerClass_ {
+	return conformanceCheckerClassReference_
+}
+
+var conformanceCheckerClassReference_ = &conformanceCheckerClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,1061 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package analysis
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	goa "go/ast"
+	bld "go/build"
+	gop "go/parser"
+	tok "go/token"
+	typ "go/types"
+	osx "os"
+	reg "regexp"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ImporterClass() ImporterClassLike {
+	return importerClass()
+}
+
+// Constructor Methods
+
+func (c *importerClass_) Importer() ImporterLike {
+	var instance = &importer_{
+		// Initialize the instance attributes.
+		unmapped_: com.List[string](),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *importer_) GetClass() ImporterClassLike {
+	return importerClass()
+}
+
+func (v *importer_) ImportPackage(
+	directory string,
+) ast.ModelLike {
+	// Reset any state left over from a previous import.
+	v.unmapped_ = com.List[string]()
+	v.aliases_ = com.Catalog[string, string]()
+	v.renamed_ = com.Catalog[string, string]()
+	v.synthesized_ = com.Set[string]()
+	v.constraints_ = com.Catalog[string, string]()
+	v.arguments_ = com.Catalog[string, string]()
+	v.constructors_ = com.Catalog[string, string]()
+	v.enumerations_ = com.Catalog[string, string]()
+	v.types_ = com.Catalog[string, string]()
+	v.functionals_ = com.Catalog[string, string]()
+	v.classes_ = com.Catalog[string, string]()
+	v.instances_ = com.Catalog[string, string]()
+	v.aspects_ = com.Catalog[string, string]()
+
+	// Map each exported declaration onto its class model equivalent.
+	var files = v.parseFiles(directory)
+	v.classifyInterfaces(files)
+	var notice, header string
+	for _, file := range files {
+		if len(notice) == 0 {
+			notice = v.extractNotice(file)
+		}
+		if len(header) == 0 && file.Doc != nil {
+			header = sts.TrimSpace(file.Doc.Text())
+		}
+		v.packages_ = v.collectPackages(file)
+		for _, declaration := range file.Decls {
+			switch actual := declaration.(type) {
+			case *goa.GenDecl:
+				v.importDeclaration(actual)
+			case *goa.FuncDecl:
+				v.importFunction(actual)
+			}
+		}
+	}
+	var name = files[0].Name.Name
+	if len(notice) == 0 {
+		notice = "This package does not contain a legal notice."
+	}
+	if len(header) == 0 {
+		header = fmt.Sprintf("Package %q has not been documented.", name)
+	}
+	var source = v.formatModel(notice, header, name)
+	if len(source) == 0 {
+		return nil
+	}
+	return gra.ParserClass().Parser().ParseSource(source)
+}
+
+// Attribute Methods
+
+func (v *importer_) GetUnmapped() com.Sequential[string] {
+	return v.unmapped_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *importer_) attemptMapping(
+	name string,
+	mapping func(),
+) {
+	defer func() {
+		var exception = recover()
+		if exception != nil {
+			v.reportUnmapped(name, fmt.Sprintf("%v", exception))
+		}
+	}()
+	mapping()
+}
+
+func (v *importer_) classifyInterfaces(
+	files []*goa.File,
+) {
+	// Collect the exported interfaces and the interfaces embedded within them.
+	var interfaces = com.Set[string]()
+	var embedded = com.Set[string]()
+	var conventional bool
+	for _, file := range files {
+		for _, declaration := range file.Decls {
+			var generic, ok = declaration.(*goa.GenDecl)
+			if !ok || generic.Tok != tok.TYPE {
+				continue
+			}
+			for _, specification := range generic.Specs {
+				var spec = specification.(*goa.TypeSpec)
+				var body, ok = spec.Type.(*goa.InterfaceType)
+				if !ok || !spec.Name.IsExported() {
+					continue
+				}
+				var name = spec.Name.Name
+				interfaces.AddValue(name)
+				conventional = conventional || sts.HasSuffix(name, "Like")
+				for _, field := range body.Methods.List {
+					if len(field.Names) == 0 {
+						embedded.AddValue(v.extractName(field.Type))
+					}
+				}
+			}
+		}
+	}
+
+	// Determine which instance interfaces require a synthesized class interface.
+	var iterator = interfaces.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext()
+		switch {
+		case sts.HasSuffix(name, "ClassLike"):
+			// This is already a class interface.
+		case sts.HasSuffix(name, "Like"):
+			var base = sts.TrimSuffix(name, "Like")
+			if !interfaces.ContainsValue(base + "ClassLike") {
+				v.synthesized_.AddValue(base)
+			}
+		case !conventional && !embedded.ContainsValue(name):
+			// A plain Go interface is treated as the instance interface of a class.
+			v.renamed_.SetValue(name, name+"Like")
+			v.synthesized_.AddValue(name)
+		}
+	}
+}
+
+func (v *importer_) collectPackages(
+	file *goa.File,
+) com.CatalogLike[string, string] {
+	var packages = com.Catalog[string, string]()
+	for _, specification := range file.Imports {
+		var path = sts.Trim(specification.Path.Value, "\"`")
+		var name = v.extractPackage(path)
+		if specification.Name != nil {
+			name = specification.Name.Name
+		}
+		packages.SetValue(name, path)
+	}
+	return packages
+}
+
+func (v *importer_) extractName(
+	expression goa.Expr,
+) string {
+	switch actual := expression.(type) {
+	case *goa.Ident:
+		return actual.Name
+	case *goa.IndexExpr:
+		return v.extractName(actual.X)
+	case *goa.IndexListExpr:
+		return v.extractName(actual.X)
+	default:
+		return typ.ExprString(expression)
+	}
+}
+
+func (v *importer_) extractNotice(
+	file *goa.File,
+) string {
+	// The legal notice is any comment preceding the package documentation.
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package || group == file.Doc {
+			break
+		}
+		return sts.TrimSpace(group.Text())
+	}
+	return ""
+}
+
+func (v *importer_) extractPackage(
+	path string,
+) string {
+	// Guess the package name from its import path.
+	var elements = sts.Split(path, "/")
+	var name = elements[len(elements)-1]
+	if len(elements) > 1 && importerClass().versions_.MatchString(name) {
+		name = elements[len(elements)-2]
+	}
+	name = sts.TrimPrefix(name, "go-")
+	return sts.ReplaceAll(name, "-", "")
+}
+
+func (v *importer_) formatAbstraction(
+	expression goa.Expr,
+	indentation string,
+) string {
+	switch actual := expression.(type) {
+	case *goa.Ellipsis:
+		return "..." + v.formatType(actual.Elt, indentation)
+	case *goa.StarExpr:
+		return "*" + v.formatType(actual.X, indentation)
+	case *goa.ArrayType:
+		if actual.Len != nil {
+			panic("Fixed length arrays cannot be modeled.")
+		}
+		return "[]" + v.formatType(actual.Elt, indentation)
+	case *goa.ChanType:
+		if actual.Dir != goa.SEND|goa.RECV {
+			panic("Directional channels cannot be modeled.")
+		}
+		return "chan " + v.formatType(actual.Value, indentation)
+	case *goa.MapType:
+		var key, ok = actual.Key.(*goa.Ident)
+		if !ok {
+			panic("Map keys must be simple type names to be modeled.")
+		}
+		return "map[" + v.formatName(key.Name) + "]" +
+			v.formatType(actual.Value, indentation)
+	default:
+		return v.formatType(expression, indentation)
+	}
+}
+
+func (v *importer_) formatArguments(
+	parameters *goa.FieldList,
+) string {
+	if parameters == nil {
+		return ""
+	}
+	var names []string
+	for _, field := range parameters.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return "[" + sts.Join(names, ", ") + "]"
+}
+
+func (v *importer_) formatClass(
+	name string,
+	constraints string,
+	body *goa.InterfaceType,
+	comment string,
+) string {
+	var instance = sts.TrimSuffix(name, "ClassLike") + "Like"
+	var constructors, constants, functions string
+	for _, field := range body.Methods.List {
+		if len(field.Names) == 0 {
+			v.reportUnmapped(
+				name+"."+typ.ExprString(field.Type),
+				"Class interfaces cannot embed other interfaces.",
+			)
+			continue
+		}
+		var method = field.Names[0].Name
+		var function = field.Type.(*goa.FuncType)
+		v.attemptMapping(name+"."+method, func() {
+			var formatted = v.formatMethod(method, function)
+			switch {
+			case sts.HasPrefix(v.formatResult(function.Results, ""), " "+instance):
+				constructors += formatted
+			case function.Params.NumFields() == 0 && v.isAbstraction(function.Results):
+				constants += formatted
+			default:
+				functions += formatted
+			}
+		})
+	}
+	if len(constructors) == 0 {
+		panic("Class interfaces must declare at least one constructor method.")
+	}
+	var methods = "\t// Constructor Methods\n" + constructors
+	if len(constants) > 0 {
+		methods += "\n\t// Constant Methods\n" + constants
+	}
+	if len(functions) > 0 {
+		methods += "\n\t// Function Methods\n" + functions
+	}
+	return v.formatComment(comment) + "type " + name + constraints +
+		" interface {\n" + methods + "}\n"
+}
+
+func (v *importer_) formatComment(
+	text string,
+) string {
+	text = sts.ReplaceAll(text, "*/", "* /")
+	return "/*\n" + text + "\n*/\n"
+}
+
+func (v *importer_) formatConstraints(
+	parameters *goa.FieldList,
+) string {
+	if parameters == nil {
+		return ""
+	}
+	var constraints []string
+	for _, field := range parameters.List {
+		var abstraction = v.formatAbstraction(field.Type, "")
+		for _, name := range field.Names {
+			constraints = append(
+				constraints,
+				v.formatName(name.Name)+" "+abstraction,
+			)
+		}
+	}
+	return "[" + sts.Join(constraints, ", ") + "]"
+}
+
+func (v *importer_) formatDeclarations(
+	declarations com.CatalogLike[string, string],
+) string {
+	var result string
+	declarations.SortValues()
+	var iterator = declarations.GetIterator()
+	for iterator.HasNext() {
+		result += "\n" + iterator.GetNext().GetValue()
+	}
+	return result
+}
+
+func (v *importer_) formatDocumentation(
+	documentation *goa.CommentGroup,
+	name string,
+	renamed string,
+) string {
+	if documentation == nil {
+		return renamed + " has not been documented."
+	}
+	var text = sts.TrimSpace(documentation.Text())
+	if name != renamed && sts.HasPrefix(text, name) {
+		text = renamed + sts.TrimPrefix(text, name)
+	}
+	return text
+}
+
+func (v *importer_) formatExpression(
+	expression goa.Expr,
+) string {
+	var binary, ok = expression.(*goa.BinaryExpr)
+	if !ok {
+		return v.formatOperand(expression)
+	}
+	switch binary.Op {
+	case tok.SHL, tok.ADD, tok.SUB:
+		return v.formatOperand(binary.X) + " " + binary.Op.String() + " " +
+			v.formatOperand(binary.Y)
+	default:
+		var message = fmt.Sprintf(
+			"The %q operator cannot be modeled.",
+			binary.Op.String(),
+		)
+		panic(message)
+	}
+}
+
+func (v *importer_) formatFunctional(
+	function *goa.FuncType,
+	indentation string,
+) string {
+	var functional = "func("
+	var parameters = v.formatParameters(function.Params, "argument", indentation+"\t")
+	if len(parameters) > 0 {
+		functional += "\n" + parameters + indentation
+	}
+	return functional + ")" + v.formatResult(function.Results, indentation)
+}
+
+func (v *importer_) formatInstance(
+	name string,
+	constraints string,
+	arguments string,
+	body *goa.InterfaceType,
+	comment string,
+) string {
+	var class = sts.TrimSuffix(name, "Like") + "ClassLike"
+	var principal = "\tGetClass() " + class + arguments + "\n"
+	var attributes, aspects string
+	for _, field := range body.Methods.List {
+		if len(field.Names) == 0 {
+			v.attemptMapping(name+"."+typ.ExprString(field.Type), func() {
+				aspects += "\t" + v.formatAbstraction(field.Type, "\t") + "\n"
+			})
+			continue
+		}
+		var method = field.Names[0].Name
+		var function = field.Type.(*goa.FuncType)
+		if method == "GetClass" {
+			continue
+		}
+		v.attemptMapping(name+"."+method, func() {
+			var formatted = v.formatMethod(method, function)
+			var parameters = function.Params.NumFields()
+			var results = function.Results.NumFields()
+			switch {
+			case sts.HasPrefix(method, "Get") && parameters == 0 &&
+				v.isAbstraction(function.Results):
+				attributes += formatted
+			case sts.HasPrefix(method, "Set") && parameters == 1 && results == 0:
+				attributes += formatted
+			default:
+				principal += formatted
+			}
+		})
+	}
+	var methods = "\t// Principal Methods\n" + principal
+	if len(attributes) > 0 {
+		methods += "\n\t// Attribute Methods\n" + attributes
+	}
+	if len(aspects) > 0 {
+		methods += "\n\t// Aspect Interfaces\n" + aspects
+	}
+	return v.formatComment(comment) + "type " + name + constraints +
+		" interface {\n" + methods + "}\n"
+}
+
+func (v *importer_) formatMethod(
+	name string,
+	function *goa.FuncType,
+) string {
+	return "\t" + v.formatName(name) +
+		sts.TrimPrefix(v.formatFunctional(function, "\t"), "func") + "\n"
+}
+
+func (v *importer_) formatModel(
+	notice string,
+	header string,
+	name string,
+) string {
+	// Synthesize the class interfaces that are missing from the package.
+	var synthesized = v.synthesized_.GetIterator()
+	for synthesized.HasNext() {
+		var base = synthesized.GetNext()
+		var name = base + "ClassLike"
+		var constructors = v.constructors_.GetValue(base)
+		if len(constructors) == 0 {
+			v.reportUnmapped(
+				name,
+				"No constructor functions were found so a default one was assumed.",
+			)
+			constructors = "\t" + base + "() " + base + "Like" +
+				v.arguments_.GetValue(base) + "\n"
+		}
+		var comment = fmt.Sprintf(
+			"%s is a class interface that declares the complete set of "+
+				"class constructors, constants and functions that must be "+
+				"supported by each concrete %s-like class.",
+			name,
+			v.makeKebabCase(base),
+		)
+		v.classes_.SetValue(
+			name,
+			v.formatComment(v.wrapText(comment))+"type "+name+
+				v.constraints_.GetValue(base)+" interface {\n"+
+				"\t// Constructor Methods\n"+constructors+"}\n",
+		)
+	}
+
+	// Attach each enumeration to the type declaration it belongs to.
+	var enumerations = v.enumerations_.GetIterator()
+	for enumerations.HasNext() {
+		var association = enumerations.GetNext()
+		var name = association.GetKey()
+		var declaration = v.types_.GetValue(name)
+		if len(declaration) == 0 {
+			v.reportUnmapped(
+				name,
+				"Constants must be grouped under a declared type to be modeled.",
+			)
+			continue
+		}
+		v.types_.SetValue(name, declaration+"\n"+association.GetValue())
+	}
+	if v.classes_.IsEmpty() || v.instances_.IsEmpty() {
+		// A class model must declare at least one class.
+		v.reportUnmapped(
+			name,
+			"The package does not declare any interfaces that can be modeled as classes.",
+		)
+		return ""
+	}
+
+	// Assemble the class model source.
+	var imports string
+	var paths = v.aliases_.GetIterator()
+	for paths.HasNext() {
+		var association = paths.GetNext()
+		imports += fmt.Sprintf(
+			"\t%s %q\n",
+			association.GetValue(),
+			association.GetKey(),
+		)
+	}
+	return v.formatComment(notice) + "\n" +
+		v.formatComment(header) + "package " + name + "\n\n" +
+		"import (\n" + imports + ")\n\n" +
+		"// TYPE DECLARATIONS\n" + v.formatDeclarations(v.types_) + "\n" +
+		"// FUNCTIONAL DECLARATIONS\n" + v.formatDeclarations(v.functionals_) + "\n" +
+		"// CLASS DECLARATIONS\n" + v.formatDeclarations(v.classes_) + "\n" +
+		"// INSTANCE DECLARATIONS\n" + v.formatDeclarations(v.instances_) + "\n" +
+		"// ASPECT DECLARATIONS\n" + v.formatDeclarations(v.aspects_)
+}
+
+func (v *importer_) formatName(
+	name string,
+) string {
+	if !importerClass().names_.MatchString(name) {
+		var message = fmt.Sprintf(
+			"The %q identifier is not a valid class model name.",
+			name,
+		)
+		panic(message)
+	}
+	return name
+}
+
+func (v *importer_) formatOperand(
+	expression goa.Expr,
+) string {
+	switch actual := expression.(type) {
+	case *goa.Ident:
+		if actual.Name == "iota" {
+			return actual.Name
+		}
+	case *goa.BasicLit:
+		if importerClass().numbers_.MatchString(actual.Value) ||
+			sts.HasPrefix(actual.Value, "\"") {
+			return actual.Value
+		}
+	}
+	var message = fmt.Sprintf(
+		"The %q constant expression cannot be modeled.",
+		typ.ExprString(expression),
+	)
+	panic(message)
+}
+
+func (v *importer_) formatParameters(
+	parameters *goa.FieldList,
+	prefix string,
+	indentation string,
+) string {
+	var result string
+	if parameters == nil {
+		return result
+	}
+	var count = parameters.NumFields()
+	var index int
+	for _, field := range parameters.List {
+		var abstraction = v.formatAbstraction(field.Type, indentation)
+		var names []string
+		for _, name := range field.Names {
+			names = append(names, v.formatName(name.Name))
+		}
+		if len(names) == 0 {
+			// Unnamed parameters are given synthesized names.
+			var name = prefix
+			if count > 1 {
+				name += fmt.Sprintf("%d", index+1)
+			}
+			names = append(names, name)
+		}
+		for _, name := range names {
+			result += indentation + name + " " + abstraction + ",\n"
+			index++
+		}
+	}
+	return result
+}
+
+func (v *importer_) formatPrefix(
+	name string,
+) string {
+	var path = v.packages_.GetValue(name)
+	if len(path) == 0 {
+		var message = fmt.Sprintf(
+			"The %q package has not been imported.",
+			name,
+		)
+		panic(message)
+	}
+	var alias = v.aliases_.GetValue(path)
+	if len(alias) > 0 {
+		return alias
+	}
+
+	// Class model prefixes must contain exactly three characters.
+	var used = com.Set[string]()
+	used.AddValues(v.aliases_.GetValues(v.aliases_.GetKeys()))
+	alias = name
+	if !importerClass().prefixes_.MatchString(alias) {
+		alias = sts.ToLower(alias + "xx")[:3]
+	}
+	for count := 2; used.ContainsValue(alias); count++ {
+		alias = fmt.Sprintf("%s%d", alias[:2], count)
+	}
+	v.aliases_.SetValue(path, alias)
+	v.aliases_.SortValues()
+	return alias
+}
+
+func (v *importer_) formatResult(
+	results *goa.FieldList,
+	indentation string,
+) string {
+	switch {
+	case results.NumFields() == 0:
+		return ""
+	case v.isAbstraction(results):
+		return " " + v.formatAbstraction(results.List[0].Type, indentation)
+	default:
+		return " (\n" +
+			v.formatParameters(results, "result", indentation+"\t") +
+			indentation + ")"
+	}
+}
+
+func (v *importer_) formatType(
+	expression goa.Expr,
+	indentation string,
+) string {
+	switch actual := expression.(type) {
+	case *goa.Ident:
+		var renamed = v.renamed_.GetValue(actual.Name)
+		if len(renamed) > 0 {
+			return renamed
+		}
+		return v.formatName(actual.Name)
+	case *goa.SelectorExpr:
+		var name, ok = actual.X.(*goa.Ident)
+		if !ok {
+			break
+		}
+		return v.formatPrefix(name.Name) + "." + v.formatName(actual.Sel.Name)
+	case *goa.IndexExpr:
+		return v.formatType(actual.X, indentation) + "[" +
+			v.formatAbstraction(actual.Index, indentation) + "]"
+	case *goa.IndexListExpr:
+		var arguments []string
+		for _, index := range actual.Indices {
+			arguments = append(arguments, v.formatAbstraction(index, indentation))
+		}
+		return v.formatType(actual.X, indentation) + "[" +
+			sts.Join(arguments, ", ") + "]"
+	case *goa.FuncType:
+		return v.formatFunctional(actual, indentation)
+	case *goa.InterfaceType:
+		if actual.Methods.NumFields() == 0 {
+			return "any"
+		}
+	case *goa.ParenExpr:
+		return v.formatType(actual.X, indentation)
+	}
+	var message = fmt.Sprintf(
+		"The %q type cannot be modeled.",
+		typ.ExprString(expression),
+	)
+	panic(message)
+}
+
+func (v *importer_) importConstants(
+	declaration *goa.GenDecl,
+) {
+	var first = declaration.Specs[0].(*goa.ValueSpec)
+	var name, ok = first.Type.(*goa.Ident)
+	if !ok || len(first.Values) != 1 {
+		for _, specification := range declaration.Specs {
+			for _, name := range specification.(*goa.ValueSpec).Names {
+				if name.IsExported() {
+					v.reportUnmapped(
+						name.Name,
+						"Constants must be grouped under a declared type to be modeled.",
+					)
+				}
+			}
+		}
+		return
+	}
+	v.attemptMapping(name.Name, func() {
+		var enumeration = "const (\n"
+		for index, specification := range declaration.Specs {
+			var value = specification.(*goa.ValueSpec)
+			if len(value.Names) != 1 {
+				panic("Each constant must be declared separately to be modeled.")
+			}
+			var constant = "\t" + v.formatName(value.Names[0].Name)
+			var explicit, ok = value.Type.(*goa.Ident)
+			switch {
+			case len(value.Values) == 1 && (value.Type == nil || ok && explicit.Name == name.Name):
+				constant += " " + name.Name + " = " + v.formatExpression(value.Values[0])
+			case index > 0 && value.Type == nil && len(value.Values) == 0:
+				// The type and expression of the previous value is repeated.
+			default:
+				panic("Each constant must be of the same declared type to be modeled.")
+			}
+			enumeration += constant + "\n"
+		}
+		if len(v.enumerations_.GetValue(name.Name)) > 0 {
+			panic("Only one group of constants can be modeled for each type.")
+		}
+		v.enumerations_.SetValue(name.Name, enumeration+")\n")
+	})
+}
+
+func (v *importer_) importDeclaration(
+	declaration *goa.GenDecl,
+) {
+	switch declaration.Tok {
+	case tok.CONST:
+		v.importConstants(declaration)
+	case tok.VAR:
+		for _, specification := range declaration.Specs {
+			for _, name := range specification.(*goa.ValueSpec).Names {
+				if name.IsExported() {
+					v.reportUnmapped(name.Name, "Variables cannot be modeled.")
+				}
+			}
+		}
+	case tok.TYPE:
+		for _, specification := range declaration.Specs {
+			var spec = specification.(*goa.TypeSpec)
+			if !spec.Name.IsExported() {
+				continue
+			}
+			var documentation = spec.Doc
+			if documentation == nil && len(declaration.Specs) == 1 {
+				documentation = declaration.Doc
+			}
+			v.attemptMapping(spec.Name.Name, func() {
+				v.importType(spec, documentation)
+			})
+		}
+	}
+}
+
+func (v *importer_) importFunction(
+	declaration *goa.FuncDecl,
+) {
+	var name = declaration.Name.Name
+	if declaration.Recv != nil || !declaration.Name.IsExported() {
+		// Only package level functions are part of the package interface.
+		return
+	}
+	v.attemptMapping(name, func() {
+		var function = declaration.Type
+		if !v.isAbstraction(function.Results) {
+			panic("Functions that are not constructors cannot be modeled.")
+		}
+		var result, _, _ = sts.Cut(v.formatAbstraction(function.Results.List[0].Type, ""), "[")
+		if sts.HasSuffix(result, "ClassLike") {
+			// This is the access function for a class.
+			return
+		}
+		var base = sts.TrimSuffix(result, "Like")
+		if !v.synthesized_.ContainsValue(base) {
+			panic("Functions that are not constructors cannot be modeled.")
+		}
+		var constructor = sts.TrimPrefix(name, "New")
+		if len(constructor) == 0 || !uni.IsUpper([]rune(constructor)[0]) {
+			constructor = base + constructor
+		}
+		var method = v.formatMethod(constructor, function)
+		v.constructors_.SetValue(base, v.constructors_.GetValue(base)+method)
+	})
+}
+
+func (v *importer_) importInterface(
+	name string,
+	parameters *goa.FieldList,
+	body *goa.InterfaceType,
+	documentation *goa.CommentGroup,
+) {
+	var constraints = v.formatConstraints(parameters)
+	var arguments = v.formatArguments(parameters)
+	var renamed = v.formatType(&goa.Ident{Name: name}, "")
+	var comment = v.formatDocumentation(documentation, name, renamed)
+	switch {
+	case sts.HasSuffix(renamed, "ClassLike"):
+		v.classes_.SetValue(
+			renamed,
+			v.formatClass(renamed, constraints, body, comment),
+		)
+	case sts.HasSuffix(renamed, "Like"):
+		var base = sts.TrimSuffix(renamed, "Like")
+		if len(constraints) > 0 {
+			// Catalogs cannot contain empty values.
+			v.constraints_.SetValue(base, constraints)
+			v.arguments_.SetValue(base, arguments)
+		}
+		v.instances_.SetValue(
+			renamed,
+			v.formatInstance(renamed, constraints, arguments, body, comment),
+		)
+	default:
+		var members string
+		for _, field := range body.Methods.List {
+			if len(field.Names) == 0 {
+				v.attemptMapping(name+"."+typ.ExprString(field.Type), func() {
+					members += "\t" + v.formatAbstraction(field.Type, "\t") + "\n"
+				})
+				continue
+			}
+			var method = field.Names[0].Name
+			v.attemptMapping(name+"."+method, func() {
+				members += v.formatMethod(method, field.Type.(*goa.FuncType))
+			})
+		}
+		if len(members) == 0 {
+			panic("Aspect interfaces must declare at least one method to be modeled.")
+		}
+		v.aspects_.SetValue(
+			name,
+			v.formatComment(comment)+"type "+name+constraints+
+				" interface {\n"+members+"}\n",
+		)
+	}
+}
+
+func (v *importer_) importType(
+	specification *goa.TypeSpec,
+	documentation *goa.CommentGroup,
+) {
+	var name = specification.Name.Name
+	if specification.Assign.IsValid() {
+		panic("Type aliases cannot be modeled.")
+	}
+	var constraints = v.formatConstraints(specification.TypeParams)
+	var comment = v.formatComment(v.formatDocumentation(documentation, name, name))
+	switch actual := specification.Type.(type) {
+	case *goa.InterfaceType:
+		v.importInterface(name, specification.TypeParams, actual, documentation)
+	case *goa.StructType:
+		panic("Structured types cannot be modeled.")
+	case *goa.FuncType:
+		v.functionals_.SetValue(
+			name,
+			comment+"type "+v.formatName(name)+constraints+" "+
+				v.formatFunctional(actual, "")+"\n",
+		)
+	default:
+		v.types_.SetValue(
+			name,
+			comment+"type "+v.formatName(name)+constraints+" "+
+				v.formatAbstraction(actual, "")+"\n",
+		)
+	}
+}
+
+func (v *importer_) isAbstraction(
+	results *goa.FieldList,
+) bool {
+	return results.NumFields() == 1 && len(results.List[0].Names) == 0
+}
+
+func (v *importer_) makeKebabCase(
+	name string,
+) string {
+	var result []rune
+	for index, character := range name {
+		if uni.IsUpper(character) {
+			if index > 0 {
+				result = append(result, '-')
+			}
+			character = uni.ToLower(character)
+		}
+		result = append(result, character)
+	}
+	return string(result)
+}
+
+func (v *importer_) parseFiles(
+	directory string,
+) []*goa.File {
+	var entries, err = osx.ReadDir(directory)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The %q directory could not be read: %v",
+			directory,
+			err,
+		)
+		panic(message)
+	}
+	var files []*goa.File
+	var fileSet = tok.NewFileSet()
+	for _, entry := range entries {
+		var filename = entry.Name()
+		if entry.IsDir() || !sts.HasSuffix(filename, ".go") ||
+			sts.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		// Ignore any files that are excluded by their build constraints.
+		var matched, err = bld.Default.MatchFile(directory, filename)
+		if err != nil {
+			var message = fmt.Sprintf(
+				"The build constraints of the %q file could not be read: %v",
+				filename,
+				err,
+			)
+			panic(message)
+		}
+		if !matched {
+			continue
+		}
+		file, err := gop.ParseFile(
+			fileSet,
+			sts.TrimSuffix(directory, "/")+"/"+filename,
+			nil,
+			gop.ParseComments,
+		)
+		if err != nil {
+			var message = fmt.Sprintf(
+				"The %q file could not be parsed: %v",
+				filename,
+				err,
+			)
+			panic(message)
+		}
+		var packageName = file.Name.Name
+		if packageName == "main" || sts.HasSuffix(packageName, "_test") {
+			// Ignore any commands and external test packages.
+			continue
+		}
+		if len(files) > 0 && packageName != files[0].Name.Name {
+			// Ignore any files belonging to a different package.
+			continue
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		var message = fmt.Sprintf(
+			"The %q directory does not contain any Go source files.",
+			directory,
+		)
+		panic(message)
+	}
+	return files
+}
+
+func (v *importer_) reportUnmapped(
+	name string,
+	reason string,
+) {
+	v.unmapped_.AppendValue(name + ": " + reason)
+}
+
+func (v *importer_) wrapText(
+	text string,
+) string {
+	var lines []string
+	var line string
+	for _, word := range sts.Fields(text) {
+		if len(line) > 0 && len(line)+len(word) >= 80 {
+			lines = append(lines, line)
+			line = ""
+		}
+		if len(line) > 0 {
+			line += " "
+		}
+		line += word
+	}
+	return sts.Join(append(lines, line), "\n")
+}
+
+// Instance Structure
+
+type importer_ struct {
+	// Declare the instance attributes.
+	unmapped_     com.ListLike[string]
+	aliases_      com.CatalogLike[string, string]
+	packages_     com.CatalogLike[string, string]
+	renamed_      com.CatalogLike[string, string]
+	synthesized_  com.SetLike[string]
+	constraints_  com.CatalogLike[string, string]
+	arguments_    com.CatalogLike[string, string]
+	constructors_ com.CatalogLike[string, string]
+	enumerations_ com.CatalogLike[string, string]
+	types_        com.CatalogLike[string, string]
+	functionals_  com.CatalogLike[string, string]
+	classes_      com.CatalogLike[string, string]
+	instances_    com.CatalogLike[string, string]
+	aspects_      com.CatalogLike[string, string]
+}
+
+// Class Structure
+
+type importerClass_ struct {
+	// Declare the class constants.
+	names_    *reg.Regexp
+	numbers_  *reg.Regexp
+	prefixes_ *reg.Regexp
+	versions_ *reg.Regexp
+}
+
+// Class Reference
+
+func importerClass() *importerClass_ {
+	return importerClassReference_
+}
+
+var importerClassReference_ = &importerClass_{
+	// Initialize the class constants.
+	names_:    reg.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*_?$`),
+	numbers_:  reg.MustCompile(`^[0-9]+(\.[0-9]+)?$`),
+	prefixes_: reg.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{2}$`),
+	versions_: reg.MustCompile(`^v[0-9]+$`),
+}
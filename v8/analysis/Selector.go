@@ -0,0 +1,332 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package analysis
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func SelectorClass() SelectorClassLike {
+	return selectorClass()
+}
+
+// Constructor Methods
+
+func (c *selectorClass_) Selector(
+	expression string,
+) SelectorLike {
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
+	}
+	var instance = &selector_{
+		// Initialize the instance attributes.
+		expression_: expression,
+	}
+	instance.parseExpression()
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *selector_) GetClass() SelectorClassLike {
+	return selectorClass()
+}
+
+func (v *selector_) SelectNodes(
+	model ast.ModelLike,
+) com.Sequential[any] {
+	// Build a tree of the rules and tokens in the order they are visited.
+	var root = &selectorNode_{name: "Model", value: model}
+	var current = root
+	var nodes []*selectorNode_
+	var preprocess = func(value any, name string) {
+		if value == model {
+			return
+		}
+		var node = &selectorNode_{name: name, value: value, parent: current}
+		current.children = append(current.children, node)
+		nodes = append(nodes, node)
+		current = node
+	}
+	var postprocess = func(value any, name string) {
+		if value == model {
+			return
+		}
+		current = current.parent
+	}
+	var inspector = gra.InspectorClass().Inspector(preprocess, postprocess)
+	gra.VisitorClass().Visitor(inspector).VisitModel(model)
+
+	// Select the matching nodes in the order they were visited.
+	var last = len(v.steps_) - 1
+	var results = com.List[any]()
+	if v.matchesStep(root, last) {
+		results.AppendValue(root.value)
+	}
+	for _, node := range nodes {
+		if v.matchesStep(node, last) {
+			results.AppendValue(node.value)
+		}
+	}
+	return results
+}
+
+// Attribute Methods
+
+func (v *selector_) GetExpression() string {
+	return v.expression_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *selector_) invalidExpression(
+	position int,
+) {
+	var message = fmt.Sprintf(
+		"The selector expression is not valid at position %d: %s",
+		position+1,
+		v.expression_,
+	)
+	panic(message)
+}
+
+func (v *selector_) matchesPath(
+	node *selectorNode_,
+	path []string,
+	value string,
+	compare bool,
+) bool {
+	if len(path) == 0 {
+		if !compare {
+			return true
+		}
+		var token, ok = node.value.(string)
+		if ok {
+			return token == value
+		}
+		for _, child := range node.children {
+			if child.name == value {
+				return true
+			}
+		}
+		return false
+	}
+	for _, child := range node.children {
+		if v.matchesName(child, path[0]) &&
+			v.matchesPath(child, path[1:], value, compare) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *selector_) matchesName(
+	node *selectorNode_,
+	name string,
+) bool {
+	return name == "*" || name == node.name
+}
+
+func (v *selector_) matchesStep(
+	node *selectorNode_,
+	index int,
+) bool {
+	var step = v.steps_[index]
+	if !v.matchesName(node, step.name) {
+		return false
+	}
+	for _, predicate := range step.predicates {
+		if !v.matchesPath(node, predicate.path, predicate.value, predicate.compare) {
+			return false
+		}
+	}
+	if index == 0 {
+		return true
+	}
+	if step.child {
+		return uti.IsDefined(node.parent) && v.matchesStep(node.parent, index-1)
+	}
+	for ancestor := node.parent; uti.IsDefined(ancestor); ancestor = ancestor.parent {
+		if v.matchesStep(ancestor, index-1) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *selector_) parseExpression() {
+	var runes = []rune(v.expression_)
+	var position int
+	var child bool
+	for position < len(runes) {
+		switch {
+		case uni.IsSpace(runes[position]):
+			position++
+		case runes[position] == '>':
+			if child || len(v.steps_) == 0 {
+				v.invalidExpression(position)
+			}
+			child = true
+			position++
+		default:
+			var step = &selectorStep_{child: child}
+			step.name, position = v.parseName(runes, position)
+			for position < len(runes) && runes[position] == '[' {
+				var predicate *selectorPredicate_
+				predicate, position = v.parsePredicate(runes, position+1)
+				step.predicates = append(step.predicates, predicate)
+			}
+			if position < len(runes) && !uni.IsSpace(runes[position]) &&
+				runes[position] != '>' {
+				v.invalidExpression(position)
+			}
+			v.steps_ = append(v.steps_, step)
+			child = false
+		}
+	}
+	if child || len(v.steps_) == 0 {
+		v.invalidExpression(position)
+	}
+}
+
+func (v *selector_) parseName(
+	runes []rune,
+	position int,
+) (
+	name string,
+	next int,
+) {
+	next = position
+	for next < len(runes) &&
+		(uni.IsLetter(runes[next]) || uni.IsDigit(runes[next]) || runes[next] == '*') {
+		next++
+	}
+	name = string(runes[position:next])
+	if len(name) == 0 || (sts.Contains(name, "*") && name != "*") {
+		v.invalidExpression(position)
+	}
+	return
+}
+
+func (v *selector_) parsePredicate(
+	runes []rune,
+	position int,
+) (
+	predicate *selectorPredicate_,
+	next int,
+) {
+	predicate = &selectorPredicate_{}
+	var name string
+	name, next = v.parseName(runes, position)
+	predicate.path = append(predicate.path, name)
+	for next < len(runes) && runes[next] == '/' {
+		name, next = v.parseName(runes, next+1)
+		predicate.path = append(predicate.path, name)
+	}
+	if next < len(runes) && runes[next] == '=' {
+		next++
+		predicate.compare = true
+		if next < len(runes) && runes[next] == '"' {
+			var end = next + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				v.invalidExpression(next)
+			}
+			predicate.value = string(runes[next+1 : end])
+			next = end + 1
+		} else {
+			var end = next
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			predicate.value = sts.TrimSpace(string(runes[next:end]))
+			next = end
+		}
+	}
+	if next == len(runes) || runes[next] != ']' {
+		v.invalidExpression(next)
+	}
+	next++
+	return
+}
+
+// Instance Structure
+
+type selector_ struct {
+	// Declare the instance attributes.
+	expression_ string
+	steps_      []*selectorStep_
+}
+
+// A single node in the tree of visited rules and tokens.
+type selectorNode_ struct {
+	name     string
+	value    any
+	parent   *selectorNode_
+	children []*selectorNode_
+}
+
+// A predicate that must hold for the node matched by a step.
+type selectorPredicate_ struct {
+	path    []string
+	value   string
+	compare bool
+}
+
+// A single step in a selector expression.
+type selectorStep_ struct {
+	child      bool
+	name       string
+	predicates []*selectorPredicate_
+}
+
+// Class Structure
+
+type selectorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func selectorClass() *selectorClass_ {
+	return selectorClassReference_
+}
+
+var selectorClassReference_ = &selectorClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,157 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│         This "package_api.go" file was automatically generated using:        │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+
+Package "analysis" provides the following classes that relate class models to
+existing Go source code:
+  - ConformanceChecker verifies that the concrete classes in a Go package
+    implement what the class model for that package declares.
+  - Importer reverse engineers a best-effort class model from the interfaces and
+    types declared by an existing Go package.
+  - Selector selects the nodes in a class model that match a selector
+    expression, much like a CSS selector selects the elements in a document.
+
+For detailed documentation on this package refer to the wiki:
+  - https://github.com/craterdog/go-class-model/wiki
+
+This package follows the Crater Dog Technologies™ Go Coding Conventions located
+here:
+  - https://github.com/craterdog/go-development-tools/wiki/Coding-Conventions
+
+Additional concrete implementations of the classes declared by this package can
+be developed and used seamlessly since the interface declarations only depend on
+other interfaces and intrinsic types—and the class implementations only depend
+on interfaces, not on each other.
+*/
+package analysis
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+)
+
+// TYPE DECLARATIONS
+
+// FUNCTIONAL DECLARATIONS
+
+// CLASS DECLARATIONS
+
+/*
+ConformanceCheckerClassLike is a class interface that declares the complete set
+of class constructors, constants and functions that must be supported by each
+concrete conformance-checker-like class.
+*/
+type ConformanceCheckerClassLike interface {
+	// Constructor Methods
+	ConformanceChecker() ConformanceCheckerLike
+}
+
+/*
+ImporterClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+importer-like class.
+*/
+type ImporterClassLike interface {
+	// Constructor Methods
+	Importer() ImporterLike
+}
+
+/*
+SelectorClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+selector-like class.
+*/
+type SelectorClassLike interface {
+	// Constructor Methods
+	Selector(
+		expression string,
+	) SelectorLike
+}
+
+// INSTANCE DECLARATIONS
+
+/*
+ConformanceCheckerLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete conformance-checker-like class.
+
+The package_api.go file in the package directory is parsed as the class model
+and the Go package is type checked.  A violation is reported for each missing
+access function, each unimplemented or mismatched method, and each exported
+symbol that is not declared by the class model.
+*/
+type ConformanceCheckerLike interface {
+	// Principal Methods
+	GetClass() ConformanceCheckerClassLike
+	CheckConformance(
+		directory string,
+	) com.Sequential[string]
+}
+
+/*
+ImporterLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete importer-like class.
+
+The interfaces, named types, constant blocks and functional types declared by
+the Go package are mapped onto the corresponding class model declarations.  Any
+declarations that cannot be mapped are skipped and described by the unmapped
+attribute of the importer.  A package that does not declare any interfaces that
+can be modeled as classes is unmappable, so no model is returned for it.
+*/
+type ImporterLike interface {
+	// Principal Methods
+	GetClass() ImporterClassLike
+	ImportPackage(
+		directory string,
+	) ast.ModelLike
+
+	// Attribute Methods
+	GetUnmapped() com.Sequential[string]
+}
+
+/*
+SelectorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete selector-like class.
+
+A selector expression is a sequence of steps that are separated by whitespace,
+meaning any descendant, or by a ">", meaning a direct child.  Each step names a
+rule (e.g. "SetterMethod") or token type (e.g. "name") from the GCMN grammar, or
+"*" for any node, and may be followed by predicates:
+
+	InstanceMethods > AttributeSubsection SetterMethod[Parameter/Abstraction/Wrapper=Map]
+
+A predicate is a path of child names that must exist below the node.  When the
+path is followed by "=" and a value, the node at the end of the path must either
+be a token with that value, or a rule with a child of that name.  The matching
+nodes are returned in the order in which they are visited.
+*/
+type SelectorLike interface {
+	// Principal Methods
+	GetClass() SelectorClassLike
+	SelectNodes(
+		model ast.ModelLike,
+	) com.Sequential[any]
+
+	// Attribute Methods
+	GetExpression() string
+}
+
+// ASPECT DECLARATIONS
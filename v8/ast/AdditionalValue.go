@@ -37,13 +37,18 @@ func AdditionalValueClass() AdditionalValueClassLike {
 
 func (c *additionalValueClass_) AdditionalValue(
 	name string,
+	specification SpecificationLike,
 ) AdditionalValueLike {
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
 	}
+	if uti.IsUndefined(specification) {
+		panic("The \"specification\" attribute is required by this class.")
+	}
 	var instance = &additionalValue_{
 		// Initialize the instance attributes.
-		name_: name,
+		name_:          name,
+		specification_: specification,
 	}
 	return instance
 }
@@ -62,13 +67,18 @@ func (v *additionalValue_) GetName() string {
 	return v.name_
 }
 
+func (v *additionalValue_) GetSpecification() SpecificationLike {
+	return v.specification_
+}
+
 // PROTECTED INTERFACE
 
 // Instance Structure
 
 type additionalValue_ struct {
 	// Declare the instance attributes.
-	name_ string
+	name_          string
+	specification_ SpecificationLike
 }
 
 // Class Structure
@@ -40,7 +40,7 @@ func (c *aspectDeclarationClass_) AspectDeclaration(
 	declaration DeclarationLike,
 	delimiter1 string,
 	delimiter2 string,
-	aspectMethods com.Sequential[AspectMethodLike],
+	aspectMembers com.Sequential[AspectMemberLike],
 	delimiter3 string,
 ) AspectDeclarationLike {
 	if uti.IsUndefined(declaration) {
@@ -52,8 +52,8 @@ func (c *aspectDeclarationClass_) AspectDeclaration(
 	if uti.IsUndefined(delimiter2) {
 		panic("The \"delimiter2\" attribute is required by this class.")
 	}
-	if uti.IsUndefined(aspectMethods) {
-		panic("The \"aspectMethods\" attribute is required by this class.")
+	if uti.IsUndefined(aspectMembers) {
+		panic("The \"aspectMembers\" attribute is required by this class.")
 	}
 	if uti.IsUndefined(delimiter3) {
 		panic("The \"delimiter3\" attribute is required by this class.")
@@ -63,7 +63,7 @@ func (c *aspectDeclarationClass_) AspectDeclaration(
 		declaration_:   declaration,
 		delimiter1_:    delimiter1,
 		delimiter2_:    delimiter2,
-		aspectMethods_: aspectMethods,
+		aspectMembers_: aspectMembers,
 		delimiter3_:    delimiter3,
 	}
 	return instance
@@ -91,8 +91,8 @@ func (v *aspectDeclaration_) GetDelimiter2() string {
 	return v.delimiter2_
 }
 
-func (v *aspectDeclaration_) GetAspectMethods() com.Sequential[AspectMethodLike] {
-	return v.aspectMethods_
+func (v *aspectDeclaration_) GetAspectMembers() com.Sequential[AspectMemberLike] {
+	return v.aspectMembers_
 }
 
 func (v *aspectDeclaration_) GetDelimiter3() string {
@@ -108,7 +108,7 @@ type aspectDeclaration_ struct {
 	declaration_   DeclarationLike
 	delimiter1_    string
 	delimiter2_    string
-	aspectMethods_ com.Sequential[AspectMethodLike]
+	aspectMembers_ com.Sequential[AspectMemberLike]
 	delimiter3_    string
 }
 
@@ -0,0 +1,108 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func AssignmentClass() AssignmentClassLike {
+	return assignmentClass()
+}
+
+// Constructor Methods
+
+func (c *assignmentClass_) Assignment(
+	abstraction AbstractionLike,
+	delimiter string,
+	expression ExpressionLike,
+) AssignmentLike {
+	if uti.IsUndefined(abstraction) {
+		panic("The \"abstraction\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(delimiter) {
+		panic("The \"delimiter\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
+	}
+	var instance = &assignment_{
+		// Initialize the instance attributes.
+		abstraction_: abstraction,
+		delimiter_:   delimiter,
+		expression_:  expression,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *assignment_) GetClass() AssignmentClassLike {
+	return assignmentClass()
+}
+
+// Attribute Methods
+
+func (v *assignment_) GetAbstraction() AbstractionLike {
+	return v.abstraction_
+}
+
+func (v *assignment_) GetDelimiter() string {
+	return v.delimiter_
+}
+
+func (v *assignment_) GetExpression() ExpressionLike {
+	return v.expression_
+}
+
+// PROTECTED INTERFACE
+
+// Instance Structure
+
+type assignment_ struct {
+	// Declare the instance attributes.
+	abstraction_ AbstractionLike
+	delimiter_   string
+	expression_  ExpressionLike
+}
+
+// Class Structure
+
+type assignmentClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func assignmentClass() *assignmentClass_ {
+	return assignmentClassReference_
+}
+
+var assignmentClassReference_ = &assignmentClass_{
+	// Initialize the class constants.
+}
@@ -36,6 +36,7 @@ func ConstantMethodClass() ConstantMethodClassLike {
 // Constructor Methods
 
 func (c *constantMethodClass_) ConstantMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	delimiter2 string,
@@ -55,10 +56,11 @@ func (c *constantMethodClass_) ConstantMethod(
 	}
 	var instance = &constantMethod_{
 		// Initialize the instance attributes.
-		name_:        name,
-		delimiter1_:  delimiter1,
-		delimiter2_:  delimiter2,
-		abstraction_: abstraction,
+		optionalNote_: optionalNote,
+		name_:         name,
+		delimiter1_:   delimiter1,
+		delimiter2_:   delimiter2,
+		abstraction_:  abstraction,
 	}
 	return instance
 }
@@ -73,6 +75,10 @@ func (v *constantMethod_) GetClass() ConstantMethodClassLike {
 
 // Attribute Methods
 
+func (v *constantMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *constantMethod_) GetName() string {
 	return v.name_
 }
@@ -95,10 +101,11 @@ func (v *constantMethod_) GetAbstraction() AbstractionLike {
 
 type constantMethod_ struct {
 	// Declare the instance attributes.
-	name_        string
-	delimiter1_  string
-	delimiter2_  string
-	abstraction_ AbstractionLike
+	optionalNote_ string
+	name_         string
+	delimiter1_   string
+	delimiter2_   string
+	abstraction_  AbstractionLike
 }
 
 // Class Structure
@@ -36,6 +36,7 @@ func ConstructorMethodClass() ConstructorMethodClassLike {
 // Constructor Methods
 
 func (c *constructorMethodClass_) ConstructorMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	optionalParameterList ParameterListLike,
@@ -56,6 +57,7 @@ func (c *constructorMethodClass_) ConstructorMethod(
 	}
 	var instance = &constructorMethod_{
 		// Initialize the instance attributes.
+		optionalNote_:          optionalNote,
 		name_:                  name,
 		delimiter1_:            delimiter1,
 		optionalParameterList_: optionalParameterList,
@@ -75,6 +77,10 @@ func (v *constructorMethod_) GetClass() ConstructorMethodClassLike {
 
 // Attribute Methods
 
+func (v *constructorMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *constructorMethod_) GetName() string {
 	return v.name_
 }
@@ -101,6 +107,7 @@ func (v *constructorMethod_) GetAbstraction() AbstractionLike {
 
 type constructorMethod_ struct {
 	// Declare the instance attributes.
+	optionalNote_          string
 	name_                  string
 	delimiter1_            string
 	optionalParameterList_ ParameterListLike
@@ -0,0 +1,95 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ExpressionClass() ExpressionClassLike {
+	return expressionClass()
+}
+
+// Constructor Methods
+
+func (c *expressionClass_) Expression(
+	operand OperandLike,
+	optionalOperation OperationLike,
+) ExpressionLike {
+	if uti.IsUndefined(operand) {
+		panic("The \"operand\" attribute is required by this class.")
+	}
+	var instance = &expression_{
+		// Initialize the instance attributes.
+		operand_:           operand,
+		optionalOperation_: optionalOperation,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *expression_) GetClass() ExpressionClassLike {
+	return expressionClass()
+}
+
+// Attribute Methods
+
+func (v *expression_) GetOperand() OperandLike {
+	return v.operand_
+}
+
+func (v *expression_) GetOptionalOperation() OperationLike {
+	return v.optionalOperation_
+}
+
+// PROTECTED INTERFACE
+
+// Instance Structure
+
+type expression_ struct {
+	// Declare the instance attributes.
+	operand_           OperandLike
+	optionalOperation_ OperationLike
+}
+
+// Class Structure
+
+type expressionClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func expressionClass() *expressionClass_ {
+	return expressionClassReference_
+}
+
+var expressionClassReference_ = &expressionClass_{
+	// Initialize the class constants.
+}
@@ -36,6 +36,7 @@ func FunctionMethodClass() FunctionMethodClassLike {
 // Constructor Methods
 
 func (c *functionMethodClass_) FunctionMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	optionalParameterList ParameterListLike,
@@ -56,6 +57,7 @@ func (c *functionMethodClass_) FunctionMethod(
 	}
 	var instance = &functionMethod_{
 		// Initialize the instance attributes.
+		optionalNote_:          optionalNote,
 		name_:                  name,
 		delimiter1_:            delimiter1,
 		optionalParameterList_: optionalParameterList,
@@ -75,6 +77,10 @@ func (v *functionMethod_) GetClass() FunctionMethodClassLike {
 
 // Attribute Methods
 
+func (v *functionMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *functionMethod_) GetName() string {
 	return v.name_
 }
@@ -101,6 +107,7 @@ func (v *functionMethod_) GetResult() ResultLike {
 
 type functionMethod_ struct {
 	// Declare the instance attributes.
+	optionalNote_          string
 	name_                  string
 	delimiter1_            string
 	optionalParameterList_ ParameterListLike
@@ -36,6 +36,7 @@ func GetterMethodClass() GetterMethodClassLike {
 // Constructor Methods
 
 func (c *getterMethodClass_) GetterMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	delimiter2 string,
@@ -55,10 +56,11 @@ func (c *getterMethodClass_) GetterMethod(
 	}
 	var instance = &getterMethod_{
 		// Initialize the instance attributes.
-		name_:        name,
-		delimiter1_:  delimiter1,
-		delimiter2_:  delimiter2,
-		abstraction_: abstraction,
+		optionalNote_: optionalNote,
+		name_:         name,
+		delimiter1_:   delimiter1,
+		delimiter2_:   delimiter2,
+		abstraction_:  abstraction,
 	}
 	return instance
 }
@@ -73,6 +75,10 @@ func (v *getterMethod_) GetClass() GetterMethodClassLike {
 
 // Attribute Methods
 
+func (v *getterMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *getterMethod_) GetName() string {
 	return v.name_
 }
@@ -95,10 +101,11 @@ func (v *getterMethod_) GetAbstraction() AbstractionLike {
 
 type getterMethod_ struct {
 	// Declare the instance attributes.
-	name_        string
-	delimiter1_  string
-	delimiter2_  string
-	abstraction_ AbstractionLike
+	optionalNote_ string
+	name_         string
+	delimiter1_   string
+	delimiter2_   string
+	abstraction_  AbstractionLike
 }
 
 // Class Structure
@@ -37,18 +37,18 @@ func ImportedPackageClass() ImportedPackageClassLike {
 
 func (c *importedPackageClass_) ImportedPackage(
 	name string,
-	path string,
+	string_ string,
 ) ImportedPackageLike {
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
 	}
-	if uti.IsUndefined(path) {
-		panic("The \"path\" attribute is required by this class.")
+	if uti.IsUndefined(string_) {
+		panic("The \"string\" attribute is required by this class.")
 	}
 	var instance = &importedPackage_{
 		// Initialize the instance attributes.
-		name_: name,
-		path_: path,
+		name_:   name,
+		string_: string_,
 	}
 	return instance
 }
@@ -67,8 +67,8 @@ func (v *importedPackage_) GetName() string {
 	return v.name_
 }
 
-func (v *importedPackage_) GetPath() string {
-	return v.path_
+func (v *importedPackage_) GetString() string {
+	return v.string_
 }
 
 // PROTECTED INTERFACE
@@ -77,8 +77,8 @@ func (v *importedPackage_) GetPath() string {
 
 type importedPackage_ struct {
 	// Declare the instance attributes.
-	name_ string
-	path_ string
+	name_   string
+	string_ string
 }
 
 // Class Structure
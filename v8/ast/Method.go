@@ -36,6 +36,7 @@ func MethodClass() MethodClassLike {
 // Constructor Methods
 
 func (c *methodClass_) Method(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	optionalParameterList ParameterListLike,
@@ -56,6 +57,7 @@ func (c *methodClass_) Method(
 	}
 	var instance = &method_{
 		// Initialize the instance attributes.
+		optionalNote_:          optionalNote,
 		name_:                  name,
 		delimiter1_:            delimiter1,
 		optionalParameterList_: optionalParameterList,
@@ -75,6 +77,10 @@ func (v *method_) GetClass() MethodClassLike {
 
 // Attribute Methods
 
+func (v *method_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *method_) GetName() string {
 	return v.name_
 }
@@ -101,6 +107,7 @@ func (v *method_) GetResult() ResultLike {
 
 type method_ struct {
 	// Declare the instance attributes.
+	optionalNote_          string
 	name_                  string
 	delimiter1_            string
 	optionalParameterList_ ParameterListLike
@@ -0,0 +1,88 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func OperandClass() OperandClassLike {
+	return operandClass()
+}
+
+// Constructor Methods
+
+func (c *operandClass_) Operand(
+	any_ any,
+) OperandLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any\" attribute is required by this class.")
+	}
+	var instance = &operand_{
+		// Initialize the instance attributes.
+		any_: any_,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *operand_) GetClass() OperandClassLike {
+	return operandClass()
+}
+
+// Attribute Methods
+
+func (v *operand_) GetAny() any {
+	return v.any_
+}
+
+// PROTECTED INTERFACE
+
+// Instance Structure
+
+type operand_ struct {
+	// Declare the instance attributes.
+	any_ any
+}
+
+// Class Structure
+
+type operandClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func operandClass() *operandClass_ {
+	return operandClassReference_
+}
+
+var operandClassReference_ = &operandClass_{
+	// Initialize the class constants.
+}
@@ -36,6 +36,7 @@ func SetterMethodClass() SetterMethodClassLike {
 // Constructor Methods
 
 func (c *setterMethodClass_) SetterMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	parameter ParameterLike,
@@ -55,10 +56,11 @@ func (c *setterMethodClass_) SetterMethod(
 	}
 	var instance = &setterMethod_{
 		// Initialize the instance attributes.
-		name_:       name,
-		delimiter1_: delimiter1,
-		parameter_:  parameter,
-		delimiter2_: delimiter2,
+		optionalNote_: optionalNote,
+		name_:         name,
+		delimiter1_:   delimiter1,
+		parameter_:    parameter,
+		delimiter2_:   delimiter2,
 	}
 	return instance
 }
@@ -73,6 +75,10 @@ func (v *setterMethod_) GetClass() SetterMethodClassLike {
 
 // Attribute Methods
 
+func (v *setterMethod_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
 func (v *setterMethod_) GetName() string {
 	return v.name_
 }
@@ -95,10 +101,11 @@ func (v *setterMethod_) GetDelimiter2() string {
 
 type setterMethod_ struct {
 	// Declare the instance attributes.
-	name_       string
-	delimiter1_ string
-	parameter_  ParameterLike
-	delimiter2_ string
+	optionalNote_ string
+	name_         string
+	delimiter1_   string
+	parameter_    ParameterLike
+	delimiter2_   string
 }
 
 // Class Structure
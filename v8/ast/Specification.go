@@ -0,0 +1,88 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package ast
+
+import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func SpecificationClass() SpecificationClassLike {
+	return specificationClass()
+}
+
+// Constructor Methods
+
+func (c *specificationClass_) Specification(
+	any_ any,
+) SpecificationLike {
+	if uti.IsUndefined(any_) {
+		panic("The \"any\" attribute is required by this class.")
+	}
+	var instance = &specification_{
+		// Initialize the instance attributes.
+		any_: any_,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *specification_) GetClass() SpecificationClassLike {
+	return specificationClass()
+}
+
+// Attribute Methods
+
+func (v *specification_) GetAny() any {
+	return v.any_
+}
+
+// PROTECTED INTERFACE
+
+// Instance Structure
+
+type specification_ struct {
+	// Declare the instance attributes.
+	any_ any
+}
+
+// Class Structure
+
+type specificationClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func specificationClass() *specificationClass_ {
+	return specificationClassReference_
+}
+
+var specificationClassReference_ = &specificationClass_{
+	// Initialize the class constants.
+}
@@ -38,8 +38,8 @@ func ValueClass() ValueClassLike {
 func (c *valueClass_) Value(
 	name string,
 	abstraction AbstractionLike,
-	delimiter1 string,
-	delimiter2 string,
+	delimiter string,
+	expression ExpressionLike,
 ) ValueLike {
 	if uti.IsUndefined(name) {
 		panic("The \"name\" attribute is required by this class.")
@@ -47,18 +47,18 @@ func (c *valueClass_) Value(
 	if uti.IsUndefined(abstraction) {
 		panic("The \"abstraction\" attribute is required by this class.")
 	}
-	if uti.IsUndefined(delimiter1) {
-		panic("The \"delimiter1\" attribute is required by this class.")
+	if uti.IsUndefined(delimiter) {
+		panic("The \"delimiter\" attribute is required by this class.")
 	}
-	if uti.IsUndefined(delimiter2) {
-		panic("The \"delimiter2\" attribute is required by this class.")
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
 	}
 	var instance = &value_{
 		// Initialize the instance attributes.
 		name_:        name,
 		abstraction_: abstraction,
-		delimiter1_:  delimiter1,
-		delimiter2_:  delimiter2,
+		delimiter_:   delimiter,
+		expression_:  expression,
 	}
 	return instance
 }
@@ -81,12 +81,12 @@ func (v *value_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
-func (v *value_) GetDelimiter1() string {
-	return v.delimiter1_
+func (v *value_) GetDelimiter() string {
+	return v.delimiter_
 }
 
-func (v *value_) GetDelimiter2() string {
-	return v.delimiter2_
+func (v *value_) GetExpression() ExpressionLike {
+	return v.expression_
 }
 
 // PROTECTED INTERFACE
@@ -97,8 +97,8 @@ type value_ struct {
 	// Declare the instance attributes.
 	name_        string
 	abstraction_ AbstractionLike
-	delimiter1_  string
-	delimiter2_  string
+	delimiter_   string
+	expression_  ExpressionLike
 }
 
 // Class Structure
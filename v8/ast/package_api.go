@@ -95,6 +95,7 @@ type AdditionalValueClassLike interface {
 	// Constructor Methods
 	AdditionalValue(
 		name string,
+		specification SpecificationLike,
 	) AdditionalValueLike
 }
 
@@ -149,7 +150,7 @@ type AspectDeclarationClassLike interface {
 		declaration DeclarationLike,
 		delimiter1 string,
 		delimiter2 string,
-		aspectMethods com.Sequential[AspectMethodLike],
+		aspectMembers com.Sequential[AspectMemberLike],
 		delimiter3 string,
 	) AspectDeclarationLike
 }
@@ -166,6 +167,18 @@ type AspectInterfaceClassLike interface {
 	) AspectInterfaceLike
 }
 
+/*
+AspectMemberClassLike is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete aspect-member-like class.
+*/
+type AspectMemberClassLike interface {
+	// Constructor Methods
+	AspectMember(
+		any_ any,
+	) AspectMemberLike
+}
+
 /*
 AspectMethodClassLike is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -204,6 +217,20 @@ type AspectSubsectionClassLike interface {
 	) AspectSubsectionLike
 }
 
+/*
+AssignmentClassLike is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete assignment-like class.
+*/
+type AssignmentClassLike interface {
+	// Constructor Methods
+	Assignment(
+		abstraction AbstractionLike,
+		delimiter string,
+		expression ExpressionLike,
+	) AssignmentLike
+}
+
 /*
 AttributeMethodClassLike is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -292,6 +319,7 @@ supported by each concrete constant-method-like class.
 type ConstantMethodClassLike interface {
 	// Constructor Methods
 	ConstantMethod(
+		optionalNote string,
 		name string,
 		delimiter1 string,
 		delimiter2 string,
@@ -348,6 +376,7 @@ supported by each concrete constructor-method-like class.
 type ConstructorMethodClassLike interface {
 	// Constructor Methods
 	ConstructorMethod(
+		optionalNote string,
 		name string,
 		delimiter1 string,
 		optionalParameterList ParameterListLike,
@@ -412,6 +441,19 @@ type EnumerationClassLike interface {
 	) EnumerationLike
 }
 
+/*
+ExpressionClassLike is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete expression-like class.
+*/
+type ExpressionClassLike interface {
+	// Constructor Methods
+	Expression(
+		operand OperandLike,
+		optionalOperation OperationLike,
+	) ExpressionLike
+}
+
 /*
 FunctionMethodClassLike is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -420,6 +462,7 @@ supported by each concrete function-method-like class.
 type FunctionMethodClassLike interface {
 	// Constructor Methods
 	FunctionMethod(
+		optionalNote string,
 		name string,
 		delimiter1 string,
 		optionalParameterList ParameterListLike,
@@ -491,6 +534,7 @@ supported by each concrete getter-method-like class.
 type GetterMethodClassLike interface {
 	// Constructor Methods
 	GetterMethod(
+		optionalNote string,
 		name string,
 		delimiter1 string,
 		delimiter2 string,
@@ -519,7 +563,7 @@ type ImportedPackageClassLike interface {
 	// Constructor Methods
 	ImportedPackage(
 		name string,
-		path string,
+		string_ string,
 	) ImportedPackageLike
 }
 
@@ -615,6 +659,7 @@ supported by each concrete method-like class.
 type MethodClassLike interface {
 	// Constructor Methods
 	Method(
+		optionalNote string,
 		name string,
 		delimiter1 string,
 		optionalParameterList ParameterListLike,
@@ -677,6 +722,31 @@ type NoneClassLike interface {
 	) NoneLike
 }
 
+/*
+OperandClassLike is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete operand-like class.
+*/
+type OperandClassLike interface {
+	// Constructor Methods
+	Operand(
+		any_ any,
+	) OperandLike
+}
+
+/*
+OperationClassLike is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete operation-like class.
+*/
+type OperationClassLike interface {
+	// Constructor Methods
+	Operation(
+		operator string,
+		operand OperandLike,
+	) OperationLike
+}
+
 /*
 PackageDeclarationClassLike is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -804,6 +874,7 @@ supported by each concrete setter-method-like class.
 type SetterMethodClassLike interface {
 	// Constructor Methods
 	SetterMethod(
+		optionalNote string,
 		name string,
 		delimiter1 string,
 		parameter ParameterLike,
@@ -811,6 +882,18 @@ type SetterMethodClassLike interface {
 	) SetterMethodLike
 }
 
+/*
+SpecificationClassLike is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete specification-like class.
+*/
+type SpecificationClassLike interface {
+	// Constructor Methods
+	Specification(
+		any_ any,
+	) SpecificationLike
+}
+
 /*
 StarClassLike is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -872,8 +955,8 @@ type ValueClassLike interface {
 	Value(
 		name string,
 		abstraction AbstractionLike,
-		delimiter1 string,
-		delimiter2 string,
+		delimiter string,
+		expression ExpressionLike,
 	) ValueLike
 }
 
@@ -944,6 +1027,7 @@ type AdditionalValueLike interface {
 
 	// Attribute Methods
 	GetName() string
+	GetSpecification() SpecificationLike
 }
 
 /*
@@ -1002,7 +1086,7 @@ type AspectDeclarationLike interface {
 	GetDeclaration() DeclarationLike
 	GetDelimiter1() string
 	GetDelimiter2() string
-	GetAspectMethods() com.Sequential[AspectMethodLike]
+	GetAspectMembers() com.Sequential[AspectMemberLike]
 	GetDelimiter3() string
 }
 
@@ -1019,6 +1103,19 @@ type AspectInterfaceLike interface {
 	GetAbstraction() AbstractionLike
 }
 
+/*
+AspectMemberLike is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete aspect-member-like class.
+*/
+type AspectMemberLike interface {
+	// Principal Methods
+	GetClass() AspectMemberClassLike
+
+	// Attribute Methods
+	GetAny() any
+}
+
 /*
 AspectMethodLike is an instance interface that declares the
 complete set of principal, attribute and aspect methods that must be supported
@@ -1060,6 +1157,21 @@ type AspectSubsectionLike interface {
 	GetAspectInterfaces() com.Sequential[AspectInterfaceLike]
 }
 
+/*
+AssignmentLike is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete assignment-like class.
+*/
+type AssignmentLike interface {
+	// Principal Methods
+	GetClass() AssignmentClassLike
+
+	// Attribute Methods
+	GetAbstraction() AbstractionLike
+	GetDelimiter() string
+	GetExpression() ExpressionLike
+}
+
 /*
 AttributeMethodLike is an instance interface that declares the
 complete set of principal, attribute and aspect methods that must be supported
@@ -1156,6 +1268,7 @@ type ConstantMethodLike interface {
 	GetClass() ConstantMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetDelimiter1() string
 	GetDelimiter2() string
@@ -1216,6 +1329,7 @@ type ConstructorMethodLike interface {
 	GetClass() ConstructorMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetDelimiter1() string
 	GetOptionalParameterList() ParameterListLike
@@ -1283,6 +1397,20 @@ type EnumerationLike interface {
 	GetDelimiter3() string
 }
 
+/*
+ExpressionLike is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete expression-like class.
+*/
+type ExpressionLike interface {
+	// Principal Methods
+	GetClass() ExpressionClassLike
+
+	// Attribute Methods
+	GetOperand() OperandLike
+	GetOptionalOperation() OperationLike
+}
+
 /*
 FunctionMethodLike is an instance interface that declares the
 complete set of principal, attribute and aspect methods that must be supported
@@ -1293,6 +1421,7 @@ type FunctionMethodLike interface {
 	GetClass() FunctionMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetDelimiter1() string
 	GetOptionalParameterList() ParameterListLike
@@ -1369,6 +1498,7 @@ type GetterMethodLike interface {
 	GetClass() GetterMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetDelimiter1() string
 	GetDelimiter2() string
@@ -1399,7 +1529,7 @@ type ImportedPackageLike interface {
 
 	// Attribute Methods
 	GetName() string
-	GetPath() string
+	GetString() string
 }
 
 /*
@@ -1502,6 +1632,7 @@ type MethodLike interface {
 	GetClass() MethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetDelimiter1() string
 	GetOptionalParameterList() ParameterListLike
@@ -1567,6 +1698,33 @@ type NoneLike interface {
 	GetNewline() string
 }
 
+/*
+OperandLike is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete operand-like class.
+*/
+type OperandLike interface {
+	// Principal Methods
+	GetClass() OperandClassLike
+
+	// Attribute Methods
+	GetAny() any
+}
+
+/*
+OperationLike is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete operation-like class.
+*/
+type OperationLike interface {
+	// Principal Methods
+	GetClass() OperationClassLike
+
+	// Attribute Methods
+	GetOperator() string
+	GetOperand() OperandLike
+}
+
 /*
 PackageDeclarationLike is an instance interface that declares the
 complete set of principal, attribute and aspect methods that must be supported
@@ -1705,12 +1863,26 @@ type SetterMethodLike interface {
 	GetClass() SetterMethodClassLike
 
 	// Attribute Methods
+	GetOptionalNote() string
 	GetName() string
 	GetDelimiter1() string
 	GetParameter() ParameterLike
 	GetDelimiter2() string
 }
 
+/*
+SpecificationLike is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete specification-like class.
+*/
+type SpecificationLike interface {
+	// Principal Methods
+	GetClass() SpecificationClassLike
+
+	// Attribute Methods
+	GetAny() any
+}
+
 /*
 StarLike is an instance interface that declares the
 complete set of principal, attribute and aspect methods that must be supported
@@ -1778,8 +1950,8 @@ type ValueLike interface {
 	// Attribute Methods
 	GetName() string
 	GetAbstraction() AbstractionLike
-	GetDelimiter1() string
-	GetDelimiter2() string
+	GetDelimiter() string
+	GetExpression() ExpressionLike
 }
 
 /*
@@ -0,0 +1,619 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+Gcmn is a command line tool for maintaining the Go Class Model Notation™ (GCMN)
+module itself.
+
+Usage:
+
+	gcmn <command> [arguments]
+
+The following commands are supported:
+
+	conform <directory>...
+	diagram <mermaid|plantuml> <file>
+	document <markdown|html> <file>
+	fake <file>
+	fix [--dry-run] [--diff] <file>...
+	generate [directory]
+	import <directory>
+	license [--write] <template> <file>...
+	lint <file>...
+	query <selector> <file>...
+	scaffold <directory>
+
+The "conform" command checks that the concrete classes in each specified package
+directory implement what its "package_api.go" file declares.  The command exits
+with a non-zero status if any violations are reported.
+
+The "diagram" command prints a Mermaid or PlantUML class diagram for the class
+model found in the specified file.
+
+The "document" command prints Markdown or HTML API documentation for the class
+model found in the specified file.
+
+The "fake" command prints a recording fake for each instance and aspect
+declaration in the class model found in the specified file.
+
+The "fix" command applies the suggested edits of the lint diagnostics reported
+for each specified class model file, using the same ".gcmn.yaml" configuration
+as the "lint" command, and rewrites the file in place preserving the layout of
+the parts of the file that were not edited.  If the "--diff" flag is specified
+the changes are printed as a unified diff.  If the "--dry-run" flag is specified
+the files are not rewritten, and the command exits with a non-zero status if any
+file would be changed.
+
+The "generate" command regenerates the grammar/Parser.go, grammar/Visitor.go,
+grammar/Processor.go, grammar/Inspector.go, grammar/CompositeProcessor.go,
+grammar/Encoder.go, grammar/Decoder.go and grammar/Transformer.go class files,
+and the "model.schema.json" file, from the "syntax.cdsn" file found in the
+specified module directory (which defaults to the current directory).
+
+The "import" command prints a best-effort class model for the existing Go package
+found in the specified directory.  Any declarations that could not be mapped onto
+the class model are reported on the standard error stream.  The command exits
+with a non-zero status if the package declares no interfaces that can be modeled
+as classes.
+
+The "license" command checks the legal notice of each specified class model file
+against the legal notice found in the specified template file.  Each occurrence
+of the "<year>" variable in the template stands for the copyright years, which
+keep the start year of the existing notice and end with the current year.  The
+mismatched notices are reported and, if the "--write" flag is specified, each
+file is rewritten in place with its updated notice, preserving the layout of the
+rest of the file.  Otherwise, the command exits with a non-zero status if any
+mismatches are reported.
+
+The "lint" command checks each specified class model file against the registered
+lint rules and reports any violations.  The rules may be configured using a
+".gcmn.yaml" file in the current directory:
+
+	rules:
+	  import-alias:
+	    severity: warning
+	  declaration-comment:
+	    severity: off
+	  function-comment:
+	    options:
+	      marker: "The following (?:class )?functions are supported:"
+	  package-header:
+	    options:
+	      support: "Please report any issues to the project maintainers."
+
+The "marker" option for the "function-comment" rule is a regular expression that
+matches the line preceding the documented class functions in a class comment.
+Each option for the "package-header" rule names a line that must appear in the
+package header comment.
+
+The command exits with a non-zero status if any error diagnostics are reported.
+
+The "query" command prints each node in the specified class model files that
+matches the selector expression, for example:
+
+	gcmn query 'AttributeSubsection SetterMethod[Parameter/Abstraction/Wrapper=Map]' package_api.go
+
+The "scaffold" command generates a "<Class>_test.go" skeleton for each instance
+declaration in the "package_api.go" file found in the specified directory.  Any
+existing test files are left unchanged.
+*/
+package main
+
+import (
+	fmt "fmt"
+	mod "github.com/craterdog/go-class-model/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	osx "os"
+	sts "strings"
+	tim "time"
+)
+
+func main() {
+	var arguments = osx.Args[1:]
+	if len(arguments) == 0 {
+		usage()
+	}
+	var command = arguments[0]
+	arguments = arguments[1:]
+	switch command {
+	case "conform":
+		conform(arguments)
+	case "diagram":
+		diagram(arguments)
+	case "document":
+		document(arguments)
+	case "fake":
+		fake(arguments)
+	case "fix":
+		fix(arguments)
+	case "generate":
+		generate(arguments)
+	case "import":
+		import_(arguments)
+	case "license":
+		license(arguments)
+	case "lint":
+		lint(arguments)
+	case "query":
+		query(arguments)
+	case "scaffold":
+		scaffold(arguments)
+	default:
+		usage()
+	}
+}
+
+// Commands
+
+func conform(
+	arguments []string,
+) {
+	if len(arguments) == 0 {
+		usage()
+	}
+	var failed bool
+	var checker = mod.ConformanceChecker()
+	for _, directory := range arguments {
+		var violations = checker.CheckConformance(directory).GetIterator()
+		for violations.HasNext() {
+			fmt.Printf("%s: %s\n", directory, violations.GetNext())
+			failed = true
+		}
+	}
+	if failed {
+		osx.Exit(1)
+	}
+}
+
+func diagram(
+	arguments []string,
+) {
+	if len(arguments) != 2 {
+		usage()
+	}
+	var model = mod.ParseSource(uti.ReadFile(arguments[1]))
+	switch arguments[0] {
+	case "mermaid":
+		fmt.Print(mod.GenerateMermaid(model))
+	case "plantuml":
+		fmt.Print(mod.GeneratePlantUML(model))
+	default:
+		usage()
+	}
+}
+
+func document(
+	arguments []string,
+) {
+	if len(arguments) != 2 {
+		usage()
+	}
+	var model = mod.ParseSource(uti.ReadFile(arguments[1]))
+	switch arguments[0] {
+	case "markdown":
+		fmt.Print(mod.GenerateMarkdown(model))
+	case "html":
+		fmt.Print(mod.GenerateHTML(model))
+	default:
+		usage()
+	}
+}
+
+func fake(
+	arguments []string,
+) {
+	if len(arguments) != 1 {
+		usage()
+	}
+	var model = mod.ParseSource(uti.ReadFile(arguments[0]))
+	fmt.Print(mod.GenerateFakes(model))
+}
+
+func fix(
+	arguments []string,
+) {
+	var dryRun, diff bool
+	for len(arguments) > 0 && sts.HasPrefix(arguments[0], "--") {
+		switch arguments[0] {
+		case "--dry-run":
+			dryRun = true
+		case "--diff":
+			diff = true
+		default:
+			usage()
+		}
+		arguments = arguments[1:]
+	}
+	if len(arguments) == 0 {
+		usage()
+	}
+	var configuration = readConfiguration(".gcmn.yaml")
+	var changed bool
+	for _, filename := range arguments {
+		var source = uti.ReadFile(filename)
+		var syntax = mod.ParseConcrete(source)
+		var model = mod.FixModel(syntax.GetModel(), configuration)
+		var fixed = syntax.FormatModel(model)
+		if fixed == source {
+			continue
+		}
+		changed = true
+		if diff {
+			fmt.Print(formatDiff(filename, source, fixed))
+		}
+		if dryRun {
+			continue
+		}
+		if !diff {
+			fmt.Printf("%s: applying the suggested edits\n", filename)
+		}
+		uti.WriteFile(filename, fixed)
+	}
+	if dryRun && changed {
+		osx.Exit(1)
+	}
+}
+
+func generate(
+	arguments []string,
+) {
+	var directory = "."
+	if len(arguments) > 0 {
+		directory = arguments[0]
+	}
+	directory = sts.TrimSuffix(directory, "/") + "/"
+	var moduleName = readModuleName(directory)
+	var syntax = mod.ReadSyntax(uti.ReadFile(directory + "syntax.cdsn"))
+	var generator = mod.Generator()
+	uti.WriteFile(
+		directory+"grammar/CompositeProcessor.go",
+		generator.GenerateCompositeProcessor(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Decoder.go",
+		generator.GenerateDecoder(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Encoder.go",
+		generator.GenerateEncoder(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Inspector.go",
+		generator.GenerateInspector(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Parser.go",
+		generator.GenerateParser(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Processor.go",
+		generator.GenerateProcessor(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Transformer.go",
+		generator.GenerateTransformer(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"grammar/Visitor.go",
+		generator.GenerateVisitor(moduleName, syntax),
+	)
+	uti.WriteFile(
+		directory+"model.schema.json",
+		generator.GenerateSchema(moduleName, syntax),
+	)
+}
+
+func import_(
+	arguments []string,
+) {
+	if len(arguments) != 1 {
+		usage()
+	}
+	var importer = mod.Importer()
+	var model = importer.ImportPackage(arguments[0])
+	if uti.IsDefined(model) {
+		fmt.Print(mod.FormatModel(model))
+	}
+	var unmapped = importer.GetUnmapped().GetIterator()
+	for unmapped.HasNext() {
+		fmt.Fprintf(osx.Stderr, "unmapped: %s\n", unmapped.GetNext())
+	}
+	if uti.IsUndefined(model) {
+		osx.Exit(1)
+	}
+}
+
+func license(
+	arguments []string,
+) {
+	var write = len(arguments) > 0 && arguments[0] == "--write"
+	if write {
+		arguments = arguments[1:]
+	}
+	if len(arguments) < 2 {
+		usage()
+	}
+	var year = uint(tim.Now().Year())
+	var licenser = mod.Licenser(uti.ReadFile(arguments[0]), year)
+	var failed bool
+	for _, filename := range arguments[1:] {
+		var syntax = mod.ParseConcrete(uti.ReadFile(filename))
+		var model = syntax.GetModel()
+		if licenser.CheckNotice(model) {
+			continue
+		}
+		if write {
+			// Only the legal notice is reformatted, the rest of the source
+			// layout is preserved.
+			fmt.Printf("%s: updating the legal notice\n", filename)
+			model = licenser.UpdateNotice(model)
+			uti.WriteFile(filename, syntax.FormatModel(model))
+			continue
+		}
+		fmt.Printf("%s: the legal notice does not match the template\n", filename)
+		failed = true
+	}
+	if failed {
+		osx.Exit(1)
+	}
+}
+
+func lint(
+	arguments []string,
+) {
+	if len(arguments) == 0 {
+		usage()
+	}
+	var configuration = readConfiguration(".gcmn.yaml")
+	var failed bool
+	for _, filename := range arguments {
+		var model = mod.ParseSource(uti.ReadFile(filename))
+		var diagnostics = mod.LintModel(model, configuration).GetIterator()
+		for diagnostics.HasNext() {
+			var diagnostic = diagnostics.GetNext()
+			fmt.Printf("%s: %s\n", filename, diagnostic.AsString())
+			if diagnostic.GetSeverity() == mod.ErrorSeverity {
+				failed = true
+			}
+		}
+	}
+	if failed {
+		osx.Exit(1)
+	}
+}
+
+func query(
+	arguments []string,
+) {
+	if len(arguments) < 2 {
+		usage()
+	}
+	var selector = mod.Selector(arguments[0])
+	for _, filename := range arguments[1:] {
+		var model = mod.ParseSource(uti.ReadFile(filename))
+		var nodes = selector.SelectNodes(model).GetIterator()
+		for nodes.HasNext() {
+			fmt.Printf("%s: %s\n", filename, renderNode(model, nodes.GetNext()))
+		}
+	}
+}
+
+func scaffold(
+	arguments []string,
+) {
+	if len(arguments) != 1 {
+		usage()
+	}
+	var directory = sts.TrimSuffix(arguments[0], "/") + "/"
+	var model = mod.ParseSource(uti.ReadFile(directory + "package_api.go"))
+	var scaffolds = mod.ScaffoldGenerator().GenerateScaffolds(model).GetIterator()
+	for scaffolds.HasNext() {
+		var scaffold = scaffolds.GetNext()
+		var filename = directory + scaffold.GetKey()
+		if uti.PathExists(filename) {
+			fmt.Printf("Skipping the existing %s file.\n", filename)
+			continue
+		}
+		uti.WriteFile(filename, scaffold.GetValue())
+	}
+}
+
+// Private Functions
+
+func formatDiff(
+	filename string,
+	original string,
+	revised string,
+) string {
+	var oldLines = sts.Split(sts.TrimSuffix(original, "\n"), "\n")
+	var newLines = sts.Split(sts.TrimSuffix(revised, "\n"), "\n")
+
+	// Calculate the longest common subsequence of lines following each pair of
+	// line positions.
+	var oldCount = len(oldLines)
+	var newCount = len(newLines)
+	var common = make([][]int, oldCount+1)
+	for index := range common {
+		common[index] = make([]int, newCount+1)
+	}
+	for i := oldCount - 1; i >= 0; i-- {
+		for j := newCount - 1; j >= 0; j-- {
+			switch {
+			case oldLines[i] == newLines[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	// Generate the edit script, each with the line positions preceding it.
+	type edit struct {
+		marker  string
+		line    string
+		oldLine int
+		newLine int
+	}
+	var edits []edit
+	var i, j int
+	for i < oldCount || j < newCount {
+		switch {
+		case i < oldCount && j < newCount && oldLines[i] == newLines[j]:
+			edits = append(edits, edit{" ", oldLines[i], i, j})
+			i++
+			j++
+		case i < oldCount && (j == newCount || common[i+1][j] >= common[i][j+1]):
+			edits = append(edits, edit{"-", oldLines[i], i, j})
+			i++
+		default:
+			edits = append(edits, edit{"+", newLines[j], i, j})
+			j++
+		}
+	}
+
+	// Group the changes into hunks with three lines of context.
+	var context = 3
+	var builder sts.Builder
+	fmt.Fprintf(&builder, "--- %s.orig\n+++ %s\n", filename, filename)
+	var index int
+	for index < len(edits) {
+		if edits[index].marker == " " {
+			index++
+			continue
+		}
+		var first = max(index-context, 0)
+		var last = index
+		for next := index; next < len(edits) && next <= last+2*context; next++ {
+			if edits[next].marker != " " {
+				last = next
+			}
+		}
+		last = min(last+context, len(edits)-1)
+		var oldLength, newLength int
+		for _, edit := range edits[first : last+1] {
+			if edit.marker != "+" {
+				oldLength++
+			}
+			if edit.marker != "-" {
+				newLength++
+			}
+		}
+		var oldStart = edits[first].oldLine + 1
+		if oldLength == 0 {
+			oldStart--
+		}
+		var newStart = edits[first].newLine + 1
+		if newLength == 0 {
+			newStart--
+		}
+		fmt.Fprintf(
+			&builder,
+			"@@ -%d,%d +%d,%d @@\n",
+			oldStart,
+			oldLength,
+			newStart,
+			newLength,
+		)
+		for _, edit := range edits[first : last+1] {
+			builder.WriteString(edit.marker + edit.line + "\n")
+		}
+		index = last + 1
+	}
+	return builder.String()
+}
+
+func readConfiguration(
+	filename string,
+) mod.ConfigurationLike {
+	if !uti.PathExists(filename) {
+		return mod.Configuration()
+	}
+	return mod.ConfigurationFromSource(uti.ReadFile(filename))
+}
+
+func readModuleName(
+	directory string,
+) string {
+	var lines = sts.Split(uti.ReadFile(directory+"go.mod"), "\n")
+	for _, line := range lines {
+		var moduleName, found = sts.CutPrefix(line, "module ")
+		if found {
+			return sts.TrimSpace(moduleName)
+		}
+	}
+	var message = fmt.Sprintf(
+		"The module directory does not contain a valid go.mod file: %s",
+		directory,
+	)
+	panic(message)
+}
+
+func renderNode(
+	model mod.ModelLike,
+	node any,
+) string {
+	var token, ok = node.(string)
+	if ok {
+		return token
+	}
+
+	// Collect the significant tokens that are visited within the node.
+	var depth int
+	var tokens []string
+	var preprocess = func(value any, name string) {
+		switch {
+		case value == node:
+			depth++
+		case depth == 0:
+		case name == "comment", name == "newline", name == "space":
+		default:
+			var token, ok = value.(string)
+			if ok {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	var postprocess = func(value any, name string) {
+		if value == node {
+			depth--
+		}
+	}
+	mod.Visitor(mod.Inspector(preprocess, postprocess)).VisitModel(model)
+	var text = sts.Join(tokens, " ")
+	for _, pair := range [][2]string{
+		{" (", "("}, {"( ", "("}, {" )", ")"},
+		{" [", "["}, {"[ ", "["}, {" ]", "]"}, {" ,", ","},
+	} {
+		text = sts.ReplaceAll(text, pair[0], pair[1])
+	}
+	return text
+}
+
+func usage() {
+	fmt.Println("Usage: gcmn <command> [arguments]")
+	fmt.Println()
+	fmt.Println("The following commands are supported:")
+	fmt.Println("  conform <directory>...")
+	fmt.Println("  diagram <mermaid|plantuml> <file>")
+	fmt.Println("  document <markdown|html> <file>")
+	fmt.Println("  fake <file>")
+	fmt.Println("  fix [--dry-run] [--diff] <file>...")
+	fmt.Println("  generate [directory]")
+	fmt.Println("  import <directory>")
+	fmt.Println("  license [--write] <template> <file>...")
+	fmt.Println("  lint <file>...")
+	fmt.Println("  query <selector> <file>...")
+	fmt.Println("  scaffold <directory>")
+	osx.Exit(1)
+}
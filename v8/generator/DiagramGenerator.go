@@ -0,0 +1,554 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sor "sort"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DiagramGeneratorClass() DiagramGeneratorClassLike {
+	return diagramGeneratorClass()
+}
+
+// Constructor Methods
+
+func (c *diagramGeneratorClass_) DiagramGenerator() DiagramGeneratorLike {
+	var instance = &diagramGenerator_{
+		// Initialize the instance attributes.
+		formatter_: gra.FormatterClass().Formatter(),
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *diagramGenerator_) GetClass() DiagramGeneratorClassLike {
+	return diagramGeneratorClass()
+}
+
+func (v *diagramGenerator_) GenerateMermaid(
+	model ast.ModelLike,
+) string {
+	v.analyzeModel(model)
+	var builder sts.Builder
+	builder.WriteString("classDiagram\n")
+	for _, name := range v.names_ {
+		var kind = v.kinds_[name]
+		var label = name
+		if len(v.generics_[name]) > 0 {
+			label += "[\"" + name + "[" + v.generics_[name] + "]\"]"
+		}
+		builder.WriteString("    class " + label + " {\n")
+		builder.WriteString("        <<" + kind + ">>\n")
+		for _, value := range v.values_[name] {
+			builder.WriteString("        " + value + "\n")
+		}
+		for _, field := range v.fields_[name] {
+			builder.WriteString("        +" + v.mermaidText(field) + "\n")
+		}
+		for _, static := range v.statics_[name] {
+			builder.WriteString("        +" + v.mermaidText(static) + "$\n")
+		}
+		for _, method := range v.methods_[name] {
+			builder.WriteString("        +" + v.mermaidText(method) + "\n")
+		}
+		builder.WriteString("    }\n")
+	}
+	for _, external := range v.externals_ {
+		var identifier = v.getIdentifier(external)
+		builder.WriteString("    class " + identifier + "[\"" + external + "\"]\n")
+		builder.WriteString("    <<aspect>> " + identifier + "\n")
+	}
+	for _, relation := range v.formatRelations() {
+		builder.WriteString("    " + relation + "\n")
+	}
+	return builder.String()
+}
+
+func (v *diagramGenerator_) GeneratePlantUML(
+	model ast.ModelLike,
+) string {
+	v.analyzeModel(model)
+	var builder sts.Builder
+	builder.WriteString("@startuml\n")
+	for _, name := range v.names_ {
+		var kind = v.kinds_[name]
+		var header = name
+		if len(v.generics_[name]) > 0 {
+			header += "<" + v.generics_[name] + ">"
+		}
+		switch kind {
+		case "aspect":
+			header = "interface " + header + " <<aspect>>"
+		case "enumeration":
+			header = "enum " + header
+		case "class":
+			header = "class " + header
+		default:
+			header = "class " + header + " <<" + kind + ">>"
+		}
+		builder.WriteString(header + " {\n")
+		for _, value := range v.values_[name] {
+			builder.WriteString("  " + value + "\n")
+		}
+		for _, field := range v.fields_[name] {
+			builder.WriteString("  +" + field + "\n")
+		}
+		for _, static := range v.statics_[name] {
+			builder.WriteString("  {static} +" + static + "\n")
+		}
+		for _, method := range v.methods_[name] {
+			builder.WriteString("  +" + method + "\n")
+		}
+		builder.WriteString("}\n")
+	}
+	for _, external := range v.externals_ {
+		var identifier = v.getIdentifier(external)
+		builder.WriteString(
+			"interface \"" + external + "\" as " + identifier + " <<aspect>>\n",
+		)
+	}
+	for _, relation := range v.formatRelations() {
+		builder.WriteString(relation + "\n")
+	}
+	builder.WriteString("@enduml\n")
+	return builder.String()
+}
+
+// Methodical Methods
+
+func (v *diagramGenerator_) PreprocessAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.values_[v.current_] = append(v.values_[v.current_], additionalValue.GetName())
+}
+
+func (v *diagramGenerator_) PreprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = aspectDeclaration.GetDeclaration()
+	v.addNode(declaration.GetName(), "aspect", declaration)
+}
+
+func (v *diagramGenerator_) PostprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.current_ = ""
+}
+
+func (v *diagramGenerator_) PreprocessAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+	index_ uint,
+	count_ uint,
+) {
+	var named, ok = aspectInterface.GetAbstraction().GetType().GetAny().(ast.NamedLike)
+	if !ok {
+		return
+	}
+	var aspect = named.GetOptionalPrefix() + named.GetName()
+	if uti.IsDefined(named.GetOptionalPrefix()) && !v.contains(v.externals_, aspect) {
+		v.externals_ = append(v.externals_, aspect)
+	}
+	if v.kinds_[v.current_] == "aspect" {
+		v.embeddings_[v.current_] = append(v.embeddings_[v.current_], aspect)
+	} else {
+		v.realizations_[v.current_] = append(v.realizations_[v.current_], aspect)
+	}
+}
+
+func (v *diagramGenerator_) PreprocessAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	var method = aspectMethod.GetMethod()
+	v.methods_[v.current_] = append(
+		v.methods_[v.current_],
+		method.GetName()+v.formatter_.FormatParameterList(method.GetOptionalParameterList())+
+			v.formatter_.FormatResult(method.GetResult()),
+	)
+}
+
+func (v *diagramGenerator_) PreprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = classDeclaration.GetDeclaration()
+	var name = sts.TrimSuffix(declaration.GetName(), "ClassLike")
+	v.addNode(name, "class", declaration)
+}
+
+func (v *diagramGenerator_) PostprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.current_ = ""
+}
+
+func (v *diagramGenerator_) PreprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.statics_[v.current_] = append(
+		v.statics_[v.current_],
+		constantMethod.GetName()+"() "+
+			v.formatter_.FormatAbstraction(constantMethod.GetAbstraction()),
+	)
+}
+
+func (v *diagramGenerator_) PreprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.statics_[v.current_] = append(
+		v.statics_[v.current_],
+		constructorMethod.GetName()+
+			v.formatter_.FormatParameterList(constructorMethod.GetOptionalParameterList())+" "+
+			v.formatter_.FormatAbstraction(constructorMethod.GetAbstraction()),
+	)
+}
+
+func (v *diagramGenerator_) PreprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.statics_[v.current_] = append(
+		v.statics_[v.current_],
+		functionMethod.GetName()+
+			v.formatter_.FormatParameterList(functionMethod.GetOptionalParameterList())+
+			v.formatter_.FormatResult(functionMethod.GetResult()),
+	)
+}
+
+func (v *diagramGenerator_) PreprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = functionalDeclaration.GetDeclaration()
+	var name = declaration.GetName()
+	v.addNode(name, "functional", declaration)
+	var functional = functionalDeclaration.GetFunctional()
+	var signature = "func" + v.formatter_.FormatParameterList(functional.GetOptionalParameterList())
+	var result = functional.GetOptionalResult()
+	if uti.IsDefined(result) {
+		signature += v.formatter_.FormatResult(result)
+	}
+	v.methods_[name] = append(v.methods_[name], signature)
+}
+
+func (v *diagramGenerator_) PostprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.current_ = ""
+}
+
+func (v *diagramGenerator_) PreprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	var attribute = sts.TrimPrefix(getterMethod.GetName(), "Get")
+	if len(attribute) == 0 {
+		// A getter named just "Get" has no attribute name of its own.
+		attribute = getterMethod.GetName()
+	}
+	attribute = sts.ToLower(attribute[:1]) + attribute[1:]
+	v.fields_[v.current_] = append(
+		v.fields_[v.current_],
+		attribute+" "+v.formatter_.FormatAbstraction(getterMethod.GetAbstraction()),
+	)
+}
+
+func (v *diagramGenerator_) PreprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = instanceDeclaration.GetDeclaration()
+	var name = sts.TrimSuffix(declaration.GetName(), "Like")
+	v.addNode(name, "class", declaration)
+}
+
+func (v *diagramGenerator_) PostprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.current_ = ""
+}
+
+func (v *diagramGenerator_) PreprocessNamed(
+	named ast.NamedLike,
+	index_ uint,
+	count_ uint,
+) {
+	// Only references to types declared in this model are associations.
+	if len(v.current_) > 0 && uti.IsUndefined(named.GetOptionalPrefix()) {
+		v.references_[v.current_] = append(v.references_[v.current_], named.GetName())
+	}
+}
+
+func (v *diagramGenerator_) PreprocessPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	var method = principalMethod.GetMethod()
+	if method.GetName() == "GetClass" {
+		// Every instance refers back to its own class.
+		return
+	}
+	v.methods_[v.current_] = append(
+		v.methods_[v.current_],
+		method.GetName()+v.formatter_.FormatParameterList(method.GetOptionalParameterList())+
+			v.formatter_.FormatResult(method.GetResult()),
+	)
+}
+
+func (v *diagramGenerator_) PreprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	var parameter = setterMethod.GetParameter()
+	v.methods_[v.current_] = append(
+		v.methods_[v.current_],
+		setterMethod.GetName()+"("+parameter.GetName()+" "+
+			v.formatter_.FormatAbstraction(parameter.GetAbstraction())+")",
+	)
+}
+
+func (v *diagramGenerator_) PreprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = typeDeclaration.GetDeclaration()
+	var kind = "type"
+	if uti.IsDefined(typeDeclaration.GetOptionalEnumeration()) {
+		kind = "enumeration"
+	}
+	v.addNode(declaration.GetName(), kind, declaration)
+}
+
+func (v *diagramGenerator_) PostprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.current_ = ""
+}
+
+func (v *diagramGenerator_) PreprocessValue(
+	value ast.ValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.values_[v.current_] = append(v.values_[v.current_], value.GetName())
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *diagramGenerator_) addNode(
+	name string,
+	kind string,
+	declaration ast.DeclarationLike,
+) {
+	v.current_ = name
+	if len(v.kinds_[name]) > 0 {
+		// The instance interface shares the node of its class interface.
+		return
+	}
+	v.names_ = append(v.names_, name)
+	v.kinds_[name] = kind
+	var constraints = declaration.GetOptionalConstraints()
+	if uti.IsDefined(constraints) {
+		var list = []string{constraints.GetConstraint().GetName()}
+		var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+		for additionalConstraints.HasNext() {
+			var constraint = additionalConstraints.GetNext().GetConstraint()
+			list = append(list, constraint.GetName())
+		}
+		v.generics_[name] = sts.Join(list, ", ")
+	}
+}
+
+func (v *diagramGenerator_) analyzeModel(
+	model ast.ModelLike,
+) {
+	v.current_ = ""
+	v.names_ = nil
+	v.externals_ = nil
+	v.kinds_ = map[string]string{}
+	v.generics_ = map[string]string{}
+	v.values_ = map[string][]string{}
+	v.fields_ = map[string][]string{}
+	v.statics_ = map[string][]string{}
+	v.methods_ = map[string][]string{}
+	v.realizations_ = map[string][]string{}
+	v.embeddings_ = map[string][]string{}
+	v.references_ = map[string][]string{}
+	gra.VisitorClass().Visitor(v).VisitModel(model)
+}
+
+func (v *diagramGenerator_) contains(
+	list []string,
+	value string,
+) bool {
+	for _, candidate := range list {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *diagramGenerator_) formatRelations() []string {
+	var relations []string
+	for _, name := range v.names_ {
+		var related = map[string]bool{name: true}
+		for _, aspect := range v.realizations_[name] {
+			related[aspect] = true
+			relations = append(
+				relations,
+				name+" ..|> "+v.getIdentifier(aspect),
+			)
+		}
+		for _, aspect := range v.embeddings_[name] {
+			related[aspect] = true
+			relations = append(
+				relations,
+				v.getIdentifier(aspect)+" <|-- "+name,
+			)
+		}
+		var associations []string
+		for _, reference := range v.references_[name] {
+			var target = v.resolveReference(reference)
+			if len(target) == 0 || related[target] {
+				continue
+			}
+			related[target] = true
+			associations = append(associations, name+" --> "+target)
+		}
+		sor.Strings(associations)
+		relations = append(relations, associations...)
+	}
+	return relations
+}
+
+func (v *diagramGenerator_) getIdentifier(
+	aspect string,
+) string {
+	// External aspects are qualified by the alias of their package.
+	return sts.ReplaceAll(aspect, ".", "_")
+}
+
+func (v *diagramGenerator_) mermaidText(
+	text string,
+) string {
+	// Mermaid uses tildes to delimit generic type arguments.
+	text = sts.ReplaceAll(text, "[", "~")
+	return sts.ReplaceAll(text, "]", "~")
+}
+
+func (v *diagramGenerator_) resolveReference(
+	reference string,
+) string {
+	var candidates = []string{
+		reference,
+		sts.TrimSuffix(reference, "ClassLike"),
+		sts.TrimSuffix(reference, "Like"),
+	}
+	for _, candidate := range candidates {
+		if len(v.kinds_[candidate]) > 0 {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// Instance Structure
+
+type diagramGenerator_ struct {
+	// Declare the instance attributes.
+	formatter_    gra.FormatterLike
+	current_      string
+	names_        []string
+	externals_    []string
+	kinds_        map[string]string
+	generics_     map[string]string
+	values_       map[string][]string
+	fields_       map[string][]string
+	statics_      map[string][]string
+	methods_      map[string][]string
+	realizations_ map[string][]string
+	embeddings_   map[string][]string
+	references_   map[string][]string
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type diagramGeneratorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func diagramGeneratorClass() *diagramGeneratorClass_ {
+	return diagramGeneratorClassReference_
+}
+
+var diagramGeneratorClassReference_ = &diagramGeneratorClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,660 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	htm "html"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DocumentGeneratorClass() DocumentGeneratorClassLike {
+	return documentGeneratorClass()
+}
+
+// Constructor Methods
+
+func (c *documentGeneratorClass_) DocumentGenerator() DocumentGeneratorLike {
+	var instance = &documentGenerator_{
+		// Initialize the instance attributes.
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	instance.formatter_ = gra.FormatterClass().FormatterWithRendering(
+		instance.renderFragment,
+	)
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *documentGenerator_) GetClass() DocumentGeneratorClassLike {
+	return documentGeneratorClass()
+}
+
+func (v *documentGenerator_) GenerateHTML(
+	model ast.ModelLike,
+) string {
+	v.html_ = true
+	v.collectNames(model)
+	var name = model.GetPackageDeclaration().GetPackageHeader().GetName()
+	v.result_.Reset()
+	v.result_.WriteString(
+		uti.ReplaceAll(
+			htmlHeaderTemplate_,
+			"name",
+			name,
+		),
+	)
+	gra.VisitorClass().Visitor(v).VisitModel(model)
+	v.result_.WriteString(htmlFooterTemplate_)
+	return v.result_.String()
+}
+
+func (v *documentGenerator_) GenerateMarkdown(
+	model ast.ModelLike,
+) string {
+	v.html_ = false
+	v.collectNames(model)
+	v.result_.Reset()
+	gra.VisitorClass().Visitor(v).VisitModel(model)
+	return v.result_.String()
+}
+
+// Methodical Methods
+
+func (v *documentGenerator_) PreprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = aspectDeclaration.GetDeclaration()
+	v.appendDeclaration(declaration)
+	v.appendHeading(4, "Method Signatures", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(v.formatter_.FormatAbstraction(aspectInterface.GetAbstraction()))
+}
+
+func (v *documentGenerator_) PreprocessAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(v.formatMethod(aspectMethod.GetMethod()))
+}
+
+func (v *documentGenerator_) PreprocessAspectSection(
+	aspectSection ast.AspectSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	if !aspectSection.GetAspectDeclarations().IsEmpty() {
+		v.appendHeading(2, "Aspect Declarations", "")
+	}
+}
+
+func (v *documentGenerator_) PreprocessAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(4, "Aspect Interfaces", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(4, "Attribute Methods", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendDeclaration(classDeclaration.GetDeclaration())
+}
+
+func (v *documentGenerator_) PreprocessClassSection(
+	classSection ast.ClassSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(2, "Class Declarations", "")
+}
+
+func (v *documentGenerator_) PreprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(
+		v.formatText(constantMethod.GetName()) + "() " +
+			v.formatter_.FormatAbstraction(constantMethod.GetAbstraction()),
+	)
+}
+
+func (v *documentGenerator_) PreprocessConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(4, "Constant Methods", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(
+		v.formatText(constructorMethod.GetName()) +
+			v.formatter_.FormatParameterList(constructorMethod.GetOptionalParameterList()) + " " +
+			v.formatter_.FormatAbstraction(constructorMethod.GetAbstraction()),
+	)
+}
+
+func (v *documentGenerator_) PreprocessConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(4, "Constructor Methods", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(
+		v.formatText(functionMethod.GetName()) +
+			v.formatter_.FormatParameterList(functionMethod.GetOptionalParameterList()) +
+			v.formatter_.FormatResult(functionMethod.GetResult()),
+	)
+}
+
+func (v *documentGenerator_) PreprocessFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(4, "Function Methods", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = functionalDeclaration.GetDeclaration()
+	v.appendDeclaration(declaration)
+	var functional = functionalDeclaration.GetFunctional()
+	var signature = "type " + v.formatDeclaration(declaration) + " func" +
+		v.formatter_.FormatParameterList(functional.GetOptionalParameterList())
+	var result = functional.GetOptionalResult()
+	if uti.IsDefined(result) {
+		signature += v.formatter_.FormatResult(result)
+	}
+	v.beginList()
+	v.appendItem(signature)
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	if !functionalSection.GetFunctionalDeclarations().IsEmpty() {
+		v.appendHeading(2, "Functional Declarations", "")
+	}
+}
+
+func (v *documentGenerator_) PreprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(
+		v.formatText(getterMethod.GetName()) + "() " +
+			v.formatter_.FormatAbstraction(getterMethod.GetAbstraction()),
+	)
+}
+
+func (v *documentGenerator_) PreprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendDeclaration(instanceDeclaration.GetDeclaration())
+}
+
+func (v *documentGenerator_) PreprocessInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(2, "Instance Declarations", "")
+}
+
+func (v *documentGenerator_) PreprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	var name = packageHeader.GetName()
+	v.appendHeading(1, "Package "+name, name)
+	v.appendComment(packageHeader.GetComment())
+}
+
+func (v *documentGenerator_) PreprocessPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendItem(v.formatMethod(principalMethod.GetMethod()))
+}
+
+func (v *documentGenerator_) PreprocessPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendHeading(4, "Principal Methods", "")
+	v.beginList()
+}
+
+func (v *documentGenerator_) PostprocessPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.endList()
+}
+
+func (v *documentGenerator_) PreprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	var parameter = setterMethod.GetParameter()
+	v.appendItem(
+		v.formatText(setterMethod.GetName()) + "(" +
+			v.formatText(parameter.GetName()) + " " +
+			v.formatter_.FormatAbstraction(parameter.GetAbstraction()) + ")",
+	)
+}
+
+func (v *documentGenerator_) PreprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = typeDeclaration.GetDeclaration()
+	v.appendDeclaration(declaration)
+	v.beginList()
+	v.appendItem(
+		"type " + v.formatDeclaration(declaration) + " " +
+			v.formatter_.FormatAbstraction(typeDeclaration.GetAbstraction()),
+	)
+	v.endList()
+	var enumeration = typeDeclaration.GetOptionalEnumeration()
+	if uti.IsDefined(enumeration) {
+		v.appendHeading(4, "Enumerated Values", "")
+		v.beginList()
+		v.appendItem(v.formatText(enumeration.GetValue().GetName()))
+		var additionalValues = enumeration.GetAdditionalValues().GetIterator()
+		for additionalValues.HasNext() {
+			var additionalValue = additionalValues.GetNext()
+			v.appendItem(v.formatText(additionalValue.GetName()))
+		}
+		v.endList()
+	}
+}
+
+func (v *documentGenerator_) PreprocessTypeSection(
+	typeSection ast.TypeSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	if !typeSection.GetTypeDeclarations().IsEmpty() {
+		v.appendHeading(2, "Type Declarations", "")
+	}
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *documentGenerator_) appendComment(
+	comment string,
+) {
+	var lines = sts.Split(comment, "\n")
+	var paragraphs []string
+	var paragraph []string
+	var inBox bool
+	for _, line := range lines {
+		switch {
+		case line == "/*" || line == "*/":
+			continue
+		case sts.HasPrefix(line, "┌"):
+			// Skip any generated warning box.
+			inBox = true
+			continue
+		case inBox:
+			inBox = !sts.HasPrefix(line, "└")
+			continue
+		case len(sts.TrimSpace(line)) == 0:
+			if len(paragraph) > 0 {
+				paragraphs = append(paragraphs, sts.Join(paragraph, "\n"))
+				paragraph = nil
+			}
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	if len(paragraph) > 0 {
+		paragraphs = append(paragraphs, sts.Join(paragraph, "\n"))
+	}
+	for _, paragraph := range paragraphs {
+		if v.html_ {
+			v.result_.WriteString("<p>" + htm.EscapeString(paragraph) + "</p>\n")
+		} else {
+			v.result_.WriteString(paragraph + "\n\n")
+		}
+	}
+}
+
+func (v *documentGenerator_) appendDeclaration(
+	declaration ast.DeclarationLike,
+) {
+	var name = declaration.GetName()
+	v.appendHeading(3, name, name)
+	v.appendComment(declaration.GetComment())
+}
+
+func (v *documentGenerator_) appendHeading(
+	level int,
+	text string,
+	anchor string,
+) {
+	if v.html_ {
+		var tag = "h" + string(rune('0'+level))
+		var identifier string
+		if len(anchor) > 0 {
+			identifier = " id=\"" + anchor + "\""
+		}
+		v.result_.WriteString(
+			"<" + tag + identifier + ">" + htm.EscapeString(text) + "</" + tag + ">\n",
+		)
+	} else {
+		v.result_.WriteString(sts.Repeat("#", level) + " " + text + "\n\n")
+	}
+}
+
+func (v *documentGenerator_) appendItem(
+	signature string,
+) {
+	if v.html_ {
+		v.result_.WriteString("<li><code>" + signature + "</code></li>\n")
+	} else {
+		v.result_.WriteString("- " + signature + "\n")
+	}
+}
+
+func (v *documentGenerator_) beginList() {
+	if v.html_ {
+		v.result_.WriteString("<ul>\n")
+	}
+}
+
+func (v *documentGenerator_) collectNames(
+	model ast.ModelLike,
+) {
+	v.names_ = map[string]bool{}
+	var primitives = model.GetPrimitiveDeclarations()
+	var types = primitives.GetTypeSection().GetTypeDeclarations().GetIterator()
+	for types.HasNext() {
+		v.names_[types.GetNext().GetDeclaration().GetName()] = true
+	}
+	var functionals = primitives.GetFunctionalSection().GetFunctionalDeclarations().GetIterator()
+	for functionals.HasNext() {
+		v.names_[functionals.GetNext().GetDeclaration().GetName()] = true
+	}
+	var interfaces = model.GetInterfaceDeclarations()
+	var classes = interfaces.GetClassSection().GetClassDeclarations().GetIterator()
+	for classes.HasNext() {
+		v.names_[classes.GetNext().GetDeclaration().GetName()] = true
+	}
+	var instances = interfaces.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instances.HasNext() {
+		v.names_[instances.GetNext().GetDeclaration().GetName()] = true
+	}
+	var aspects = interfaces.GetAspectSection().GetAspectDeclarations().GetIterator()
+	for aspects.HasNext() {
+		v.names_[aspects.GetNext().GetDeclaration().GetName()] = true
+	}
+}
+
+func (v *documentGenerator_) endList() {
+	if v.html_ {
+		v.result_.WriteString("</ul>\n")
+	} else {
+		v.result_.WriteString("\n")
+	}
+}
+
+func (v *documentGenerator_) formatDeclaration(
+	declaration ast.DeclarationLike,
+) string {
+	var result = v.formatText(declaration.GetName())
+	var constraints = declaration.GetOptionalConstraints()
+	if uti.IsDefined(constraints) {
+		var constraint = constraints.GetConstraint()
+		var list = []string{
+			v.formatText(constraint.GetName()) + " " +
+				v.formatter_.FormatAbstraction(constraint.GetAbstraction()),
+		}
+		var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+		for additionalConstraints.HasNext() {
+			constraint = additionalConstraints.GetNext().GetConstraint()
+			list = append(
+				list,
+				v.formatText(constraint.GetName())+" "+
+					v.formatter_.FormatAbstraction(constraint.GetAbstraction()),
+			)
+		}
+		result += v.formatText("[") + sts.Join(list, ", ") + v.formatText("]")
+	}
+	return result
+}
+
+func (v *documentGenerator_) formatMethod(
+	method ast.MethodLike,
+) string {
+	return v.formatText(method.GetName()) +
+		v.formatter_.FormatParameterList(method.GetOptionalParameterList()) +
+		v.formatter_.FormatResult(method.GetResult())
+}
+
+func (v *documentGenerator_) formatName(
+	name string,
+) string {
+	// Only types declared in this model can be cross-linked.
+	if !v.names_[name] {
+		return v.formatText(name)
+	}
+	if v.html_ {
+		return "<a href=\"#" + name + "\">" + name + "</a>"
+	}
+	return "[" + name + "](#" + sts.ToLower(name) + ")"
+}
+
+func (v *documentGenerator_) formatText(
+	text string,
+) string {
+	if v.html_ {
+		return htm.EscapeString(text)
+	}
+	// Escape the characters that have a special meaning in Markdown.
+	for _, character := range []string{"\\", "[", "]", "*", "_"} {
+		text = sts.ReplaceAll(text, character, "\\"+character)
+	}
+	return text
+}
+
+func (v *documentGenerator_) renderFragment(
+	fragment string,
+	name string,
+) string {
+	if name == "Named" {
+		return v.formatName(fragment)
+	}
+	return v.formatText(fragment)
+}
+
+// Instance Structure
+
+type documentGenerator_ struct {
+	// Declare the instance attributes.
+	formatter_ gra.FormatterLike
+	html_      bool
+	names_     map[string]bool
+	result_    sts.Builder
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type documentGeneratorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func documentGeneratorClass() *documentGeneratorClass_ {
+	return documentGeneratorClassReference_
+}
+
+var documentGeneratorClassReference_ = &documentGeneratorClass_{
+	// Initialize the class constants.
+}
+
+// Private Constants
+
+// NOTE:
+// These private constants define the boilerplate that makes each HTML document
+// self-contained.  The placeholders in angle brackets are replaced using the
+// conventions defined by the uti.ReplaceAll() function.
+const htmlHeaderTemplate_ = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Package <name></title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 60em; }
+h3 { border-top: 1px solid #ccc; padding-top: 1em; }
+p { white-space: pre-wrap; }
+code { font-family: monospace; }
+ul { list-style: none; padding-left: 1em; }
+</style>
+</head>
+<body>
+`
+
+const htmlFooterTemplate_ = `</body>
+</html>
+`
@@ -0,0 +1,511 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	gof "go/format"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func FakeGeneratorClass() FakeGeneratorClassLike {
+	return fakeGeneratorClass()
+}
+
+// Constructor Methods
+
+func (c *fakeGeneratorClass_) FakeGenerator() FakeGeneratorLike {
+	var instance = &fakeGenerator_{
+		// Initialize the instance attributes.
+	}
+	instance.formatter_ = gra.FormatterClass().FormatterWithRendering(
+		instance.renderFragment,
+	)
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *fakeGenerator_) GetClass() FakeGeneratorClassLike {
+	return fakeGeneratorClass()
+}
+
+func (v *fakeGenerator_) GenerateFakes(
+	model ast.ModelLike,
+) string {
+	var declaration = model.GetPackageDeclaration()
+	var notice = declaration.GetLegalNotice().GetComment()
+	var packageName = declaration.GetPackageHeader().GetName()
+	v.prefixes_ = com.Set[string]()
+	v.substitutions_ = map[string]string{}
+
+	// Index the aspect declarations by name so that they can be expanded.
+	v.aspects_ = map[string]ast.AspectDeclarationLike{}
+	var interfaces = model.GetInterfaceDeclarations()
+	var aspects = interfaces.GetAspectSection().GetAspectDeclarations().GetIterator()
+	for aspects.HasNext() {
+		var aspect = aspects.GetNext()
+		v.aspects_[aspect.GetDeclaration().GetName()] = aspect
+	}
+
+	// Generate a fake for each instance declaration.
+	var fakes string
+	var instances = interfaces.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instances.HasNext() {
+		var instance = instances.GetNext()
+		var methods = v.newMethods()
+		var instanceMethods = instance.GetInstanceMethods()
+		var principalMethods = instanceMethods.GetPrincipalSubsection().GetPrincipalMethods().GetIterator()
+		for principalMethods.HasNext() {
+			v.addMethod(methods, principalMethods.GetNext().GetMethod())
+		}
+		var attributeSubsection = instanceMethods.GetOptionalAttributeSubsection()
+		if uti.IsDefined(attributeSubsection) {
+			var attributeMethods = attributeSubsection.GetAttributeMethods().GetIterator()
+			for attributeMethods.HasNext() {
+				switch actual := attributeMethods.GetNext().GetAny().(type) {
+				case ast.GetterMethodLike:
+					v.addMethod(methods, v.makeGetter(actual))
+				case ast.SetterMethodLike:
+					v.addMethod(methods, v.makeSetter(actual))
+				}
+			}
+		}
+		var aspectSubsection = instanceMethods.GetOptionalAspectSubsection()
+		if uti.IsDefined(aspectSubsection) {
+			var aspectInterfaces = aspectSubsection.GetAspectInterfaces().GetIterator()
+			for aspectInterfaces.HasNext() {
+				v.addAspect(methods, aspectInterfaces.GetNext().GetAbstraction())
+			}
+		}
+		var name = instance.GetDeclaration().GetName()
+		fakes += v.generateFake(
+			sts.TrimSuffix(name, "Like"),
+			name,
+			instance.GetDeclaration(),
+			methods,
+		)
+	}
+
+	// Generate a fake for each aspect declaration.
+	aspects = interfaces.GetAspectSection().GetAspectDeclarations().GetIterator()
+	for aspects.HasNext() {
+		var aspect = aspects.GetNext()
+		var methods = v.newMethods()
+		v.addMembers(methods, aspect)
+		var name = aspect.GetDeclaration().GetName()
+		fakes += v.generateFake(name, name, aspect.GetDeclaration(), methods)
+	}
+
+	// Import only the packages that are referenced by the fakes.
+	var importedPackages string
+	var importList = declaration.GetPackageImports().GetOptionalImportList()
+	if uti.IsDefined(importList) {
+		var packages = importList.GetImportedPackages().GetIterator()
+		for packages.HasNext() {
+			var importedPackage = packages.GetNext()
+			if v.prefixes_.ContainsValue(importedPackage.GetName()) {
+				importedPackages += fmt.Sprintf(
+					"\n\t%s %s",
+					importedPackage.GetName(),
+					importedPackage.GetString(),
+				)
+			}
+		}
+	}
+	var source = fakesTemplate_
+	source = uti.ReplaceAll(source, "notice", notice)
+	source = uti.ReplaceAll(source, "packageName", packageName)
+	source = uti.ReplaceAll(source, "importedPackages", importedPackages)
+	source = uti.ReplaceAll(source, "fakes", fakes)
+	var bytes, err = gof.Source([]byte(source))
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated source code is not valid Go: %v\n%s",
+			err,
+			source,
+		)
+		panic(message)
+	}
+	return string(bytes)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *fakeGenerator_) addAspect(
+	methods *fakeMethods_,
+	abstraction ast.AbstractionLike,
+) {
+	var named, ok = abstraction.GetType().GetAny().(ast.NamedLike)
+	if !ok {
+		return
+	}
+	var aspect, found = v.aspects_[named.GetName()]
+	if uti.IsDefined(named.GetOptionalPrefix()) || !found {
+		// Aspects declared by other packages are embedded in the fake.
+		var embedded = v.formatter_.FormatAbstraction(abstraction)
+		if !sts.Contains(methods.embedded_, "\t"+embedded+"\n") {
+			methods.embedded_ += "\t" + embedded + "\n"
+		}
+		return
+	}
+
+	// Substitute the aspect arguments for its type parameters.
+	var substitutions = map[string]string{}
+	var constraints = v.getConstraints(aspect.GetDeclaration())
+	var arguments = v.getArguments(named)
+	for index, constraint := range constraints {
+		if index < len(arguments) {
+			substitutions[constraint.GetName()] = v.formatter_.FormatAbstraction(arguments[index])
+		}
+	}
+	var previous = v.substitutions_
+	v.substitutions_ = substitutions
+	v.addMembers(methods, aspect)
+	v.substitutions_ = previous
+}
+
+func (v *fakeGenerator_) addMembers(
+	methods *fakeMethods_,
+	aspect ast.AspectDeclarationLike,
+) {
+	var members = aspect.GetAspectMembers().GetIterator()
+	for members.HasNext() {
+		switch actual := members.GetNext().GetAny().(type) {
+		case ast.AspectMethodLike:
+			v.addMethod(methods, actual.GetMethod())
+		case ast.AspectInterfaceLike:
+			v.addAspect(methods, actual.GetAbstraction())
+		}
+	}
+}
+
+func (v *fakeGenerator_) addMethod(
+	methods *fakeMethods_,
+	method ast.MethodLike,
+) {
+	var methodName = method.GetName()
+	if methods.names_[methodName] {
+		// The method is declared by more than one aspect.
+		return
+	}
+	methods.names_[methodName] = true
+
+	// Each method records the number of calls and the arguments for each call.
+	var fields = fmt.Sprintf("\t%sCount int\n", methodName)
+	var statements = fmt.Sprintf("\tv.%sCount++\n", methodName)
+	var parameters []string
+	var captures []string
+	var values []string
+	for _, parameter := range v.getParameters(method.GetOptionalParameterList()) {
+		var name = parameter.GetName()
+		var abstraction = v.formatter_.FormatAbstraction(parameter.GetAbstraction())
+		parameters = append(parameters, name+" "+abstraction)
+		var capture = "[]" + sts.TrimPrefix(abstraction, "...")
+		if capture == "[]"+abstraction {
+			capture = abstraction
+		}
+		captures = append(
+			captures,
+			uti.MakeUpperCase(sts.TrimSuffix(name, "_"))+" "+capture,
+		)
+		values = append(values, name)
+	}
+	if len(captures) > 0 {
+		var arguments = "struct {\n\t\t" + sts.Join(captures, "\n\t\t") + "\n\t}"
+		fields += fmt.Sprintf("\t%sArguments []%s\n", methodName, arguments)
+		statements += fmt.Sprintf(
+			"\tv.%sArguments = append(v.%sArguments, %s{%s})\n",
+			methodName,
+			methodName,
+			arguments,
+			sts.Join(values, ", "),
+		)
+	}
+
+	// Each method returns the configured result values.
+	var result string
+	switch actual := method.GetResult().GetAny().(type) {
+	case ast.AbstractionLike:
+		var abstraction = v.formatter_.FormatAbstraction(actual)
+		result = " " + abstraction
+		fields += fmt.Sprintf("\t%sResult %s\n", methodName, abstraction)
+		statements += fmt.Sprintf("\treturn v.%sResult\n", methodName)
+	case ast.MultivalueLike:
+		var results []string
+		var returns []string
+		for _, parameter := range v.getParameters(actual.GetParameterList()) {
+			var name = parameter.GetName()
+			var abstraction = v.formatter_.FormatAbstraction(parameter.GetAbstraction())
+			var field = methodName + uti.MakeUpperCase(sts.TrimSuffix(name, "_"))
+			results = append(results, name+" "+abstraction)
+			fields += fmt.Sprintf("\t%s %s\n", field, abstraction)
+			returns = append(returns, "v."+field)
+		}
+		result = " (" + sts.Join(results, ", ") + ")"
+		statements += "\treturn " + sts.Join(returns, ", ") + "\n"
+	}
+	methods.fields_ += fields
+	methods.signatures_ = append(
+		methods.signatures_,
+		methodName+"("+sts.Join(parameters, ", ")+")"+result,
+	)
+	methods.statements_ = append(methods.statements_, statements)
+}
+
+func (v *fakeGenerator_) formatName(
+	name string,
+) string {
+	var substitution, found = v.substitutions_[name]
+	if found {
+		return substitution
+	}
+	return name
+}
+
+func (v *fakeGenerator_) generateFake(
+	fakeName string,
+	interfaceName string,
+	declaration ast.DeclarationLike,
+	methods *fakeMethods_,
+) string {
+	// Generic fakes use the same constraints as the interface they implement.
+	var parameters, arguments string
+	var assertion = fakeAssertionTemplate_
+	var constraints = v.getConstraints(declaration)
+	if len(constraints) > 0 {
+		var parameterList, argumentList []string
+		for _, constraint := range constraints {
+			parameterList = append(
+				parameterList,
+				constraint.GetName()+" "+v.formatter_.FormatAbstraction(constraint.GetAbstraction()),
+			)
+			argumentList = append(argumentList, constraint.GetName())
+		}
+		parameters = "[" + sts.Join(parameterList, ", ") + "]"
+		arguments = "[" + sts.Join(argumentList, ", ") + "]"
+		assertion = genericAssertionTemplate_
+	}
+	var implementations string
+	for index, signature := range methods.signatures_ {
+		var implementation = fakeMethodTemplate_
+		implementation = uti.ReplaceAll(implementation, "signature", signature)
+		implementation = uti.ReplaceAll(implementation, "statements", methods.statements_[index])
+		implementations += implementation
+	}
+	var fake = fakeTemplate_
+	fake = uti.ReplaceAll(fake, "assertion", assertion)
+	fake = uti.ReplaceAll(fake, "fields", methods.embedded_+methods.fields_)
+	fake = uti.ReplaceAll(fake, "methods", implementations)
+	fake = uti.ReplaceAll(fake, "fakeName", "Fake"+fakeName)
+	fake = uti.ReplaceAll(fake, "interfaceName", interfaceName)
+	fake = uti.ReplaceAll(fake, "parameters", parameters)
+	fake = uti.ReplaceAll(fake, "arguments", arguments)
+	return fake
+}
+
+func (v *fakeGenerator_) getArguments(
+	named ast.NamedLike,
+) []ast.AbstractionLike {
+	var list []ast.AbstractionLike
+	var arguments = named.GetOptionalArguments()
+	if uti.IsDefined(arguments) {
+		list = append(list, arguments.GetArgument().GetAbstraction())
+		var additionalArguments = arguments.GetAdditionalArguments().GetIterator()
+		for additionalArguments.HasNext() {
+			list = append(list, additionalArguments.GetNext().GetArgument().GetAbstraction())
+		}
+	}
+	return list
+}
+
+func (v *fakeGenerator_) getConstraints(
+	declaration ast.DeclarationLike,
+) []ast.ConstraintLike {
+	var list []ast.ConstraintLike
+	var constraints = declaration.GetOptionalConstraints()
+	if uti.IsDefined(constraints) {
+		list = append(list, constraints.GetConstraint())
+		var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+		for additionalConstraints.HasNext() {
+			list = append(list, additionalConstraints.GetNext().GetConstraint())
+		}
+	}
+	return list
+}
+
+func (v *fakeGenerator_) getParameters(
+	parameterList ast.ParameterListLike,
+) []ast.ParameterLike {
+	var parameters []ast.ParameterLike
+	if uti.IsDefined(parameterList) {
+		var iterator = parameterList.GetParameters().GetIterator()
+		for iterator.HasNext() {
+			parameters = append(parameters, iterator.GetNext())
+		}
+	}
+	return parameters
+}
+
+func (v *fakeGenerator_) makeGetter(
+	getter ast.GetterMethodLike,
+) ast.MethodLike {
+	return ast.MethodClass().Method(
+		getter.GetOptionalNote(),
+		getter.GetName(),
+		"(",
+		nil,
+		")",
+		ast.ResultClass().Result(getter.GetAbstraction()),
+	)
+}
+
+func (v *fakeGenerator_) makeSetter(
+	setter ast.SetterMethodLike,
+) ast.MethodLike {
+	return ast.MethodClass().Method(
+		setter.GetOptionalNote(),
+		setter.GetName(),
+		"(",
+		ast.ParameterListClass().ParameterList(
+			com.ListFromArray([]ast.ParameterLike{setter.GetParameter()}),
+		),
+		")",
+		ast.ResultClass().Result(ast.NoneClass().None("\n")),
+	)
+}
+
+func (v *fakeGenerator_) newMethods() *fakeMethods_ {
+	return &fakeMethods_{
+		names_: map[string]bool{},
+	}
+}
+
+func (v *fakeGenerator_) renderFragment(
+	fragment string,
+	name string,
+) string {
+	if name != "Named" {
+		return fragment
+	}
+	var prefix, _, found = sts.Cut(fragment, ".")
+	if found {
+		v.prefixes_.AddValue(prefix)
+		return fragment
+	}
+	return v.formatName(fragment)
+}
+
+// Instance Structure
+
+type fakeGenerator_ struct {
+	// Declare the instance attributes.
+	formatter_     gra.FormatterLike
+	aspects_       map[string]ast.AspectDeclarationLike
+	prefixes_      com.SetLike[string]
+	substitutions_ map[string]string
+}
+
+// The methods that are implemented by a single fake.
+type fakeMethods_ struct {
+	names_      map[string]bool
+	embedded_   string
+	fields_     string
+	signatures_ []string
+	statements_ []string
+}
+
+// Class Structure
+
+type fakeGeneratorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func fakeGeneratorClass() *fakeGeneratorClass_ {
+	return fakeGeneratorClassReference_
+}
+
+var fakeGeneratorClassReference_ = &fakeGeneratorClass_{
+	// Initialize the class constants.
+}
+
+// Private Constants
+
+// NOTE:
+// The following templates use the "<name>" placeholder syntax supported by the
+// uti.ReplaceAll() function.
+
+const fakesTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│                This file was automatically generated using:                  │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package <packageName>
+
+import (<importedPackages>
+)
+<fakes>`
+
+const fakeTemplate_ = `
+/*
+<FakeName> is a recording fake that implements the <InterfaceName>
+interface.  Each method counts its calls, captures its arguments and returns
+the result values that have been assigned to its fields.
+*/
+type <FakeName><parameters> struct {
+<fields>}
+
+<assertion><methods>`
+
+const fakeAssertionTemplate_ = `
+var _ <InterfaceName> = (*<FakeName>)(nil)
+`
+
+const genericAssertionTemplate_ = `
+func _<parameters>() {
+	var _ <InterfaceName><arguments> = (*<FakeName><arguments>)(nil)
+}
+`
+
+const fakeMethodTemplate_ = `
+func (v *<FakeName><arguments>) <signature> {
+<statements>}
+`
@@ -0,0 +1,2431 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	gof "go/format"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func GeneratorClass() GeneratorClassLike {
+	return generatorClass()
+}
+
+// Constructor Methods
+
+func (c *generatorClass_) Generator() GeneratorLike {
+	var instance = &generator_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *generator_) GetClass() GeneratorClassLike {
+	return generatorClass()
+}
+
+func (v *generator_) GenerateCompositeProcessor(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var tokens = syntax.GetTokens().GetIterator()
+	for tokens.HasNext() {
+		var method = compositeTokenTemplate_
+		method = uti.ReplaceAll(method, "tokenName", tokens.GetNext())
+		methods += method
+	}
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		var method = compositeRuleTemplate_
+		method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+		methods += method
+	}
+	var source = compositeProcessorTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateDecoder(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		if rule.IsAlternatives() {
+			methods += v.generateDecodeAlternatives(rule)
+		} else {
+			methods += v.generateDecodeTerms(rule)
+		}
+	}
+	var source = decoderTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateEncoder(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		if rule.IsAlternatives() {
+			methods += v.generateEncodeAlternatives(rule)
+		} else {
+			methods += v.generateEncodeTerms(rule)
+		}
+	}
+	var source = encoderTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateInspector(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var tokens = syntax.GetTokens().GetIterator()
+	for tokens.HasNext() {
+		var method = inspectTokenTemplate_
+		method = uti.ReplaceAll(method, "tokenName", tokens.GetNext())
+		methods += method
+	}
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		var method = inspectRuleTemplate_
+		method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+		methods += method
+	}
+	var source = inspectorTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateParser(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		if rule.IsAlternatives() {
+			methods += v.generateParseAlternatives(rule)
+		} else {
+			methods += v.generateParseTerms(rule)
+		}
+	}
+	var definitions string
+	var iterator = syntax.GetRules().GetIterator()
+	for iterator.HasNext() {
+		var rule = iterator.GetNext()
+		definitions += fmt.Sprintf(
+			"\t\t\t%q: `%s`,\n",
+			"$"+rule.GetName(),
+			rule.GetDefinition(),
+		)
+	}
+	var source = parserTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	source = uti.ReplaceAll(source, "definitions", definitions)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateProcessor(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var tokens = syntax.GetTokens().GetIterator()
+	for tokens.HasNext() {
+		var method = processTokenTemplate_
+		method = uti.ReplaceAll(method, "tokenName", tokens.GetNext())
+		methods += method
+	}
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		var method = processRuleTemplate_
+		method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+		methods += method
+	}
+	var source = processorTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateSchema(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var definitions = map[string]any{}
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		if rule.IsAlternatives() {
+			definitions[rule.GetName()] = v.generateSchemaAlternatives(rule)
+		} else {
+			definitions[rule.GetName()] = v.generateSchemaTerms(rule)
+		}
+	}
+	var rootName = syntax.GetRules().GetIterator().GetNext().GetName()
+	var schema = map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     "https://" + moduleName + "/model.schema.json",
+		"title":   "The JSON encoding of a " + rootName + " abstract syntax tree.",
+		"$ref":    "#/$defs/" + rootName,
+		"$defs":   definitions,
+	}
+	var bytes, err = jsn.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated schema is not valid JSON: %v",
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes) + "\n"
+}
+
+func (v *generator_) GenerateTransformer(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		if rule.IsAlternatives() {
+			methods += v.generateTransformAlternatives(rule)
+		} else {
+			methods += v.generateTransformTerms(rule)
+		}
+	}
+	var source = transformerTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+func (v *generator_) GenerateVisitor(
+	moduleName string,
+	syntax SyntaxLike,
+) string {
+	var methods string
+	var rules = v.sortRules(syntax)
+	for _, rule := range rules {
+		if rule.IsAlternatives() {
+			methods += v.generateVisitAlternatives(rule)
+		} else {
+			methods += v.generateVisitTerms(rule)
+		}
+	}
+	var source = visitorTemplate_
+	source = uti.ReplaceAll(source, "methods", methods)
+	return v.generateClass(moduleName, syntax, source)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *generator_) generateClass(
+	moduleName string,
+	syntax SyntaxLike,
+	source string,
+) string {
+	var rootName = syntax.GetRules().GetIterator().GetNext().GetName()
+	source = uti.ReplaceAll(source, "notice", syntax.GetNotice())
+	source = uti.ReplaceAll(source, "moduleName", moduleName)
+	source = uti.ReplaceAll(source, "rootName", rootName)
+	var bytes, err = gof.Source([]byte(source))
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated source code is not valid Go: %v\n%s",
+			err,
+			source,
+		)
+		panic(message)
+	}
+	return string(bytes)
+}
+
+func (v *generator_) generateDecodeAlternatives(
+	rule RuleLike,
+) string {
+	var cases string
+	var hasString bool
+	var iterator = rule.GetAlternatives().GetIterator()
+	for iterator.HasNext() {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		if term.IsLiteral() || term.IsToken() {
+			hasString = true
+			continue
+		}
+		var alternative = decodeRuleCaseTemplate_
+		alternative = uti.ReplaceAll(alternative, "alternativeName", term.GetText())
+		cases += alternative
+	}
+	var kinds string
+	if hasString {
+		kinds += decodeStringCaseTemplate_
+	}
+	if len(cases) > 0 {
+		var kind = decodeRuleCasesTemplate_
+		kind = uti.ReplaceAll(kind, "cases", cases)
+		kinds += kind
+	}
+	var method = decodeAlternativesTemplate_
+	method = uti.ReplaceAll(method, "kinds", kinds)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateDecodeTerms(
+	rule RuleLike,
+) string {
+	var terms string
+	var names = v.getTermNames(rule)
+	var iterator = rule.GetTerms().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = iterator.GetNext()
+		var template string
+		switch {
+		case term.IsLiteral(), term.IsToken():
+			template = v.selectTemplate(
+				term,
+				decodeSingleTokenTemplate_,
+				decodeOptionalTokenTemplate_,
+				"",
+			)
+		default:
+			template = v.selectTemplate(
+				term,
+				decodeSingleRuleTemplate_,
+				decodeOptionalRuleTemplate_,
+				decodeMultipleRulesTemplate_,
+			)
+		}
+		template = uti.ReplaceAll(template, "termName", term.GetText())
+		template = uti.ReplaceAll(template, "variableName", names[index])
+		template = uti.ReplaceAll(
+			template,
+			"attributeName",
+			sts.TrimSuffix(names[index], "_"),
+		)
+		terms += template
+	}
+	var method = decodeTermsTemplate_
+	method = uti.ReplaceAll(method, "terms", terms)
+	method = uti.ReplaceAll(method, "arguments", v.formatArguments(names))
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateEncodeAlternatives(
+	rule RuleLike,
+) string {
+	var cases string
+	var hasString bool
+	var iterator = rule.GetAlternatives().GetIterator()
+	for iterator.HasNext() {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		if term.IsLiteral() || term.IsToken() {
+			hasString = true
+			continue
+		}
+		var alternative = encodeRuleCaseTemplate_
+		alternative = uti.ReplaceAll(alternative, "alternativeName", term.GetText())
+		cases += alternative
+	}
+	if hasString {
+		cases += encodeStringCaseTemplate_
+	}
+	var method = encodeAlternativesTemplate_
+	method = uti.ReplaceAll(method, "cases", cases)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateEncodeTerms(
+	rule RuleLike,
+) string {
+	var terms string
+	var names = v.getTermNames(rule)
+	var iterator = rule.GetTerms().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = iterator.GetNext()
+		var template string
+		switch {
+		case term.IsLiteral(), term.IsToken():
+			template = v.selectTemplate(
+				term,
+				encodeSingleTokenTemplate_,
+				encodeOptionalTokenTemplate_,
+				"",
+			)
+		default:
+			template = v.selectTemplate(
+				term,
+				encodeSingleRuleTemplate_,
+				encodeOptionalRuleTemplate_,
+				encodeMultipleRulesTemplate_,
+			)
+		}
+		template = uti.ReplaceAll(template, "termName", term.GetText())
+		template = uti.ReplaceAll(template, "variableName", names[index])
+		template = uti.ReplaceAll(
+			template,
+			"attributeName",
+			sts.TrimSuffix(names[index], "_"),
+		)
+		terms += template
+	}
+	var method = encodeTermsTemplate_
+	method = uti.ReplaceAll(method, "terms", terms)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateParseAlternatives(
+	rule RuleLike,
+) string {
+	var alternatives string
+	var names = v.getAlternativeNames(rule)
+	var iterator = rule.GetAlternatives().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		var alternative string
+		switch {
+		case term.IsLiteral():
+			alternative = parseLiteralAlternativeTemplate_
+			alternative = uti.ReplaceAll(alternative, "literal", term.GetText())
+		case term.IsToken():
+			alternative = parseTokenAlternativeTemplate_
+			alternative = uti.ReplaceAll(alternative, "tokenName", term.GetText())
+		default:
+			alternative = parseRuleAlternativeTemplate_
+			alternative = uti.ReplaceAll(alternative, "alternativeName", term.GetText())
+		}
+		alternative = uti.ReplaceAll(alternative, "variableName", names[index])
+		alternatives += alternative
+	}
+	var method = parseAlternativesTemplate_
+	method = uti.ReplaceAll(method, "alternatives", alternatives)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateParseTerms(
+	rule RuleLike,
+) string {
+	var terms string
+	var arguments []string
+	var names = v.getTermNames(rule)
+	var iterator = rule.GetTerms().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = iterator.GetNext()
+		var template string
+		switch {
+		case term.IsLiteral():
+			template = v.selectTemplate(
+				term,
+				parseSingleLiteralTemplate_,
+				"",
+				"",
+			)
+		case term.IsToken():
+			template = v.selectTemplate(
+				term,
+				parseSingleTokenTemplate_,
+				parseOptionalTokenTemplate_,
+				"",
+			)
+		default:
+			template = v.selectTemplate(
+				term,
+				parseSingleRuleTemplate_,
+				parseOptionalRuleTemplate_,
+				parseMultipleRulesTemplate_,
+			)
+		}
+		var minimum = "0"
+		if term.GetCardinality() == OneOrMoreCardinality {
+			minimum = "1"
+		}
+		template = uti.ReplaceAll(template, "minimum", minimum)
+		template = uti.ReplaceAll(template, "literal", term.GetText())
+		template = uti.ReplaceAll(template, "tokenName", term.GetText())
+		template = uti.ReplaceAll(template, "termName", term.GetText())
+		template = uti.ReplaceAll(template, "variableName", names[index])
+		terms += template
+		arguments = append(arguments, names[index])
+	}
+	var method = parseTermsTemplate_
+	method = uti.ReplaceAll(method, "terms", terms)
+	method = uti.ReplaceAll(method, "arguments", v.formatArguments(arguments))
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateSchemaAlternatives(
+	rule RuleLike,
+) map[string]any {
+	var alternatives []any
+	var hasString bool
+	var iterator = rule.GetAlternatives().GetIterator()
+	for iterator.HasNext() {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		if term.IsLiteral() || term.IsToken() {
+			hasString = true
+			continue
+		}
+		alternatives = append(
+			alternatives,
+			map[string]any{"$ref": "#/$defs/" + term.GetText()},
+		)
+	}
+	if hasString {
+		alternatives = append(alternatives, map[string]any{"type": "string"})
+	}
+	var any_ any = map[string]any{"oneOf": alternatives}
+	if len(alternatives) == 1 {
+		any_ = alternatives[0]
+	}
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"kind": map[string]any{"const": rule.GetName()},
+			"any":  any_,
+		},
+		"required":             []string{"kind", "any"},
+		"additionalProperties": false,
+	}
+}
+
+func (v *generator_) generateSchemaTerms(
+	rule RuleLike,
+) map[string]any {
+	var properties = map[string]any{
+		"kind": map[string]any{"const": rule.GetName()},
+	}
+	var required = []string{"kind"}
+	var names = v.getTermNames(rule)
+	var iterator = rule.GetTerms().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = iterator.GetNext()
+		var attributeName = sts.TrimSuffix(names[index], "_")
+		var property map[string]any
+		switch {
+		case term.IsLiteral():
+			property = map[string]any{"const": sts.Trim(term.GetText(), "\"")}
+		case term.IsToken():
+			property = map[string]any{"type": "string"}
+		default:
+			property = map[string]any{"$ref": "#/$defs/" + term.GetText()}
+		}
+		switch term.GetCardinality() {
+		case SingleCardinality:
+			required = append(required, attributeName)
+		case ZeroOrMoreCardinality:
+			property = map[string]any{"type": "array", "items": property}
+			required = append(required, attributeName)
+		case OneOrMoreCardinality:
+			property = map[string]any{"type": "array", "items": property, "minItems": 1}
+			required = append(required, attributeName)
+		}
+		properties[attributeName] = property
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+func (v *generator_) generateTransformAlternatives(
+	rule RuleLike,
+) string {
+	var cases string
+	var iterator = rule.GetAlternatives().GetIterator()
+	for iterator.HasNext() {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		if term.IsLiteral() || term.IsToken() {
+			continue
+		}
+		var alternative = transformRuleCaseTemplate_
+		alternative = uti.ReplaceAll(alternative, "alternativeName", term.GetText())
+		cases += alternative
+	}
+	if len(cases) > 0 {
+		var switch_ = transformRuleCasesTemplate_
+		cases = uti.ReplaceAll(switch_, "cases", cases)
+	}
+	var method = transformAlternativesTemplate_
+	method = uti.ReplaceAll(method, "cases", cases)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateTransformTerms(
+	rule RuleLike,
+) string {
+	var terms string
+	var names = v.getTermNames(rule)
+	var iterator = rule.GetTerms().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = iterator.GetNext()
+		var template string
+		switch {
+		case term.IsLiteral(), term.IsToken():
+			template = v.selectTemplate(
+				term,
+				transformTokenTemplate_,
+				transformTokenTemplate_,
+				"",
+			)
+		default:
+			template = v.selectTemplate(
+				term,
+				transformSingleRuleTemplate_,
+				transformOptionalRuleTemplate_,
+				transformMultipleRulesTemplate_,
+			)
+		}
+		template = uti.ReplaceAll(template, "termName", term.GetText())
+		template = uti.ReplaceAll(template, "variableName", names[index])
+		template = uti.ReplaceAll(
+			template,
+			"attributeName",
+			sts.TrimSuffix(names[index], "_"),
+		)
+		terms += template
+	}
+	var method = transformTermsTemplate_
+	method = uti.ReplaceAll(method, "terms", terms)
+	method = uti.ReplaceAll(method, "arguments", v.formatArguments(names))
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateVisitAlternatives(
+	rule RuleLike,
+) string {
+	var cases string
+	var literals string
+	var hasLiteral bool
+	var hasRule bool
+	var iterator = rule.GetAlternatives().GetIterator()
+	for iterator.HasNext() {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		switch {
+		case term.IsLiteral():
+			hasLiteral = true
+		case term.IsToken():
+			var literal = visitTokenCaseTemplate_
+			literal = uti.ReplaceAll(literal, "tokenName", term.GetText())
+			literals += literal
+		default:
+			hasRule = true
+			var alternative = visitRuleCaseTemplate_
+			alternative = uti.ReplaceAll(alternative, "alternativeName", term.GetText())
+			cases += alternative
+		}
+	}
+	if hasLiteral {
+		literals += visitDelimiterCaseTemplate_
+	}
+	var kind = "literal values"
+	if hasRule {
+		kind = "rule types"
+	}
+	if len(literals) > 0 {
+		var literal = visitLiteralCasesTemplate_
+		literal = uti.ReplaceAll(literal, "literals", literals)
+		cases += literal
+	}
+	var method = visitAlternativesTemplate_
+	method = uti.ReplaceAll(method, "cases", cases)
+	method = uti.ReplaceAll(method, "kind", kind)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) generateVisitTerms(
+	rule RuleLike,
+) string {
+	var terms []string
+	var names = v.getTermNames(rule)
+	var iterator = rule.GetTerms().GetIterator()
+	for index := 0; iterator.HasNext(); index++ {
+		var term = iterator.GetNext()
+		var template string
+		switch {
+		case term.IsLiteral():
+			template = visitSingleTokenTemplate_
+			template = uti.ReplaceAll(template, "tokenName", "delimiter")
+		case term.IsToken():
+			template = v.selectTemplate(
+				term,
+				visitSingleTokenTemplate_,
+				visitOptionalTokenTemplate_,
+				"",
+			)
+			template = uti.ReplaceAll(template, "tokenName", term.GetText())
+		default:
+			template = v.selectTemplate(
+				term,
+				visitSingleRuleTemplate_,
+				visitOptionalRuleTemplate_,
+				visitMultipleRulesTemplate_,
+			)
+			template = uti.ReplaceAll(template, "termName", term.GetText())
+		}
+		var variableName = names[index]
+		template = uti.ReplaceAll(template, "variableName", variableName)
+		template = uti.ReplaceAll(
+			template,
+			"attributeName",
+			sts.TrimSuffix(variableName, "_"),
+		)
+		terms = append(terms, template)
+	}
+	var body string
+	for index, term := range terms {
+		if index > 0 {
+			var slot = visitSlotTemplate_
+			slot = uti.ReplaceAll(slot, "slot", fmt.Sprintf("%d", index))
+			body += slot
+		}
+		body += term
+	}
+	var method = visitTermsTemplate_
+	method = uti.ReplaceAll(method, "terms", body)
+	method = uti.ReplaceAll(method, "ruleName", rule.GetName())
+	return method
+}
+
+func (v *generator_) formatArguments(
+	arguments []string,
+) string {
+	if len(arguments) == 1 {
+		return arguments[0]
+	}
+	var result = "\n"
+	for _, argument := range arguments {
+		result += "\t\t" + argument + ",\n"
+	}
+	return result + "\t"
+}
+
+func (v *generator_) getAlternativeNames(
+	rule RuleLike,
+) []string {
+	var names []string
+	var iterator = rule.GetAlternatives().GetIterator()
+	for iterator.HasNext() {
+		var term = TermClass().Term(iterator.GetNext(), SingleCardinality)
+		var name = term.GetText()
+		if term.IsLiteral() {
+			name = "delimiter"
+		}
+		names = append(names, name)
+	}
+	return v.numberNames(names)
+}
+
+func (v *generator_) getTermNames(
+	rule RuleLike,
+) []string {
+	var names []string
+	var iterator = rule.GetTerms().GetIterator()
+	for iterator.HasNext() {
+		var term = iterator.GetNext()
+		var name = uti.MakeLowerCase(term.GetText())
+		if term.IsLiteral() {
+			name = "delimiter"
+		}
+		switch term.GetCardinality() {
+		case OptionalCardinality:
+			name = "optional" + uti.MakeUpperCase(name)
+		case ZeroOrMoreCardinality, OneOrMoreCardinality:
+			name = uti.MakePlural(name)
+		}
+		names = append(names, name)
+	}
+	return v.numberNames(names)
+}
+
+func (v *generator_) numberNames(
+	names []string,
+) []string {
+	// Duplicate names are numbered and Go keywords are suffixed with "_".
+	var counts = make(map[string]int)
+	for _, name := range names {
+		counts[name]++
+	}
+	var indices = make(map[string]int)
+	var results []string
+	for _, name := range names {
+		var result = name
+		if counts[name] > 1 {
+			indices[name]++
+			result += fmt.Sprintf("%d", indices[name])
+		}
+		result = uti.ReplaceAll("<name_>", "name", result)
+		results = append(results, result)
+	}
+	return results
+}
+
+func (v *generator_) selectTemplate(
+	term TermLike,
+	single string,
+	optional string,
+	multiple string,
+) string {
+	var template string
+	switch term.GetCardinality() {
+	case SingleCardinality:
+		template = single
+	case OptionalCardinality:
+		template = optional
+	default:
+		template = multiple
+	}
+	if len(template) == 0 {
+		var message = fmt.Sprintf(
+			"The following term has an unsupported cardinality: %s",
+			term.GetText(),
+		)
+		panic(message)
+	}
+	return template
+}
+
+func (v *generator_) sortRules(
+	syntax SyntaxLike,
+) []RuleLike {
+	var catalog = com.Catalog[string, RuleLike]()
+	var iterator = syntax.GetRules().GetIterator()
+	for iterator.HasNext() {
+		var rule = iterator.GetNext()
+		catalog.SetValue(rule.GetName(), rule)
+	}
+	catalog.SortValues()
+	var rules []RuleLike
+	var names = catalog.GetKeys().GetIterator()
+	for names.HasNext() {
+		rules = append(rules, catalog.GetValue(names.GetNext()))
+	}
+	return rules
+}
+
+// Instance Structure
+
+type generator_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type generatorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func generatorClass() *generatorClass_ {
+	return generatorClassReference_
+}
+
+var generatorClassReference_ = &generatorClass_{
+	// Initialize the class constants.
+}
+
+// Private Constants
+
+// NOTE:
+// These private constants define the templates used to generate each grammar
+// class.  The placeholders in angle brackets are replaced using the conventions
+// defined by the uti.ReplaceAll() function.
+const decodeTermsTemplate_ = `
+func (v *decoder_) decode<~RuleName>(
+	encoded any,
+) ast.<~RuleName>Like {
+	var node = v.getNode(encoded, "<~RuleName>")
+<terms>	return ast.<~RuleName>Class().<~RuleName>(<arguments>)
+}
+`
+
+const decodeSingleTokenTemplate_ = `	var <variableName> = v.getString(node, "<attributeName>")
+`
+
+const decodeOptionalTokenTemplate_ = `	var <variableName> = v.getOptionalString(node, "<attributeName>")
+`
+
+const decodeSingleRuleTemplate_ = `	var <variableName> = v.decode<~TermName>(v.getValue(node, "<attributeName>"))
+`
+
+const decodeOptionalRuleTemplate_ = `	var <variableName> ast.<~TermName>Like
+	if uti.IsDefined(node["<attributeName>"]) {
+		<variableName> = v.decode<~TermName>(node["<attributeName>"])
+	}
+`
+
+const decodeMultipleRulesTemplate_ = `	var <variableName> = com.List[ast.<~TermName>Like]()
+	for _, element := range v.getArray(node, "<attributeName>") {
+		<variableName>.AppendValue(v.decode<~TermName>(element))
+	}
+`
+
+const decodeAlternativesTemplate_ = `
+func (v *decoder_) decode<~RuleName>(
+	encoded any,
+) ast.<~RuleName>Like {
+	var node = v.getNode(encoded, "<~RuleName>")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+<kinds>	default:
+		var message = fmt.Sprintf(
+			"An unexpected <~ruleName> value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.<~RuleName>Class().<~RuleName>(any_)
+}
+`
+
+const decodeStringCaseTemplate_ = `	case string:
+		any_ = actual
+`
+
+const decodeRuleCasesTemplate_ = `	case map[string]any:
+		switch actual["kind"] {
+<cases>		default:
+			var message = fmt.Sprintf(
+				"An unexpected <~ruleName> kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+`
+
+const decodeRuleCaseTemplate_ = `		case "<~AlternativeName>":
+			any_ = v.decode<~AlternativeName>(actual)
+`
+
+const encodeTermsTemplate_ = `
+func (v *encoder_) encode<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "<~RuleName>",
+	}
+<terms>	return node
+}
+`
+
+const encodeSingleTokenTemplate_ = `	node["<attributeName>"] = <ruleName_>.Get<~AttributeName>()
+`
+
+const encodeOptionalTokenTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	if uti.IsDefined(<variableName>) {
+		node["<attributeName>"] = <variableName>
+	}
+`
+
+const encodeSingleRuleTemplate_ = `	node["<attributeName>"] = v.encode<~TermName>(<ruleName_>.Get<~AttributeName>())
+`
+
+const encodeOptionalRuleTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	if uti.IsDefined(<variableName>) {
+		node["<attributeName>"] = v.encode<~TermName>(<variableName>)
+	}
+`
+
+const encodeMultipleRulesTemplate_ = `	var <variableName> = []any{}
+	var <variableName>Iterator = <ruleName_>.Get<~AttributeName>().GetIterator()
+	for <variableName>Iterator.HasNext() {
+		var rule = <variableName>Iterator.GetNext()
+		<variableName> = append(<variableName>, v.encode<~TermName>(rule))
+	}
+	node["<attributeName>"] = <variableName>
+`
+
+const encodeAlternativesTemplate_ = `
+func (v *encoder_) encode<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "<~RuleName>",
+	}
+	switch actual := <ruleName_>.GetAny().(type) {
+<cases>	}
+	return node
+}
+`
+
+const encodeRuleCaseTemplate_ = `	case ast.<~AlternativeName>Like:
+		node["any"] = v.encode<~AlternativeName>(actual)
+`
+
+const encodeStringCaseTemplate_ = `	case string:
+		node["any"] = actual
+`
+
+const parseTermsTemplate_ = `
+func (v *parser_) parse<~RuleName>() (
+	<ruleName_> ast.<~RuleName>Like,
+	token TokenLike,
+	ok bool,
+) {
+	var tokens = com.List[TokenLike]()
+<terms>
+	// Found a single <~RuleName> rule.
+	ok = true
+	v.remove(tokens)
+	<ruleName_> = ast.<~RuleName>Class().<~RuleName>(<arguments>)
+	return
+}
+`
+
+const parseSingleLiteralTemplate_ = `
+	// Attempt to parse a single <literal> literal.
+	var <variableName> string
+	<variableName>, token, ok = v.parseDelimiter(<literal>)
+	if !ok {
+		if uti.IsDefined(tokens) {
+			// This is not a single <~RuleName> rule.
+			v.putBack(tokens)
+			return
+		} else {
+			// Found a syntax error.
+			var message = v.formatError("$<~RuleName>", token)
+			panic(message)
+		}
+	}
+	if uti.IsDefined(tokens) {
+		tokens.AppendValue(token)
+	}
+`
+
+const parseSingleTokenTemplate_ = `
+	// Attempt to parse a single <~tokenName> token.
+	var <variableName> string
+	<variableName>, token, ok = v.parseToken(<~TokenName>Token)
+	if !ok {
+		if uti.IsDefined(tokens) {
+			// This is not a single <~tokenName> token.
+			v.putBack(tokens)
+			return
+		} else {
+			// Found a syntax error.
+			var message = v.formatError("$<~RuleName>", token)
+			panic(message)
+		}
+	}
+	if uti.IsDefined(tokens) {
+		tokens.AppendValue(token)
+	}
+`
+
+const parseOptionalTokenTemplate_ = `
+	// Attempt to parse an optional <~tokenName> token.
+	var <variableName> string
+	<variableName>, token, ok = v.parseToken(<~TokenName>Token)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		<variableName> = "" // Reset this to undefined.
+	}
+`
+
+const parseSingleRuleTemplate_ = `
+	// Attempt to parse a single <~TermName> rule.
+	var <variableName> ast.<~TermName>Like
+	<variableName>, token, ok = v.parse<~TermName>()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single <~TermName> rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$<~RuleName>", token)
+		panic(message)
+	}
+`
+
+const parseOptionalRuleTemplate_ = `
+	// Attempt to parse an optional <~TermName> rule.
+	var <variableName> ast.<~TermName>Like
+	<variableName>, _, ok = v.parse<~TermName>()
+	if ok {
+		// No additional put backs allowed at this point.
+		tokens = nil
+	}
+`
+
+const parseMultipleRulesTemplate_ = `
+	// Attempt to parse multiple <~TermName> rules.
+	var <variableName> = com.List[ast.<~TermName>Like]()
+<variableName>Loop:
+	for count_ := 0; count_ < mat.MaxInt; count_++ {
+		var <termName_> ast.<~TermName>Like
+		<termName_>, token, ok = v.parse<~TermName>()
+		if !ok {
+			switch {
+			case count_ >= <minimum>:
+				break <variableName>Loop
+			case uti.IsDefined(tokens):
+				// This is not multiple <~TermName> rules.
+				v.putBack(tokens)
+				return
+			default:
+				// Found a syntax error.
+				var message = v.formatError("$<~RuleName>", token)
+				message += "<minimum> or more <~TermName> rules are required."
+				panic(message)
+			}
+		}
+		// No additional put backs allowed at this point.
+		tokens = nil
+		<variableName>.AppendValue(<termName_>)
+	}
+`
+
+const parseAlternativesTemplate_ = `
+func (v *parser_) parse<~RuleName>() (
+	<ruleName_> ast.<~RuleName>Like,
+	token TokenLike,
+	ok bool,
+) {<alternatives>
+	// This is not a single <~RuleName> rule.
+	return
+}
+`
+
+const parseLiteralAlternativeTemplate_ = `
+	// Attempt to parse a single <literal> <~RuleName>.
+	var <variableName> string
+	<variableName>, token, ok = v.parseDelimiter(<literal>)
+	if ok {
+		// Found a single <literal> <~RuleName>.
+		<ruleName_> = ast.<~RuleName>Class().<~RuleName>(<variableName>)
+		return
+	}
+`
+
+const parseTokenAlternativeTemplate_ = `
+	// Attempt to parse a single <~tokenName> <~RuleName>.
+	var <variableName> string
+	<variableName>, token, ok = v.parseToken(<~TokenName>Token)
+	if ok {
+		// Found a single <~tokenName> <~RuleName>.
+		<ruleName_> = ast.<~RuleName>Class().<~RuleName>(<variableName>)
+		return
+	}
+`
+
+const parseRuleAlternativeTemplate_ = `
+	// Attempt to parse a single <~AlternativeName> <~RuleName>.
+	var <variableName> ast.<~AlternativeName>Like
+	<variableName>, token, ok = v.parse<~AlternativeName>()
+	if ok {
+		// Found a single <~AlternativeName> <~RuleName>.
+		<ruleName_> = ast.<~RuleName>Class().<~RuleName>(<variableName>)
+		return
+	}
+`
+
+const visitTermsTemplate_ = `
+func (v *visitor_) visit<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, <ruleName_>)
+<terms>	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+`
+
+const visitSlotTemplate_ = `	// Visit slot <slot> between terms.
+	v.processor_.Process<~RuleName>Slot(
+		<ruleName_>,
+		<slot>,
+	)
+
+`
+
+const visitSingleTokenTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	v.processor_.Process<~TokenName>(<variableName>)
+`
+
+const visitOptionalTokenTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	if uti.IsDefined(<variableName>) {
+		v.processor_.Process<~TokenName>(<variableName>)
+	}
+`
+
+const visitSingleRuleTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	v.processor_.Preprocess<~TermName>(
+		<variableName>,
+		0,
+		0,
+	)
+	v.visit<~TermName>(<variableName>)
+	v.processor_.Postprocess<~TermName>(
+		<variableName>,
+		0,
+		0,
+	)
+`
+
+const visitOptionalRuleTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	if uti.IsDefined(<variableName>) {
+		v.processor_.Preprocess<~TermName>(
+			<variableName>,
+			0,
+			0,
+		)
+		v.visit<~TermName>(<variableName>)
+		v.processor_.Postprocess<~TermName>(
+			<variableName>,
+			0,
+			0,
+		)
+	}
+`
+
+const visitMultipleRulesTemplate_ = `	var <variableName>Index uint
+	var <variableName> = <ruleName_>.Get<~AttributeName>().GetIterator()
+	var <variableName>Count = uint(<variableName>.GetSize())
+	for <variableName>.HasNext() {
+		<variableName>Index++
+		var rule = <variableName>.GetNext()
+		v.processor_.Preprocess<~TermName>(
+			rule,
+			<variableName>Index,
+			<variableName>Count,
+		)
+		v.visit<~TermName>(rule)
+		v.processor_.Postprocess<~TermName>(
+			rule,
+			<variableName>Index,
+			<variableName>Count,
+		)
+	}
+`
+
+const visitAlternativesTemplate_ = `
+func (v *visitor_) visit<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, <ruleName_>)
+
+	// Visit the possible <~ruleName> <kind>.
+	switch actual := <ruleName_>.GetAny().(type) {
+<cases>	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+`
+
+const visitRuleCaseTemplate_ = `	case ast.<~AlternativeName>Like:
+		v.processor_.Preprocess<~AlternativeName>(
+			actual,
+			0,
+			0,
+		)
+		v.visit<~AlternativeName>(actual)
+		v.processor_.Postprocess<~AlternativeName>(
+			actual,
+			0,
+			0,
+		)
+`
+
+const visitLiteralCasesTemplate_ = `	case string:
+		var scannerClass = ScannerClass()
+		switch {
+<literals>		}
+`
+
+const visitTokenCaseTemplate_ = `		case scannerClass.MatchesType(actual, <~TokenName>Token):
+			v.processor_.Process<~TokenName>(actual)
+`
+
+const visitDelimiterCaseTemplate_ = `		default:
+			v.processor_.ProcessDelimiter(actual)
+`
+
+const processTokenTemplate_ = `
+func (v *processor_) Process<~TokenName>(
+	<tokenName_> string,
+) {
+}
+`
+
+const processRuleTemplate_ = `
+func (v *processor_) Preprocess<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) Postprocess<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) Process<~RuleName>Slot(
+	<ruleName_> ast.<~RuleName>Like,
+	slot_ uint,
+) {
+}
+`
+
+const inspectTokenTemplate_ = `
+func (v *inspector_) Process<~TokenName>(
+	<tokenName_> string,
+) {
+	v.preprocess_(<tokenName_>, "<~tokenName>")
+	v.postprocess_(<tokenName_>, "<~tokenName>")
+}
+`
+
+const inspectRuleTemplate_ = `
+func (v *inspector_) Preprocess<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(<ruleName_>, "<~RuleName>")
+}
+
+func (v *inspector_) Postprocess<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(<ruleName_>, "<~RuleName>")
+}
+
+func (v *inspector_) Process<~RuleName>Slot(
+	<ruleName_> ast.<~RuleName>Like,
+	slot_ uint,
+) {
+}
+`
+
+const compositeTokenTemplate_ = `
+func (v *compositeProcessor_) Process<~TokenName>(
+	<tokenName_> string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.Process<~TokenName>(<tokenName_>)
+	})
+}
+`
+
+const compositeRuleTemplate_ = `
+func (v *compositeProcessor_) Preprocess<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.Preprocess<~RuleName>(<ruleName_>, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) Postprocess<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.Postprocess<~RuleName>(<ruleName_>, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) Process<~RuleName>Slot(
+	<ruleName_> ast.<~RuleName>Like,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.Process<~RuleName>Slot(<ruleName_>, slot_)
+	})
+}
+`
+
+const transformTermsTemplate_ = `
+func (v *transformer_) transform<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+) ast.<~RuleName>Like {
+<terms>	<ruleName_> = ast.<~RuleName>Class().<~RuleName>(<arguments>)
+	return v.transformation_(<ruleName_>, "<~RuleName>").(ast.<~RuleName>Like)
+}
+`
+
+const transformTokenTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+`
+
+const transformSingleRuleTemplate_ = `	var <variableName> = v.transform<~TermName>(<ruleName_>.Get<~AttributeName>())
+`
+
+const transformOptionalRuleTemplate_ = `	var <variableName> = <ruleName_>.Get<~AttributeName>()
+	if uti.IsDefined(<variableName>) {
+		<variableName> = v.transform<~TermName>(<variableName>)
+	}
+`
+
+const transformMultipleRulesTemplate_ = `	var <variableName> = com.List[ast.<~TermName>Like]()
+	var <variableName>Iterator = <ruleName_>.Get<~AttributeName>().GetIterator()
+	for <variableName>Iterator.HasNext() {
+		var rule = <variableName>Iterator.GetNext()
+		<variableName>.AppendValue(v.transform<~TermName>(rule))
+	}
+`
+
+const transformAlternativesTemplate_ = `
+func (v *transformer_) transform<~RuleName>(
+	<ruleName_> ast.<~RuleName>Like,
+) ast.<~RuleName>Like {
+	var any_ = <ruleName_>.GetAny()
+<cases>	<ruleName_> = ast.<~RuleName>Class().<~RuleName>(any_)
+	return v.transformation_(<ruleName_>, "<~RuleName>").(ast.<~RuleName>Like)
+}
+`
+
+const transformRuleCasesTemplate_ = `	switch actual := any_.(type) {
+<cases>	}
+`
+
+const transformRuleCaseTemplate_ = `	case ast.<~AlternativeName>Like:
+		any_ = v.transform<~AlternativeName>(actual)
+`
+
+const decoderTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	ast "<moduleName>/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DecoderClass() DecoderClassLike {
+	return decoderClass()
+}
+
+// Constructor Methods
+
+func (c *decoderClass_) Decoder() DecoderLike {
+	var instance = &decoder_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *decoder_) GetClass() DecoderClassLike {
+	return decoderClass()
+}
+
+func (v *decoder_) Decode<~RootName>(
+	source string,
+) ast.<~RootName>Like {
+	var value any
+	var err = jsn.Unmarshal([]byte(source), &value)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The JSON source is not valid: %v",
+			err,
+		)
+		panic(message)
+	}
+	return v.decode<~RootName>(value)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+<methods>
+func (v *decoder_) getArray(
+	node map[string]any,
+	name string,
+) []any {
+	var array, ok = v.getValue(node, name).([]any)
+	if !ok {
+		var message = fmt.Sprintf(
+			"The %q attribute of a %v node must be an array.",
+			name,
+			node["kind"],
+		)
+		panic(message)
+	}
+	return array
+}
+
+func (v *decoder_) getNode(
+	value any,
+	kind string,
+) map[string]any {
+	var node, ok = value.(map[string]any)
+	if !ok || node["kind"] != kind {
+		var message = fmt.Sprintf(
+			"Expected a %s node but found: %v",
+			kind,
+			value,
+		)
+		panic(message)
+	}
+	return node
+}
+
+func (v *decoder_) getOptionalString(
+	node map[string]any,
+	name string,
+) string {
+	if uti.IsUndefined(node[name]) {
+		return ""
+	}
+	return v.getString(node, name)
+}
+
+func (v *decoder_) getString(
+	node map[string]any,
+	name string,
+) string {
+	var value, ok = v.getValue(node, name).(string)
+	if !ok {
+		var message = fmt.Sprintf(
+			"The %q attribute of a %v node must be a string.",
+			name,
+			node["kind"],
+		)
+		panic(message)
+	}
+	return value
+}
+
+func (v *decoder_) getValue(
+	node map[string]any,
+	name string,
+) any {
+	var value, ok = node[name]
+	if !ok {
+		var message = fmt.Sprintf(
+			"The %q attribute is required by a %v node.",
+			name,
+			node["kind"],
+		)
+		panic(message)
+	}
+	return value
+}
+
+// Instance Structure
+
+type decoder_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type decoderClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func decoderClass() *decoderClass_ {
+	return decoderClassReference_
+}
+
+var decoderClassReference_ = &decoderClass_{
+	// Initialize the class constants.
+}
+`
+
+const encoderTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	ast "<moduleName>/ast"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func EncoderClass() EncoderClassLike {
+	return encoderClass()
+}
+
+// Constructor Methods
+
+func (c *encoderClass_) Encoder() EncoderLike {
+	var instance = &encoder_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *encoder_) GetClass() EncoderClassLike {
+	return encoderClass()
+}
+
+func (v *encoder_) Encode<~RootName>(
+	<rootName_> ast.<~RootName>Like,
+) string {
+	var node = v.encode<~RootName>(<rootName_>)
+	var bytes, err = jsn.MarshalIndent(node, "", "  ")
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The <~rootName> could not be encoded as JSON: %v",
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes) + "\n"
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+<methods>
+// Instance Structure
+
+type encoder_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type encoderClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func encoderClass() *encoderClass_ {
+	return encoderClassReference_
+}
+
+var encoderClassReference_ = &encoderClass_{
+	// Initialize the class constants.
+}
+`
+
+const parserTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	fmt "fmt"
+	ast "<moduleName>/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	mat "math"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ParserClass() ParserClassLike {
+	return parserClass()
+}
+
+// Constructor Methods
+
+func (c *parserClass_) Parser() ParserLike {
+	var instance = &parser_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *parser_) GetClass() ParserClassLike {
+	return parserClass()
+}
+
+func (v *parser_) ParseSource(
+	source string,
+) ast.<~RootName>Like {
+	v.source_ = sts.ReplaceAll(source, "\t", "    ")
+	v.tokens_ = com.Queue[TokenLike]()
+	v.next_ = com.Stack[TokenLike]()
+
+	// The scanner runs in a separate Go routine.
+	ScannerClass().Scanner(v.source_, v.tokens_)
+
+	// Attempt to parse the model.
+	var <rootName_>, token, ok = v.parse<~RootName>()
+	if !ok || v.tokens_.GetSize() > 1 {
+		var message = v.formatError("$<~RootName>", token)
+		panic(message)
+	}
+	return <rootName_>
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+<methods>
+func (v *parser_) parseDelimiter(
+	literal string,
+) (
+	value string,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a single delimiter.
+	value, token, ok = v.parseToken(DelimiterToken)
+	if ok {
+		if value == literal {
+			// Found the desired delimiter.
+			return
+		}
+		v.next_.AddValue(token)
+		ok = false
+	}
+
+	// This is not the desired delimiter.
+	return
+}
+
+func (v *parser_) parseToken(
+	tokenType TokenType,
+) (
+	value string,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a specific token type.
+	var tokens = com.List[TokenLike]()
+	token = v.getNextToken()
+	for token != nil {
+		tokens.AppendValue(token)
+		switch token.GetType() {
+		case tokenType:
+			// Found the desired token type.
+			value = token.GetValue()
+			ok = true
+			return
+		case SpaceToken, NewlineToken:
+			// Ignore any unrequested whitespace.
+			token = v.getNextToken()
+		default:
+			// This is not the desired token type.
+			v.putBack(tokens)
+			return
+		}
+	}
+
+	// We are at the end-of-file marker.
+	return
+}
+
+func (v *parser_) formatError(
+	ruleName string,
+	token TokenLike,
+) string {
+	// Format the error message.
+	var message = fmt.Sprintf(
+		"An unexpected token was received by the parser: %v\n",
+		ScannerClass().FormatToken(token),
+	)
+	var line = token.GetLine()
+	var lines = sts.Split(v.source_, "\n")
+
+	// Append the source lines with the error in it.
+	message += "\033[36m"
+	for index := line - 3; index < line; index++ {
+		if index > 1 {
+			message += fmt.Sprintf("%04d: ", index) + string(lines[index-1]) + "\n"
+		}
+	}
+	message += fmt.Sprintf("%04d: ", line) + string(lines[line-1]) + "\n"
+
+	// Append an arrow pointing to the error.
+	message += " \033[32m>>>─"
+	var count uint
+	for count < token.GetPosition() {
+		message += "─"
+		count++
+	}
+	message += "⌃\033[36m\n"
+
+	// Append the following source line for context.
+	if line < uti.ArraySize(lines) {
+		message += fmt.Sprintf("%04d: ", line+1) + string(lines[line]) + "\n"
+	}
+	message += "\033[0m\n"
+	if uti.IsDefined(ruleName) {
+		message += "Was expecting:\n"
+		message += fmt.Sprintf(
+			"  \033[32m%v: \033[33m%v\033[0m\n\n",
+			ruleName,
+			v.getDefinition(ruleName),
+		)
+	}
+	return message
+}
+
+func (v *parser_) getDefinition(
+	ruleName string,
+) string {
+	var syntax = parserClass().syntax_
+	var definition = syntax.GetValue(ruleName)
+	return definition
+}
+
+func (v *parser_) getNextToken() TokenLike {
+	// Check for any read, but unprocessed tokens.
+	if !v.next_.IsEmpty() {
+		return v.next_.RemoveLast()
+	}
+
+	// Read a new token from the token stream.
+	var token, ok = v.tokens_.RemoveFirst() // This will wait for a token.
+	if !ok {
+		// The token channel has been closed.
+		return nil
+	}
+
+	// Check for an error token.
+	if token.GetType() == ErrorToken {
+		var message = v.formatError("", token)
+		panic(message)
+	}
+
+	return token
+}
+
+func (v *parser_) putBack(
+	tokens com.Sequential[TokenLike],
+) {
+	var iterator = tokens.GetIterator()
+	for iterator.ToEnd(); iterator.HasPrevious(); {
+		var token = iterator.GetPrevious()
+		v.next_.AddValue(token)
+	}
+}
+
+// NOTE:
+// This method does nothing but must exist to satisfy the lint check on the
+// generated parser code.  The generated code must call this method is some
+// cases to make it look that the tokens variable is being used somewhere.
+func (v *parser_) remove(
+	tokens com.Sequential[TokenLike],
+) {
+}
+
+// Instance Structure
+
+type parser_ struct {
+	// Declare the instance attributes.
+	source_ string                   // The original source code.
+	tokens_ com.QueueLike[TokenLike] // A queue of unread tokens from the scanner.
+	next_   com.StackLike[TokenLike] // A stack of read, but unprocessed tokens.
+}
+
+// Class Structure
+
+type parserClass_ struct {
+	// Declare the class constants.
+	syntax_ com.CatalogLike[string, string]
+}
+
+// Class Reference
+
+func parserClass() *parserClass_ {
+	return parserClassReference_
+}
+
+var parserClassReference_ = &parserClass_{
+	// Initialize the class constants.
+	syntax_: com.CatalogFromMap[string, string](
+		map[string]string{
+<definitions>		},
+	),
+}
+`
+
+const processorTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "<moduleName>/ast"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ProcessorClass() ProcessorClassLike {
+	return processorClass()
+}
+
+// Constructor Methods
+
+func (c *processorClass_) Processor() ProcessorLike {
+	var instance = &processor_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *processor_) GetClass() ProcessorClassLike {
+	return processorClass()
+}
+
+// Methodical Methods
+<methods>
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type processor_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type processorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func processorClass() *processorClass_ {
+	return processorClassReference_
+}
+
+var processorClassReference_ = &processorClass_{
+	// Initialize the class constants.
+}
+`
+
+const compositeProcessorTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	fmt "fmt"
+	ast "<moduleName>/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func CompositeProcessorClass() CompositeProcessorClassLike {
+	return compositeProcessorClass()
+}
+
+// Constructor Methods
+
+func (c *compositeProcessorClass_) CompositeProcessor(
+	processors com.Sequential[Methodical],
+) CompositeProcessorLike {
+	if uti.IsUndefined(processors) {
+		panic("The \"processors\" attribute is required by this class.")
+	}
+	var instance = &compositeProcessor_{
+		// Initialize the instance attributes.
+		processors_: processors,
+		failures_:   com.Catalog[uint, string](),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *compositeProcessor_) GetClass() CompositeProcessorClassLike {
+	return compositeProcessorClass()
+}
+
+// Attribute Methods
+
+func (v *compositeProcessor_) GetProcessors() com.Sequential[Methodical] {
+	return v.processors_
+}
+
+func (v *compositeProcessor_) GetFailures() com.CatalogLike[uint, string] {
+	return v.failures_
+}
+
+// Methodical Methods
+<methods>
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *compositeProcessor_) fanOut(
+	call func(processor Methodical),
+) {
+	// Call each child processor in order, skipping any that have failed.
+	var index uint
+	var processors = v.processors_.GetIterator()
+	for processors.HasNext() {
+		index++
+		var processor = processors.GetNext()
+		if uti.IsDefined(v.failures_.GetValue(index)) {
+			continue
+		}
+		v.isolate(index, processor, call)
+	}
+}
+
+func (v *compositeProcessor_) isolate(
+	index uint,
+	processor Methodical,
+	call func(processor Methodical),
+) {
+	defer func() {
+		var exception = recover()
+		if exception != nil {
+			// Every failure is recorded, even one without a message.
+			var failure = fmt.Sprintf("%v", exception)
+			if uti.IsUndefined(failure) {
+				failure = fmt.Sprintf("The processor failed with: %#v", exception)
+			}
+			v.failures_.SetValue(index, failure)
+		}
+	}()
+	call(processor)
+}
+
+// Instance Structure
+
+type compositeProcessor_ struct {
+	// Declare the instance attributes.
+	processors_ com.Sequential[Methodical]
+	failures_   com.CatalogLike[uint, string]
+}
+
+// Class Structure
+
+type compositeProcessorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func compositeProcessorClass() *compositeProcessorClass_ {
+	return compositeProcessorClassReference_
+}
+
+var compositeProcessorClassReference_ = &compositeProcessorClass_{
+	// Initialize the class constants.
+}
+`
+
+const inspectorTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "<moduleName>/ast"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func InspectorClass() InspectorClassLike {
+	return inspectorClass()
+}
+
+// Constructor Methods
+
+func (c *inspectorClass_) Inspector(
+	preprocess InspectionFunction,
+	postprocess InspectionFunction,
+) InspectorLike {
+	if uti.IsUndefined(preprocess) {
+		panic("The \"preprocess\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(postprocess) {
+		panic("The \"postprocess\" attribute is required by this class.")
+	}
+	var instance = &inspector_{
+		// Initialize the instance attributes.
+		preprocess_:  preprocess,
+		postprocess_: postprocess,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *inspector_) GetClass() InspectorClassLike {
+	return inspectorClass()
+}
+
+// Methodical Methods
+<methods>
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type inspector_ struct {
+	// Declare the instance attributes.
+	preprocess_  InspectionFunction
+	postprocess_ InspectionFunction
+}
+
+// Class Structure
+
+type inspectorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func inspectorClass() *inspectorClass_ {
+	return inspectorClassReference_
+}
+
+var inspectorClassReference_ = &inspectorClass_{
+	// Initialize the class constants.
+}
+`
+
+const visitorTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "<moduleName>/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func VisitorClass() VisitorClassLike {
+	return visitorClass()
+}
+
+// Constructor Methods
+
+func (c *visitorClass_) Visitor(
+	processor Methodical,
+) VisitorLike {
+	if uti.IsUndefined(processor) {
+		panic("The \"processor\" attribute is required by this class.")
+	}
+	var instance = &visitor_{
+		// Initialize the instance attributes.
+		processor_: processor,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *visitor_) GetClass() VisitorClassLike {
+	return visitorClass()
+}
+
+func (v *visitor_) Visit<~RootName>(
+	<rootName_> ast.<~RootName>Like,
+) {
+	var processor = v.processor_
+	v.ancestors_ = nil
+	v.skipping_ = false
+	v.stopped_ = false
+	v.processor_.Preprocess<~RootName>(
+		<rootName_>,
+		0,
+		0,
+	)
+	v.visit<~RootName>(<rootName_>)
+	v.processor_.Postprocess<~RootName>(
+		<rootName_>,
+		0,
+		0,
+	)
+	v.processor_ = processor
+}
+
+func (v *visitor_) GetAncestors() com.Sequential[any] {
+	return com.ListFromArray(v.ancestors_)
+}
+
+func (v *visitor_) SkipChildren() {
+	v.skipping_ = true
+}
+
+func (v *visitor_) StopVisiting() {
+	// The rest of the walk is abandoned without calling the processor again.
+	v.stopped_ = true
+	v.processor_ = processorClass().Processor()
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *visitor_) isSkipping() bool {
+	var skipping = v.skipping_ || v.stopped_
+	v.skipping_ = false
+	return skipping
+}
+<methods>
+// Instance Structure
+
+type visitor_ struct {
+	// Declare the instance attributes.
+	processor_ Methodical
+	ancestors_ []any
+	skipping_  bool
+	stopped_   bool
+}
+
+// Class Structure
+
+type visitorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func visitorClass() *visitorClass_ {
+	return visitorClassReference_
+}
+
+var visitorClassReference_ = &visitorClass_{
+	// Initialize the class constants.
+}
+`
+
+const transformerTemplate_ = `<notice>
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "<moduleName>/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func TransformerClass() TransformerClassLike {
+	return transformerClass()
+}
+
+// Constructor Methods
+
+func (c *transformerClass_) Transformer(
+	transformation TransformationFunction,
+) TransformerLike {
+	if uti.IsUndefined(transformation) {
+		panic("The \"transformation\" attribute is required by this class.")
+	}
+	var instance = &transformer_{
+		// Initialize the instance attributes.
+		transformation_: transformation,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *transformer_) GetClass() TransformerClassLike {
+	return transformerClass()
+}
+
+func (v *transformer_) Transform<~RootName>(
+	<rootName_> ast.<~RootName>Like,
+) ast.<~RootName>Like {
+	return v.transform<~RootName>(<rootName_>)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+<methods>
+// Instance Structure
+
+type transformer_ struct {
+	// Declare the instance attributes.
+	transformation_ TransformationFunction
+}
+
+// Class Structure
+
+type transformerClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func transformerClass() *transformerClass_ {
+	return transformerClassReference_
+}
+
+var transformerClassReference_ = &transformerClass_{
+	// Initialize the class constants.
+}
+`
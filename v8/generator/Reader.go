@@ -0,0 +1,243 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	fmt "fmt"
+	com "github.com/craterdog/go-essential-composites/v8"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ReaderClass() ReaderClassLike {
+	return readerClass()
+}
+
+// Constructor Methods
+
+func (c *readerClass_) Reader() ReaderLike {
+	var instance = &reader_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *reader_) GetClass() ReaderClassLike {
+	return readerClass()
+}
+
+func (v *reader_) ReadSyntax(
+	source string,
+) SyntaxLike {
+	var notice string
+	var rules = com.List[RuleLike]()
+	var tokens = com.Set[string]()
+	tokens.AddValues(com.ListFromArray([]string{"delimiter", "newline", "space"}))
+	var lines = sts.Split(sts.ReplaceAll(source, "\r\n", "\n"), "\n")
+	for index := 0; index < len(lines); index++ {
+		var line = lines[index]
+		switch {
+		case line == "!>":
+			// Skip over the comment block, saving the first one as the notice.
+			var comment []string
+			for index++; index < len(lines) && lines[index] != "<!"; index++ {
+				comment = append(comment, lines[index])
+			}
+			if rules.IsEmpty() && len(notice) == 0 {
+				notice = "/*\n" + sts.Join(comment, "\n") + "\n*/\n"
+			}
+		case sts.HasPrefix(line, "$"):
+			var name, definition, found = sts.Cut(line[1:], ":")
+			if !found {
+				var message = fmt.Sprintf(
+					"The following rule definition is missing a colon: %s",
+					line,
+				)
+				panic(message)
+			}
+			if !v.isRuleName(name) {
+				// Only rule definitions are needed, not expressions or fragments.
+				continue
+			}
+			definition = sts.TrimPrefix(definition, " ")
+			if len(definition) == 0 {
+				// The rule contains multiple lines of alternatives.
+				for index+1 < len(lines) && sts.HasPrefix(lines[index+1], "    ") {
+					index++
+					definition += "\n" + lines[index]
+				}
+			}
+			var rule = v.parseRule(name, definition)
+			v.collectTokens(rule, tokens)
+			rules.AppendValue(rule)
+		}
+	}
+	return SyntaxClass().Syntax(
+		notice,
+		rules,
+		tokens,
+	)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *reader_) collectTokens(
+	rule RuleLike,
+	tokens com.SetLike[string],
+) {
+	var alternatives = rule.GetAlternatives().GetIterator()
+	for alternatives.HasNext() {
+		var term = TermClass().Term(alternatives.GetNext(), SingleCardinality)
+		if term.IsToken() {
+			tokens.AddValue(term.GetText())
+		}
+	}
+	var terms = rule.GetTerms().GetIterator()
+	for terms.HasNext() {
+		var term = terms.GetNext()
+		if term.IsToken() {
+			tokens.AddValue(term.GetText())
+		}
+	}
+}
+
+func (v *reader_) isRuleName(
+	name string,
+) bool {
+	// Rule names are mixed case while fragment names are all capitals.
+	var runes = []rune(name)
+	if len(runes) == 0 || !uni.IsUpper(runes[0]) {
+		return false
+	}
+	for _, r := range runes {
+		if uni.IsLower(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *reader_) parseRule(
+	name string,
+	definition string,
+) RuleLike {
+	var alternatives = com.List[string]()
+	var terms = com.List[TermLike]()
+	if sts.HasPrefix(definition, "\n") {
+		// Each line contains a single alternative.
+		for _, line := range sts.Split(definition[1:], "\n") {
+			alternatives.AppendValue(v.stripComment(line))
+		}
+	} else {
+		terms.AppendValues(v.parseTerms(v.stripComment(definition)))
+	}
+	return RuleClass().Rule(
+		name,
+		definition,
+		alternatives,
+		terms,
+	)
+}
+
+func (v *reader_) parseTerms(
+	expression string,
+) com.Sequential[TermLike] {
+	var terms = com.List[TermLike]()
+	var runes = []rune(expression)
+	for index := 0; index < len(runes); {
+		if runes[index] == ' ' {
+			index++
+			continue
+		}
+
+		// Extract the text of the next term.
+		var first = index
+		if runes[index] == '"' {
+			// Literals may contain spaces.
+			for index++; index < len(runes) && runes[index] != '"'; index++ {
+			}
+			index++
+		} else {
+			for index < len(runes) && !sts.ContainsRune(" ?*+", runes[index]) {
+				index++
+			}
+		}
+		var text = string(runes[first:index])
+
+		// Extract the cardinality of the next term.
+		var cardinality = SingleCardinality
+		if index < len(runes) {
+			switch runes[index] {
+			case '?':
+				cardinality = OptionalCardinality
+				index++
+			case '*':
+				cardinality = ZeroOrMoreCardinality
+				index++
+			case '+':
+				cardinality = OneOrMoreCardinality
+				index++
+			}
+		}
+		terms.AppendValue(TermClass().Term(text, cardinality))
+	}
+	return terms
+}
+
+func (v *reader_) stripComment(
+	line string,
+) string {
+	var expression, _, _ = sts.Cut(line, "  !")
+	return sts.TrimSpace(expression)
+}
+
+// Instance Structure
+
+type reader_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type readerClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func readerClass() *readerClass_ {
+	return readerClassReference_
+}
+
+var readerClassReference_ = &readerClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,125 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RuleClass() RuleClassLike {
+	return ruleClass()
+}
+
+// Constructor Methods
+
+func (c *ruleClass_) Rule(
+	name string,
+	definition string,
+	alternatives com.Sequential[string],
+	terms com.Sequential[TermLike],
+) RuleLike {
+	if uti.IsUndefined(name) {
+		panic("The \"name\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(definition) {
+		panic("The \"definition\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(alternatives) {
+		panic("The \"alternatives\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(terms) {
+		panic("The \"terms\" attribute is required by this class.")
+	}
+	var instance = &rule_{
+		// Initialize the instance attributes.
+		name_:         name,
+		definition_:   definition,
+		alternatives_: alternatives,
+		terms_:        terms,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *rule_) GetClass() RuleClassLike {
+	return ruleClass()
+}
+
+func (v *rule_) IsAlternatives() bool {
+	return !v.alternatives_.IsEmpty()
+}
+
+// Attribute Methods
+
+func (v *rule_) GetName() string {
+	return v.name_
+}
+
+func (v *rule_) GetDefinition() string {
+	return v.definition_
+}
+
+func (v *rule_) GetAlternatives() com.Sequential[string] {
+	return v.alternatives_
+}
+
+func (v *rule_) GetTerms() com.Sequential[TermLike] {
+	return v.terms_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type rule_ struct {
+	// Declare the instance attributes.
+	name_         string
+	definition_   string
+	alternatives_ com.Sequential[string]
+	terms_        com.Sequential[TermLike]
+}
+
+// Class Structure
+
+type ruleClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func ruleClass() *ruleClass_ {
+	return ruleClassReference_
+}
+
+var ruleClassReference_ = &ruleClass_{
+	// Initialize the class constants.
+}
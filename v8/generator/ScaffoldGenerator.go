@@ -0,0 +1,702 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	gof "go/format"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ScaffoldGeneratorClass() ScaffoldGeneratorClassLike {
+	return scaffoldGeneratorClass()
+}
+
+// Constructor Methods
+
+func (c *scaffoldGeneratorClass_) ScaffoldGenerator() ScaffoldGeneratorLike {
+	var instance = &scaffoldGenerator_{
+		// Initialize the instance attributes.
+	}
+	instance.formatter_ = gra.FormatterClass().FormatterWithRendering(
+		instance.renderFragment,
+	)
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *scaffoldGenerator_) GetClass() ScaffoldGeneratorClassLike {
+	return scaffoldGeneratorClass()
+}
+
+func (v *scaffoldGenerator_) GenerateScaffolds(
+	model ast.ModelLike,
+) com.CatalogLike[string, string] {
+	var scaffolds = com.Catalog[string, string]()
+	var declaration = model.GetPackageDeclaration()
+	var notice = declaration.GetLegalNotice().GetComment()
+	var packageName = declaration.GetPackageHeader().GetName()
+	var imports = com.Catalog[string, string]()
+	var importList = declaration.GetPackageImports().GetOptionalImportList()
+	if uti.IsDefined(importList) {
+		var packages = importList.GetImportedPackages().GetIterator()
+		for packages.HasNext() {
+			var importedPackage = packages.GetNext()
+			imports.SetValue(importedPackage.GetName(), importedPackage.GetString())
+		}
+	}
+
+	// Index the class declarations by name.
+	var interfaces = model.GetInterfaceDeclarations()
+	var classes = com.Catalog[string, ast.ClassDeclarationLike]()
+	var classIterator = interfaces.GetClassSection().GetClassDeclarations().GetIterator()
+	for classIterator.HasNext() {
+		var class = classIterator.GetNext()
+		classes.SetValue(class.GetDeclaration().GetName(), class)
+	}
+
+	// Index every declared type by name so that defined values can be
+	// generated for it.
+	v.declarations_ = map[string]any{}
+	var primitives = model.GetPrimitiveDeclarations()
+	var types = primitives.GetTypeSection().GetTypeDeclarations().GetIterator()
+	for types.HasNext() {
+		var typeDeclaration = types.GetNext()
+		v.declarations_[typeDeclaration.GetDeclaration().GetName()] = typeDeclaration
+	}
+	var functionals = primitives.GetFunctionalSection().GetFunctionalDeclarations().GetIterator()
+	for functionals.HasNext() {
+		var functionalDeclaration = functionals.GetNext()
+		v.declarations_[functionalDeclaration.GetDeclaration().GetName()] = functionalDeclaration
+	}
+	for _, declaration := range classes.GetValues(classes.GetKeys()).AsArray() {
+		v.declarations_[declaration.GetDeclaration().GetName()] = declaration
+	}
+	var instanceIterator = interfaces.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instanceIterator.HasNext() {
+		var declaration = instanceIterator.GetNext()
+		v.declarations_[declaration.GetDeclaration().GetName()] = declaration
+	}
+	var aspects = interfaces.GetAspectSection().GetAspectDeclarations().GetIterator()
+	for aspects.HasNext() {
+		var declaration = aspects.GetNext()
+		v.declarations_[declaration.GetDeclaration().GetName()] = declaration
+	}
+
+	// Generate a test scaffold for each instance declaration.
+	var instances = interfaces.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instances.HasNext() {
+		var instance = instances.GetNext()
+		var className = sts.TrimSuffix(instance.GetDeclaration().GetName(), "Like")
+		var class = classes.GetValue(className + "ClassLike")
+		if uti.IsUndefined(class) {
+			continue
+		}
+		v.prefixes_ = com.Set[string]()
+		v.substitutions_ = map[string]string{}
+		var tests = v.generateTests(className, class, instance)
+		if len(tests) == 0 {
+			continue
+		}
+		var importedPackages string
+		if sts.Contains(tests, "ass.") {
+			importedPackages += "\n\tass \"github.com/stretchr/testify/assert\""
+		}
+		importedPackages += "\n\ttes \"testing\""
+		var prefixes = v.prefixes_.GetIterator()
+		for prefixes.HasNext() {
+			var prefix = prefixes.GetNext()
+			importedPackages += fmt.Sprintf(
+				"\n\t%s %s",
+				prefix,
+				imports.GetValue(prefix),
+			)
+		}
+		var source = scaffoldTemplate_
+		source = uti.ReplaceAll(source, "notice", notice)
+		source = uti.ReplaceAll(source, "packageName", packageName)
+		source = uti.ReplaceAll(source, "importedPackages", importedPackages)
+		source = uti.ReplaceAll(source, "tests", tests)
+		scaffolds.SetValue(className+"_test.go", v.formatSource(source))
+	}
+	return scaffolds
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *scaffoldGenerator_) formatArguments(
+	parameters []ast.ParameterLike,
+	fields map[string]string,
+) string {
+	var list []string
+	for _, parameter := range parameters {
+		var argument = "test." + fields[parameter.GetName()]
+		if v.isVariadic(parameter) {
+			argument += "..."
+		}
+		list = append(list, argument)
+	}
+	return sts.Join(list, ", ")
+}
+
+func (v *scaffoldGenerator_) formatFields(
+	parameters []ast.ParameterLike,
+	fields map[string]string,
+	used map[string]bool,
+) string {
+	var result string
+	for _, parameter := range parameters {
+		var field = v.makeUnique(parameter.GetName(), used)
+		fields[parameter.GetName()] = field
+		var abstraction = v.formatter_.FormatAbstraction(parameter.GetAbstraction())
+		if v.isVariadic(parameter) {
+			// Variadic parameters are captured as arrays.
+			abstraction = "[]" + sts.TrimPrefix(abstraction, "...")
+		}
+		result += "\t\t" + field + " " + abstraction + "\n"
+	}
+	return result
+}
+
+func (v *scaffoldGenerator_) formatName(
+	name string,
+) string {
+	var substitution, found = v.substitutions_[name]
+	if found {
+		return substitution
+	}
+	return name
+}
+
+func (v *scaffoldGenerator_) formatSource(
+	source string,
+) string {
+	var bytes, err = gof.Source([]byte(source))
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated source code is not valid Go: %v\n%s",
+			err,
+			source,
+		)
+		panic(message)
+	}
+	return string(bytes)
+}
+
+func (v *scaffoldGenerator_) formatValue(
+	abstraction ast.AbstractionLike,
+	name string,
+) string {
+	// Return a defined value of the abstraction, or an empty string if its zero
+	// value is already defined.
+	var formatted = v.formatter_.FormatAbstraction(abstraction)
+	var wrapper = abstraction.GetOptionalWrapper()
+	if uti.IsDefined(wrapper) {
+		switch wrapper.GetAny().(type) {
+		case ast.DotsLike:
+			return "[]" + sts.TrimPrefix(formatted, "...") + "{}"
+		case ast.StarLike:
+			return "new(" + sts.TrimPrefix(formatted, "*") + ")"
+		case ast.ChannelLike:
+			return "make(" + formatted + ")"
+		default:
+			return formatted + "{}"
+		}
+	}
+	switch actual := abstraction.GetType().GetAny().(type) {
+	case ast.FunctionalLike:
+		return formatted + " { panic(\"Not implemented.\") }"
+	case ast.NamedLike:
+		if uti.IsDefined(actual.GetOptionalPrefix()) {
+			// Generic types and instance types from other packages are assumed
+			// to be interfaces.
+			if uti.IsDefined(actual.GetOptionalArguments()) ||
+				sts.HasSuffix(actual.GetName(), "Like") {
+				return "struct{ " + formatted + " }{}"
+			}
+			return ""
+		}
+		switch declaration := v.declarations_[actual.GetName()].(type) {
+		case ast.TypeDeclarationLike:
+			var previous = v.substituteArguments(declaration.GetDeclaration(), actual)
+			var value = v.formatValue(declaration.GetAbstraction(), name)
+			v.substitutions_ = previous
+			if len(value) == 0 {
+				return ""
+			}
+			return formatted + "(" + value + ")"
+		case ast.FunctionalDeclarationLike:
+			var previous = v.substituteArguments(declaration.GetDeclaration(), actual)
+			var value = v.formatValue(
+				ast.AbstractionClass().Abstraction(
+					nil,
+					ast.TypeClass().Type(declaration.GetFunctional()),
+				),
+				name,
+			)
+			v.substitutions_ = previous
+			return value
+		case nil:
+			switch formatted {
+			case "any", "string":
+				return fmt.Sprintf("%q", sts.TrimSuffix(name, "_"))
+			case "error":
+				return "struct{ error }{}"
+			default:
+				return ""
+			}
+		default:
+			// Class, instance and aspect declarations are all interfaces.
+			return "struct{ " + formatted + " }{}"
+		}
+	}
+	return ""
+}
+
+func (v *scaffoldGenerator_) generateAttributeTest(
+	className string,
+	construction string,
+	constructorFields string,
+	getter ast.GetterMethodLike,
+	setter ast.SetterMethodLike,
+	used map[string]bool,
+) string {
+	var attributeName = sts.TrimPrefix(getter.GetName(), "Get")
+	var test = attributeTestTemplate_
+	var fields = constructorFields
+	var assertion string
+	switch {
+	case uti.IsDefined(setter):
+		var parameter = setter.GetParameter()
+		var field = v.makeUnique(parameter.GetName(), used)
+		fields += "\t\t" + field + " " +
+			v.formatter_.FormatAbstraction(parameter.GetAbstraction()) + "\n"
+		assertion = "\t\t\tinstance." + setter.GetName() + "(test." + field + ")\n"
+		assertion += "\t\t\tass.Equal(t, test." + field + ", instance." +
+			getter.GetName() + "())\n"
+	case used[uti.MakeLowerCase(attributeName)]:
+		// The attribute is initialized by the constructor.
+		assertion = "\t\t\tass.Equal(t, test." + uti.MakeLowerCase(attributeName) +
+			", instance." + getter.GetName() + "())\n"
+	default:
+		var field = v.makeUnique("expected", used)
+		fields += "\t\t" + field + " " +
+			v.formatter_.FormatAbstraction(getter.GetAbstraction()) + "\n"
+		assertion = "\t\t\tass.Equal(t, test." + field + ", instance." +
+			getter.GetName() + "())\n"
+	}
+	test = uti.ReplaceAll(test, "className", className)
+	test = uti.ReplaceAll(test, "attributeName", attributeName)
+	test = uti.ReplaceAll(test, "fields", fields)
+	test = uti.ReplaceAll(test, "construction", construction)
+	test = uti.ReplaceAll(test, "assertion", assertion)
+	return test
+}
+
+func (v *scaffoldGenerator_) generateMethodTest(
+	className string,
+	construction string,
+	constructorFields string,
+	method ast.MethodLike,
+	used map[string]bool,
+) string {
+	var names = map[string]string{}
+	var parameters = v.getParameters(method.GetOptionalParameterList())
+	var fields = constructorFields + v.formatFields(parameters, names, used)
+	var invocation = "instance." + method.GetName() + "(" +
+		v.formatArguments(parameters, names) + ")"
+	var assertion string
+	switch actual := method.GetResult().GetAny().(type) {
+	case ast.AbstractionLike:
+		var field = v.makeUnique("expected", used)
+		fields += "\t\t" + field + " " + v.formatter_.FormatAbstraction(actual) + "\n"
+		assertion = "\t\t\tvar result = " + invocation + "\n"
+		assertion += "\t\t\tass.Equal(t, test." + field + ", result)\n"
+	case ast.MultivalueLike:
+		var results []string
+		var assertions string
+		for _, parameter := range v.getParameters(actual.GetParameterList()) {
+			var name = parameter.GetName()
+			var field = v.makeUnique("expected"+uti.MakeUpperCase(name), used)
+			fields += "\t\t" + field + " " +
+				v.formatter_.FormatAbstraction(parameter.GetAbstraction()) + "\n"
+			results = append(results, name)
+			assertions += "\t\t\tass.Equal(t, test." + field + ", " + name + ")\n"
+		}
+		assertion = "\t\t\tvar " + sts.Join(results, ", ") + " = " + invocation + "\n"
+		assertion += assertions
+	default:
+		assertion = "\t\t\t" + invocation + "\n"
+	}
+	var test = methodTestTemplate_
+	test = uti.ReplaceAll(test, "className", className)
+	test = uti.ReplaceAll(test, "methodName", method.GetName())
+	test = uti.ReplaceAll(test, "fields", fields)
+	test = uti.ReplaceAll(test, "construction", construction)
+	test = uti.ReplaceAll(test, "assertion", assertion)
+	return test
+}
+
+func (v *scaffoldGenerator_) generateRequiredTest(
+	className string,
+	classArguments string,
+	constructor ast.ConstructorMethodLike,
+) string {
+	var names = map[string]string{}
+	var parameters = v.getParameters(constructor.GetOptionalParameterList())
+	var fields = v.formatFields(parameters, names, map[string]bool{"label": true})
+	// Only the attributes whose zero values are undefined can be checked, and
+	// each case defines every other attribute.
+	var values = map[string]string{}
+	for _, parameter := range parameters {
+		var name = parameter.GetName()
+		values[name] = v.formatValue(parameter.GetAbstraction(), name)
+	}
+	var cases string
+	for _, parameter := range parameters {
+		var name = parameter.GetName()
+		if sts.HasPrefix(name, "optional") || len(values[name]) == 0 {
+			// Optional and zero valued attributes may be undefined.
+			continue
+		}
+		var definitions string
+		for _, other := range parameters {
+			var value = values[other.GetName()]
+			if other == parameter || len(value) == 0 {
+				continue
+			}
+			definitions += "\n\t\t\t" + names[other.GetName()] + ": " + value + ","
+		}
+		var testCase = requiredCaseTemplate_
+		testCase = uti.ReplaceAll(testCase, "attributeName", sts.TrimSuffix(name, "_"))
+		testCase = uti.ReplaceAll(testCase, "definitions", definitions)
+		cases += testCase
+	}
+	if len(cases) == 0 {
+		return ""
+	}
+	var testName = constructor.GetName()
+	if !sts.HasPrefix(testName, className) {
+		testName = className + testName
+	}
+	var test = requiredTestTemplate_
+	test = uti.ReplaceAll(test, "testName", testName)
+	test = uti.ReplaceAll(test, "className", className)
+	test = uti.ReplaceAll(test, "classArguments", classArguments)
+	test = uti.ReplaceAll(test, "constructorName", constructor.GetName())
+	test = uti.ReplaceAll(test, "fields", fields)
+	test = uti.ReplaceAll(test, "cases", cases)
+	test = uti.ReplaceAll(test, "arguments", v.formatArguments(parameters, names))
+	return test
+}
+
+func (v *scaffoldGenerator_) generateTests(
+	className string,
+	class ast.ClassDeclarationLike,
+	instance ast.InstanceDeclarationLike,
+) string {
+	// Concrete types are substituted for any generic type parameters.
+	var classArguments string
+	var constraints = class.GetDeclaration().GetOptionalConstraints()
+	if uti.IsDefined(constraints) {
+		var list = []ast.ConstraintLike{constraints.GetConstraint()}
+		var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+		for additionalConstraints.HasNext() {
+			list = append(list, additionalConstraints.GetNext().GetConstraint())
+		}
+		var arguments []string
+		for _, constraint := range list {
+			var substitution = v.formatter_.FormatAbstraction(constraint.GetAbstraction())
+			if substitution == "comparable" {
+				substitution = "string"
+			}
+			v.substitutions_[constraint.GetName()] = substitution
+			arguments = append(arguments, substitution)
+		}
+		classArguments = "[" + sts.Join(arguments, ", ") + "]"
+	}
+
+	// The first constructor is used to create each instance under test.
+	var tests string
+	var constructors = class.GetClassMethods().GetConstructorSubsection().GetConstructorMethods()
+	var constructor = constructors.GetIterator().GetNext()
+	var names = map[string]string{}
+	var used = map[string]bool{"label": true}
+	var parameters = v.getParameters(constructor.GetOptionalParameterList())
+	var constructorFields = v.formatFields(parameters, names, used)
+	var construction = className + "Class" + classArguments + "()." +
+		constructor.GetName() + "(" + v.formatArguments(parameters, names) + ")"
+	var copyUsed = func() map[string]bool {
+		var copied = map[string]bool{}
+		for name := range used {
+			copied[name] = true
+		}
+		return copied
+	}
+
+	// Generate a table driven test for each principal method.
+	var instanceMethods = instance.GetInstanceMethods()
+	var principalMethods = instanceMethods.GetPrincipalSubsection().GetPrincipalMethods().GetIterator()
+	for principalMethods.HasNext() {
+		var method = principalMethods.GetNext().GetMethod()
+		if method.GetName() == "GetClass" {
+			continue
+		}
+		tests += v.generateMethodTest(
+			className,
+			construction,
+			constructorFields,
+			method,
+			copyUsed(),
+		)
+	}
+
+	// Generate a round-trip test for each attribute.
+	var attributeSubsection = instanceMethods.GetOptionalAttributeSubsection()
+	if uti.IsDefined(attributeSubsection) {
+		var setters = map[string]ast.SetterMethodLike{}
+		var getters []ast.GetterMethodLike
+		var attributeMethods = attributeSubsection.GetAttributeMethods().GetIterator()
+		for attributeMethods.HasNext() {
+			switch actual := attributeMethods.GetNext().GetAny().(type) {
+			case ast.GetterMethodLike:
+				getters = append(getters, actual)
+			case ast.SetterMethodLike:
+				setters[sts.TrimPrefix(actual.GetName(), "Set")] = actual
+			}
+		}
+		for _, getter := range getters {
+			tests += v.generateAttributeTest(
+				className,
+				construction,
+				constructorFields,
+				getter,
+				setters[sts.TrimPrefix(getter.GetName(), "Get")],
+				copyUsed(),
+			)
+		}
+	}
+
+	// Generate a required attribute test for each constructor.
+	var constructorIterator = constructors.GetIterator()
+	for constructorIterator.HasNext() {
+		tests += v.generateRequiredTest(
+			className,
+			classArguments,
+			constructorIterator.GetNext(),
+		)
+	}
+	return tests
+}
+
+func (v *scaffoldGenerator_) getParameters(
+	parameterList ast.ParameterListLike,
+) []ast.ParameterLike {
+	var parameters []ast.ParameterLike
+	if uti.IsDefined(parameterList) {
+		var iterator = parameterList.GetParameters().GetIterator()
+		for iterator.HasNext() {
+			parameters = append(parameters, iterator.GetNext())
+		}
+	}
+	return parameters
+}
+
+func (v *scaffoldGenerator_) isVariadic(
+	parameter ast.ParameterLike,
+) bool {
+	var wrapper = parameter.GetAbstraction().GetOptionalWrapper()
+	if uti.IsUndefined(wrapper) {
+		return false
+	}
+	var _, ok = wrapper.GetAny().(ast.DotsLike)
+	return ok
+}
+
+func (v *scaffoldGenerator_) makeUnique(
+	name string,
+	used map[string]bool,
+) string {
+	var unique = name
+	for count := 2; used[unique]; count++ {
+		unique = fmt.Sprintf("%s%d", name, count)
+	}
+	used[unique] = true
+	return unique
+}
+
+func (v *scaffoldGenerator_) renderFragment(
+	fragment string,
+	name string,
+) string {
+	if name != "Named" {
+		return fragment
+	}
+	var prefix, _, found = sts.Cut(fragment, ".")
+	if found {
+		v.prefixes_.AddValue(prefix)
+		return fragment
+	}
+	return v.formatName(fragment)
+}
+
+func (v *scaffoldGenerator_) substituteArguments(
+	declaration ast.DeclarationLike,
+	named ast.NamedLike,
+) map[string]string {
+	// Substitute the type arguments of a generic type for its type parameters,
+	// returning the previous substitutions.
+	var previous = v.substitutions_
+	var substitutions = map[string]string{}
+	for key, value := range previous {
+		substitutions[key] = value
+	}
+	var constraints = declaration.GetOptionalConstraints()
+	var arguments = named.GetOptionalArguments()
+	if uti.IsDefined(constraints) && uti.IsDefined(arguments) {
+		var names = []string{constraints.GetConstraint().GetName()}
+		var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+		for additionalConstraints.HasNext() {
+			names = append(names, additionalConstraints.GetNext().GetConstraint().GetName())
+		}
+		var values = []string{
+			v.formatter_.FormatAbstraction(arguments.GetArgument().GetAbstraction()),
+		}
+		var additionalArguments = arguments.GetAdditionalArguments().GetIterator()
+		for additionalArguments.HasNext() {
+			var argument = additionalArguments.GetNext().GetArgument()
+			values = append(values, v.formatter_.FormatAbstraction(argument.GetAbstraction()))
+		}
+		for index, name := range names {
+			if index < len(values) {
+				substitutions[name] = values[index]
+			}
+		}
+	}
+	v.substitutions_ = substitutions
+	return previous
+}
+
+// Instance Structure
+
+type scaffoldGenerator_ struct {
+	// Declare the instance attributes.
+	formatter_     gra.FormatterLike
+	declarations_  map[string]any
+	prefixes_      com.SetLike[string]
+	substitutions_ map[string]string
+}
+
+// Class Structure
+
+type scaffoldGeneratorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func scaffoldGeneratorClass() *scaffoldGeneratorClass_ {
+	return scaffoldGeneratorClassReference_
+}
+
+var scaffoldGeneratorClassReference_ = &scaffoldGeneratorClass_{
+	// Initialize the class constants.
+}
+
+// Private Constants
+
+// NOTE:
+// The following templates use the "<name>" placeholder syntax supported by the
+// uti.ReplaceAll() function.
+
+const scaffoldTemplate_ = `<notice>
+package <packageName>
+
+import (<importedPackages>
+)
+<tests>`
+
+const methodTestTemplate_ = `
+func Test<ClassName><MethodName>(t *tes.T) {
+	var tests = []struct {
+		label string
+<fields>	}{
+		// TODO: Add the test cases.
+	}
+	for _, test := range tests {
+		t.Run(test.label, func(t *tes.T) {
+			var instance = <construction>
+<assertion>		})
+	}
+}
+`
+
+const attributeTestTemplate_ = `
+func Test<ClassName><AttributeName>Attribute(t *tes.T) {
+	var tests = []struct {
+		label string
+<fields>	}{
+		// TODO: Add the test cases.
+	}
+	for _, test := range tests {
+		t.Run(test.label, func(t *tes.T) {
+			var instance = <construction>
+<assertion>		})
+	}
+}
+`
+
+const requiredTestTemplate_ = `
+func Test<TestName>RequiredAttributes(t *tes.T) {
+	var tests = []struct {
+		label string
+<fields>		expected string
+	}{<cases>
+	}
+	for _, test := range tests {
+		t.Run(test.label, func(t *tes.T) {
+			ass.PanicsWithValue(t, test.expected, func() {
+				<ClassName>Class<classArguments>().<ConstructorName>(<arguments>)
+			})
+		})
+	}
+}
+`
+
+const requiredCaseTemplate_ = `
+		{
+			label: "undefined <attributeName>",<definitions>
+			expected: "The \"<attributeName>\" attribute is required by this class.",
+		},`
@@ -0,0 +1,111 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func SyntaxClass() SyntaxClassLike {
+	return syntaxClass()
+}
+
+// Constructor Methods
+
+func (c *syntaxClass_) Syntax(
+	notice string,
+	rules com.Sequential[RuleLike],
+	tokens com.Sequential[string],
+) SyntaxLike {
+	if uti.IsUndefined(notice) {
+		panic("The \"notice\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(rules) {
+		panic("The \"rules\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(tokens) {
+		panic("The \"tokens\" attribute is required by this class.")
+	}
+	var instance = &syntax_{
+		// Initialize the instance attributes.
+		notice_: notice,
+		rules_:  rules,
+		tokens_: tokens,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *syntax_) GetClass() SyntaxClassLike {
+	return syntaxClass()
+}
+
+// Attribute Methods
+
+func (v *syntax_) GetNotice() string {
+	return v.notice_
+}
+
+func (v *syntax_) GetRules() com.Sequential[RuleLike] {
+	return v.rules_
+}
+
+func (v *syntax_) GetTokens() com.Sequential[string] {
+	return v.tokens_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type syntax_ struct {
+	// Declare the instance attributes.
+	notice_ string
+	rules_  com.Sequential[RuleLike]
+	tokens_ com.Sequential[string]
+}
+
+// Class Structure
+
+type syntaxClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func syntaxClass() *syntaxClass_ {
+	return syntaxClassReference_
+}
+
+var syntaxClassReference_ = &syntaxClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,107 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package generator
+
+import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func TermClass() TermClassLike {
+	return termClass()
+}
+
+// Constructor Methods
+
+func (c *termClass_) Term(
+	text string,
+	cardinality Cardinality,
+) TermLike {
+	if uti.IsUndefined(text) {
+		panic("The \"text\" attribute is required by this class.")
+	}
+	var instance = &term_{
+		// Initialize the instance attributes.
+		text_:        text,
+		cardinality_: cardinality,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *term_) GetClass() TermClassLike {
+	return termClass()
+}
+
+func (v *term_) IsLiteral() bool {
+	return sts.HasPrefix(v.text_, "\"")
+}
+
+func (v *term_) IsToken() bool {
+	return !v.IsLiteral() && uni.IsLower([]rune(v.text_)[0])
+}
+
+// Attribute Methods
+
+func (v *term_) GetText() string {
+	return v.text_
+}
+
+func (v *term_) GetCardinality() Cardinality {
+	return v.cardinality_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type term_ struct {
+	// Declare the instance attributes.
+	text_        string
+	cardinality_ Cardinality
+}
+
+// Class Structure
+
+type termClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func termClass() *termClass_ {
+	return termClassReference_
+}
+
+var termClassReference_ = &termClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,362 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│         This "package_api.go" file was automatically generated using:        │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+
+Package "generator" provides the following classes that regenerate the grammar
+classes for this module from its "syntax.cdsn" grammar, and that generate other
+artifacts from a class model:
+  - Term captures a single term and its cardinality within a rule definition.
+  - Rule captures the name, definition and terms of a single rule.
+  - Syntax captures the legal notice, rules and token types of a grammar.
+  - Reader is used to read a Crater Dog Syntax Notation™ (CDSN) document.
+  - Generator is used to generate the parser, visitor, processor, inspector,
+    composite processor, encoder, decoder and transformer classes, and the JSON
+    schema for the encoded abstract syntax tree.
+  - DiagramGenerator is used to generate Mermaid and PlantUML class diagrams.
+  - DocumentGenerator is used to generate Markdown and HTML API documentation.
+  - FakeGenerator is used to generate a recording fake for each interface.
+  - ScaffoldGenerator is used to generate a test file skeleton for each class.
+
+For detailed documentation on this package refer to the wiki:
+  - https://github.com/craterdog/go-class-model/wiki
+
+This package follows the Crater Dog Technologies™ Go Coding Conventions located
+here:
+  - https://github.com/craterdog/go-development-tools/wiki/Coding-Conventions
+
+Additional concrete implementations of the classes declared by this package can
+be developed and used seamlessly since the interface declarations only depend on
+other interfaces and intrinsic types—and the class implementations only depend
+on interfaces, not on each other.
+*/
+package generator
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+)
+
+// TYPE DECLARATIONS
+
+/*
+Cardinality is a constrained type representing the number of times that a term
+may occur within a rule definition.
+*/
+type Cardinality uint8
+
+const (
+	SingleCardinality Cardinality = iota
+	OptionalCardinality
+	ZeroOrMoreCardinality
+	OneOrMoreCardinality
+)
+
+// FUNCTIONAL DECLARATIONS
+
+// CLASS DECLARATIONS
+
+/*
+DiagramGeneratorClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete diagram-generator-like class.
+*/
+type DiagramGeneratorClassLike interface {
+	// Constructor Methods
+	DiagramGenerator() DiagramGeneratorLike
+}
+
+/*
+DocumentGeneratorClassLike is a class interface that declares the complete set
+of class constructors, constants and functions that must be supported by each
+concrete document-generator-like class.
+*/
+type DocumentGeneratorClassLike interface {
+	// Constructor Methods
+	DocumentGenerator() DocumentGeneratorLike
+}
+
+/*
+FakeGeneratorClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete fake-generator-like class.
+*/
+type FakeGeneratorClassLike interface {
+	// Constructor Methods
+	FakeGenerator() FakeGeneratorLike
+}
+
+/*
+GeneratorClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+generator-like class.
+*/
+type GeneratorClassLike interface {
+	// Constructor Methods
+	Generator() GeneratorLike
+}
+
+/*
+ReaderClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+reader-like class.
+*/
+type ReaderClassLike interface {
+	// Constructor Methods
+	Reader() ReaderLike
+}
+
+/*
+RuleClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+rule-like class.
+*/
+type RuleClassLike interface {
+	// Constructor Methods
+	Rule(
+		name string,
+		definition string,
+		alternatives com.Sequential[string],
+		terms com.Sequential[TermLike],
+	) RuleLike
+}
+
+/*
+ScaffoldGeneratorClassLike is a class interface that declares the complete set
+of class constructors, constants and functions that must be supported by each
+concrete scaffold-generator-like class.
+*/
+type ScaffoldGeneratorClassLike interface {
+	// Constructor Methods
+	ScaffoldGenerator() ScaffoldGeneratorLike
+}
+
+/*
+SyntaxClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+syntax-like class.
+*/
+type SyntaxClassLike interface {
+	// Constructor Methods
+	Syntax(
+		notice string,
+		rules com.Sequential[RuleLike],
+		tokens com.Sequential[string],
+	) SyntaxLike
+}
+
+/*
+TermClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+term-like class.
+*/
+type TermClassLike interface {
+	// Constructor Methods
+	Term(
+		text string,
+		cardinality Cardinality,
+	) TermLike
+}
+
+// INSTANCE DECLARATIONS
+
+/*
+DiagramGeneratorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete diagram-generator-like class.  Each class and instance interface
+pair in a model is rendered as a single class, each aspect interface that it
+supports as a realization and each type that it references as an association.
+*/
+type DiagramGeneratorLike interface {
+	// Principal Methods
+	GetClass() DiagramGeneratorClassLike
+	GenerateMermaid(
+		model ast.ModelLike,
+	) string
+	GeneratePlantUML(
+		model ast.ModelLike,
+	) string
+
+	// Aspect Interfaces
+	gra.Methodical
+}
+
+/*
+DocumentGeneratorLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete document-generator-like class.  Each generated document
+contains a section for each kind of declaration in the model, and each type
+reference in a method signature links to the declaration of that type.
+*/
+type DocumentGeneratorLike interface {
+	// Principal Methods
+	GetClass() DocumentGeneratorClassLike
+	GenerateHTML(
+		model ast.ModelLike,
+	) string
+	GenerateMarkdown(
+		model ast.ModelLike,
+	) string
+
+	// Aspect Interfaces
+	gra.Methodical
+}
+
+/*
+FakeGeneratorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete fake-generator-like class.  A recording fake is generated for each
+instance and aspect declaration in the model.  Each fake counts the calls to
+each of its methods, captures their arguments and returns the result values
+that have been assigned to it.  Generic fakes use the constraints of the
+interface they implement.
+*/
+type FakeGeneratorLike interface {
+	// Principal Methods
+	GetClass() FakeGeneratorClassLike
+	GenerateFakes(
+		model ast.ModelLike,
+	) string
+}
+
+/*
+GeneratorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete generator-like class.
+*/
+type GeneratorLike interface {
+	// Principal Methods
+	GetClass() GeneratorClassLike
+	GenerateCompositeProcessor(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateDecoder(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateEncoder(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateInspector(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateParser(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateProcessor(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateSchema(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateTransformer(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+	GenerateVisitor(
+		moduleName string,
+		syntax SyntaxLike,
+	) string
+}
+
+/*
+ReaderLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete reader-like class.
+*/
+type ReaderLike interface {
+	// Principal Methods
+	GetClass() ReaderClassLike
+	ReadSyntax(
+		source string,
+	) SyntaxLike
+}
+
+/*
+RuleLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete rule-like class.
+*/
+type RuleLike interface {
+	// Principal Methods
+	GetClass() RuleClassLike
+	IsAlternatives() bool
+
+	// Attribute Methods
+	GetName() string
+	GetDefinition() string
+	GetAlternatives() com.Sequential[string]
+	GetTerms() com.Sequential[TermLike]
+}
+
+/*
+ScaffoldGeneratorLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete scaffold-generator-like class.  A test scaffold is
+generated for each instance declaration in the model.  It contains a table
+driven test for each principal method, a round-trip test for each attribute,
+and a required attribute test for each constructor.  The resulting catalog maps
+the name of each test file onto its source code.
+*/
+type ScaffoldGeneratorLike interface {
+	// Principal Methods
+	GetClass() ScaffoldGeneratorClassLike
+	GenerateScaffolds(
+		model ast.ModelLike,
+	) com.CatalogLike[string, string]
+}
+
+/*
+SyntaxLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete syntax-like class.
+*/
+type SyntaxLike interface {
+	// Principal Methods
+	GetClass() SyntaxClassLike
+
+	// Attribute Methods
+	GetNotice() string
+	GetRules() com.Sequential[RuleLike]
+	GetTokens() com.Sequential[string]
+}
+
+/*
+TermLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete term-like class.
+*/
+type TermLike interface {
+	// Principal Methods
+	GetClass() TermClassLike
+	IsLiteral() bool
+	IsToken() bool
+
+	// Attribute Methods
+	GetText() string
+	GetCardinality() Cardinality
+}
+
+// ASPECT DECLARATIONS
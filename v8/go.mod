@@ -6,10 +6,10 @@ require (
 	github.com/craterdog/go-essential-composites/v8 v8.5.0
 	github.com/craterdog/go-essential-utilities/v8 v8.4.0
 	github.com/stretchr/testify v1.11.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,2188 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func CompositeProcessorClass() CompositeProcessorClassLike {
+	return compositeProcessorClass()
+}
+
+// Constructor Methods
+
+func (c *compositeProcessorClass_) CompositeProcessor(
+	processors com.Sequential[Methodical],
+) CompositeProcessorLike {
+	if uti.IsUndefined(processors) {
+		panic("The \"processors\" attribute is required by this class.")
+	}
+	var instance = &compositeProcessor_{
+		// Initialize the instance attributes.
+		processors_: processors,
+		failures_:   com.Catalog[uint, string](),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *compositeProcessor_) GetClass() CompositeProcessorClassLike {
+	return compositeProcessorClass()
+}
+
+// Attribute Methods
+
+func (v *compositeProcessor_) GetProcessors() com.Sequential[Methodical] {
+	return v.processors_
+}
+
+func (v *compositeProcessor_) GetFailures() com.CatalogLike[uint, string] {
+	return v.failures_
+}
+
+// Methodical Methods
+
+func (v *compositeProcessor_) ProcessComment(
+	comment string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessComment(comment)
+	})
+}
+
+func (v *compositeProcessor_) ProcessDelimiter(
+	delimiter string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessDelimiter(delimiter)
+	})
+}
+
+func (v *compositeProcessor_) ProcessName(
+	name string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessName(name)
+	})
+}
+
+func (v *compositeProcessor_) ProcessNewline(
+	newline string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessNewline(newline)
+	})
+}
+
+func (v *compositeProcessor_) ProcessNote(
+	note string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessNote(note)
+	})
+}
+
+func (v *compositeProcessor_) ProcessNumber(
+	number string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessNumber(number)
+	})
+}
+
+func (v *compositeProcessor_) ProcessOperator(
+	operator string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessOperator(operator)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPrefix(
+	prefix string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPrefix(prefix)
+	})
+}
+
+func (v *compositeProcessor_) ProcessSpace(
+	space string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessSpace(space)
+	})
+}
+
+func (v *compositeProcessor_) ProcessString(
+	string_ string,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessString(string_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAbstraction(
+	abstraction ast.AbstractionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAbstraction(abstraction, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAbstraction(
+	abstraction ast.AbstractionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAbstraction(abstraction, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAbstractionSlot(
+	abstraction ast.AbstractionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAbstractionSlot(abstraction, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAdditionalArgument(
+	additionalArgument ast.AdditionalArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAdditionalArgument(additionalArgument, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAdditionalArgument(
+	additionalArgument ast.AdditionalArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAdditionalArgument(additionalArgument, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAdditionalArgumentSlot(
+	additionalArgument ast.AdditionalArgumentLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAdditionalArgumentSlot(additionalArgument, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAdditionalConstraint(
+	additionalConstraint ast.AdditionalConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAdditionalConstraint(additionalConstraint, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAdditionalConstraint(
+	additionalConstraint ast.AdditionalConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAdditionalConstraint(additionalConstraint, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAdditionalConstraintSlot(
+	additionalConstraint ast.AdditionalConstraintLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAdditionalConstraintSlot(additionalConstraint, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAdditionalValue(additionalValue, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAdditionalValue(additionalValue, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAdditionalValueSlot(
+	additionalValue ast.AdditionalValueLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAdditionalValueSlot(additionalValue, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessArgument(
+	argument ast.ArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessArgument(argument, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessArgument(
+	argument ast.ArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessArgument(argument, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessArgumentSlot(
+	argument ast.ArgumentLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessArgumentSlot(argument, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessArguments(
+	arguments ast.ArgumentsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessArguments(arguments, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessArguments(
+	arguments ast.ArgumentsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessArguments(arguments, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessArgumentsSlot(
+	arguments ast.ArgumentsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessArgumentsSlot(arguments, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessArray(
+	array ast.ArrayLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessArray(array, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessArray(
+	array ast.ArrayLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessArray(array, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessArraySlot(
+	array ast.ArrayLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessArraySlot(array, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAspectDeclaration(aspectDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAspectDeclaration(aspectDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAspectDeclarationSlot(
+	aspectDeclaration ast.AspectDeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAspectDeclarationSlot(aspectDeclaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAspectInterface(aspectInterface, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAspectInterface(aspectInterface, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAspectInterfaceSlot(
+	aspectInterface ast.AspectInterfaceLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAspectInterfaceSlot(aspectInterface, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAspectMember(aspectMember, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAspectMember(aspectMember, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAspectMemberSlot(
+	aspectMember ast.AspectMemberLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAspectMemberSlot(aspectMember, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAspectMethod(aspectMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAspectMethod(aspectMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAspectMethodSlot(
+	aspectMethod ast.AspectMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAspectMethodSlot(aspectMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAspectSection(
+	aspectSection ast.AspectSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAspectSection(aspectSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAspectSection(
+	aspectSection ast.AspectSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAspectSection(aspectSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAspectSectionSlot(
+	aspectSection ast.AspectSectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAspectSectionSlot(aspectSection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAspectSubsection(aspectSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAspectSubsection(aspectSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAspectSubsectionSlot(
+	aspectSubsection ast.AspectSubsectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAspectSubsectionSlot(aspectSubsection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAssignment(
+	assignment ast.AssignmentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAssignment(assignment, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAssignment(
+	assignment ast.AssignmentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAssignment(assignment, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAssignmentSlot(
+	assignment ast.AssignmentLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAssignmentSlot(assignment, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAttributeMethod(
+	attributeMethod ast.AttributeMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAttributeMethod(attributeMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAttributeMethod(
+	attributeMethod ast.AttributeMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAttributeMethod(attributeMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAttributeMethodSlot(
+	attributeMethod ast.AttributeMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAttributeMethodSlot(attributeMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessAttributeSubsection(attributeSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessAttributeSubsection(attributeSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessAttributeSubsectionSlot(
+	attributeSubsection ast.AttributeSubsectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessAttributeSubsectionSlot(attributeSubsection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessChannel(
+	channel ast.ChannelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessChannel(channel, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessChannel(
+	channel ast.ChannelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessChannel(channel, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessChannelSlot(
+	channel ast.ChannelLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessChannelSlot(channel, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessClassDeclaration(classDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessClassDeclaration(classDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessClassDeclarationSlot(
+	classDeclaration ast.ClassDeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessClassDeclarationSlot(classDeclaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessClassMethods(
+	classMethods ast.ClassMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessClassMethods(classMethods, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessClassMethods(
+	classMethods ast.ClassMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessClassMethods(classMethods, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessClassMethodsSlot(
+	classMethods ast.ClassMethodsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessClassMethodsSlot(classMethods, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessClassSection(
+	classSection ast.ClassSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessClassSection(classSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessClassSection(
+	classSection ast.ClassSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessClassSection(classSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessClassSectionSlot(
+	classSection ast.ClassSectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessClassSectionSlot(classSection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessConstantMethod(constantMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessConstantMethod(constantMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessConstantMethodSlot(
+	constantMethod ast.ConstantMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessConstantMethodSlot(constantMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessConstantSubsection(constantSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessConstantSubsection(constantSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessConstantSubsectionSlot(
+	constantSubsection ast.ConstantSubsectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessConstantSubsectionSlot(constantSubsection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessConstraint(
+	constraint ast.ConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessConstraint(constraint, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessConstraint(
+	constraint ast.ConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessConstraint(constraint, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessConstraintSlot(
+	constraint ast.ConstraintLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessConstraintSlot(constraint, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessConstraints(
+	constraints ast.ConstraintsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessConstraints(constraints, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessConstraints(
+	constraints ast.ConstraintsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessConstraints(constraints, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessConstraintsSlot(
+	constraints ast.ConstraintsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessConstraintsSlot(constraints, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessConstructorMethod(constructorMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessConstructorMethod(constructorMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessConstructorMethodSlot(
+	constructorMethod ast.ConstructorMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessConstructorMethodSlot(constructorMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessConstructorSubsection(constructorSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessConstructorSubsection(constructorSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessConstructorSubsectionSlot(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessConstructorSubsectionSlot(constructorSubsection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessDeclaration(
+	declaration ast.DeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessDeclaration(declaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessDeclaration(
+	declaration ast.DeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessDeclaration(declaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessDeclarationSlot(
+	declaration ast.DeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessDeclarationSlot(declaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessDots(
+	dots ast.DotsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessDots(dots, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessDots(
+	dots ast.DotsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessDots(dots, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessDotsSlot(
+	dots ast.DotsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessDotsSlot(dots, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessEnumeration(
+	enumeration ast.EnumerationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessEnumeration(enumeration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessEnumeration(
+	enumeration ast.EnumerationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessEnumeration(enumeration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessEnumerationSlot(
+	enumeration ast.EnumerationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessEnumerationSlot(enumeration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessExpression(
+	expression ast.ExpressionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessExpression(expression, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessExpression(
+	expression ast.ExpressionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessExpression(expression, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessExpressionSlot(
+	expression ast.ExpressionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessExpressionSlot(expression, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessFunctionMethod(functionMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessFunctionMethod(functionMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessFunctionMethodSlot(
+	functionMethod ast.FunctionMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessFunctionMethodSlot(functionMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessFunctionSubsection(functionSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessFunctionSubsection(functionSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessFunctionSubsectionSlot(
+	functionSubsection ast.FunctionSubsectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessFunctionSubsectionSlot(functionSubsection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessFunctional(
+	functional ast.FunctionalLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessFunctional(functional, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessFunctional(
+	functional ast.FunctionalLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessFunctional(functional, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessFunctionalSlot(
+	functional ast.FunctionalLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessFunctionalSlot(functional, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessFunctionalDeclaration(functionalDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessFunctionalDeclaration(functionalDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessFunctionalDeclarationSlot(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessFunctionalDeclarationSlot(functionalDeclaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessFunctionalSection(functionalSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessFunctionalSection(functionalSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessFunctionalSectionSlot(
+	functionalSection ast.FunctionalSectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessFunctionalSectionSlot(functionalSection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessGetterMethod(getterMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessGetterMethod(getterMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessGetterMethodSlot(
+	getterMethod ast.GetterMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessGetterMethodSlot(getterMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessImportList(
+	importList ast.ImportListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessImportList(importList, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessImportList(
+	importList ast.ImportListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessImportList(importList, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessImportListSlot(
+	importList ast.ImportListLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessImportListSlot(importList, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessImportedPackage(importedPackage, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessImportedPackage(importedPackage, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessImportedPackageSlot(
+	importedPackage ast.ImportedPackageLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessImportedPackageSlot(importedPackage, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessInstanceDeclaration(instanceDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessInstanceDeclaration(instanceDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessInstanceDeclarationSlot(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessInstanceDeclarationSlot(instanceDeclaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessInstanceMethods(
+	instanceMethods ast.InstanceMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessInstanceMethods(instanceMethods, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessInstanceMethods(
+	instanceMethods ast.InstanceMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessInstanceMethods(instanceMethods, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessInstanceMethodsSlot(
+	instanceMethods ast.InstanceMethodsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessInstanceMethodsSlot(instanceMethods, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessInstanceSection(instanceSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessInstanceSection(instanceSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessInstanceSectionSlot(
+	instanceSection ast.InstanceSectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessInstanceSectionSlot(instanceSection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessInterfaceDeclarations(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessInterfaceDeclarations(interfaceDeclarations, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessInterfaceDeclarations(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessInterfaceDeclarations(interfaceDeclarations, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessInterfaceDeclarationsSlot(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessInterfaceDeclarationsSlot(interfaceDeclarations, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessLegalNotice(
+	legalNotice ast.LegalNoticeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessLegalNotice(legalNotice, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessLegalNotice(
+	legalNotice ast.LegalNoticeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessLegalNotice(legalNotice, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessLegalNoticeSlot(
+	legalNotice ast.LegalNoticeLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessLegalNoticeSlot(legalNotice, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessMap(
+	map_ ast.MapLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessMap(map_, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessMap(
+	map_ ast.MapLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessMap(map_, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessMapSlot(
+	map_ ast.MapLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessMapSlot(map_, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessMethod(
+	method ast.MethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessMethod(method, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessMethod(
+	method ast.MethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessMethod(method, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessMethodSlot(
+	method ast.MethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessMethodSlot(method, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessModel(model, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessModel(model, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessModelSlot(
+	model ast.ModelLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessModelSlot(model, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessMultivalue(
+	multivalue ast.MultivalueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessMultivalue(multivalue, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessMultivalue(
+	multivalue ast.MultivalueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessMultivalue(multivalue, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessMultivalueSlot(
+	multivalue ast.MultivalueLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessMultivalueSlot(multivalue, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessNamed(
+	named ast.NamedLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessNamed(named, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessNamed(
+	named ast.NamedLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessNamed(named, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessNamedSlot(
+	named ast.NamedLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessNamedSlot(named, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessNone(
+	none ast.NoneLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessNone(none, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessNone(
+	none ast.NoneLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessNone(none, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessNoneSlot(
+	none ast.NoneLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessNoneSlot(none, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessOperand(
+	operand ast.OperandLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessOperand(operand, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessOperand(
+	operand ast.OperandLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessOperand(operand, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessOperandSlot(
+	operand ast.OperandLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessOperandSlot(operand, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessOperation(operation, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessOperation(operation, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessOperationSlot(
+	operation ast.OperationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessOperationSlot(operation, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessPackageDeclaration(
+	packageDeclaration ast.PackageDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessPackageDeclaration(packageDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessPackageDeclaration(
+	packageDeclaration ast.PackageDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessPackageDeclaration(packageDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPackageDeclarationSlot(
+	packageDeclaration ast.PackageDeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPackageDeclarationSlot(packageDeclaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessPackageHeader(packageHeader, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessPackageHeader(packageHeader, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPackageHeaderSlot(
+	packageHeader ast.PackageHeaderLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPackageHeaderSlot(packageHeader, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessPackageImports(
+	packageImports ast.PackageImportsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessPackageImports(packageImports, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessPackageImports(
+	packageImports ast.PackageImportsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessPackageImports(packageImports, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPackageImportsSlot(
+	packageImports ast.PackageImportsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPackageImportsSlot(packageImports, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessParameter(
+	parameter ast.ParameterLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessParameter(parameter, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessParameter(
+	parameter ast.ParameterLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessParameter(parameter, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessParameterSlot(
+	parameter ast.ParameterLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessParameterSlot(parameter, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessParameterList(
+	parameterList ast.ParameterListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessParameterList(parameterList, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessParameterList(
+	parameterList ast.ParameterListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessParameterList(parameterList, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessParameterListSlot(
+	parameterList ast.ParameterListLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessParameterListSlot(parameterList, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessPrimitiveDeclarations(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessPrimitiveDeclarations(primitiveDeclarations, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessPrimitiveDeclarations(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessPrimitiveDeclarations(primitiveDeclarations, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPrimitiveDeclarationsSlot(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPrimitiveDeclarationsSlot(primitiveDeclarations, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessPrincipalMethod(principalMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessPrincipalMethod(principalMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPrincipalMethodSlot(
+	principalMethod ast.PrincipalMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPrincipalMethodSlot(principalMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessPrincipalSubsection(principalSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessPrincipalSubsection(principalSubsection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessPrincipalSubsectionSlot(
+	principalSubsection ast.PrincipalSubsectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessPrincipalSubsectionSlot(principalSubsection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessResult(
+	result ast.ResultLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessResult(result, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessResult(
+	result ast.ResultLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessResult(result, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessResultSlot(
+	result ast.ResultLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessResultSlot(result, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessSetterMethod(setterMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessSetterMethod(setterMethod, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessSetterMethodSlot(
+	setterMethod ast.SetterMethodLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessSetterMethodSlot(setterMethod, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessSpecification(specification, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessSpecification(specification, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessSpecificationSlot(
+	specification ast.SpecificationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessSpecificationSlot(specification, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessStar(
+	star ast.StarLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessStar(star, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessStar(
+	star ast.StarLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessStar(star, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessStarSlot(
+	star ast.StarLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessStarSlot(star, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessType(
+	type_ ast.TypeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessType(type_, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessType(
+	type_ ast.TypeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessType(type_, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessTypeSlot(
+	type_ ast.TypeLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessTypeSlot(type_, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessTypeDeclaration(typeDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessTypeDeclaration(typeDeclaration, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessTypeDeclarationSlot(
+	typeDeclaration ast.TypeDeclarationLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessTypeDeclarationSlot(typeDeclaration, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessTypeSection(
+	typeSection ast.TypeSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessTypeSection(typeSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessTypeSection(
+	typeSection ast.TypeSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessTypeSection(typeSection, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessTypeSectionSlot(
+	typeSection ast.TypeSectionLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessTypeSectionSlot(typeSection, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessValue(
+	value ast.ValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessValue(value, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessValue(
+	value ast.ValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessValue(value, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessValueSlot(
+	value ast.ValueLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessValueSlot(value, slot_)
+	})
+}
+
+func (v *compositeProcessor_) PreprocessWrapper(
+	wrapper ast.WrapperLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PreprocessWrapper(wrapper, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) PostprocessWrapper(
+	wrapper ast.WrapperLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.PostprocessWrapper(wrapper, index_, count_)
+	})
+}
+
+func (v *compositeProcessor_) ProcessWrapperSlot(
+	wrapper ast.WrapperLike,
+	slot_ uint,
+) {
+	v.fanOut(func(processor Methodical) {
+		processor.ProcessWrapperSlot(wrapper, slot_)
+	})
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *compositeProcessor_) fanOut(
+	call func(processor Methodical),
+) {
+	// Call each child processor in order, skipping any that have failed.
+	var index uint
+	var processors = v.processors_.GetIterator()
+	for processors.HasNext() {
+		index++
+		var processor = processors.GetNext()
+		if uti.IsDefined(v.failures_.GetValue(index)) {
+			continue
+		}
+		v.isolate(index, processor, call)
+	}
+}
+
+func (v *compositeProcessor_) isolate(
+	index uint,
+	processor Methodical,
+	call func(processor Methodical),
+) {
+	defer func() {
+		var exception = recover()
+		if exception != nil {
+			// Every failure is recorded, even one without a message.
+			var failure = fmt.Sprintf("%v", exception)
+			if uti.IsUndefined(failure) {
+				failure = fmt.Sprintf("The processor failed with: %#v", exception)
+			}
+			v.failures_.SetValue(index, failure)
+		}
+	}()
+	call(processor)
+}
+
+// Instance Structure
+
+type compositeProcessor_ struct {
+	// Declare the instance attributes.
+	processors_ com.Sequential[Methodical]
+	failures_   com.CatalogLike[uint, string]
+}
+
+// Class Structure
+
+type compositeProcessorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func compositeProcessorClass() *compositeProcessorClass_ {
+	return compositeProcessorClassReference_
+}
+
+var compositeProcessorClassReference_ = &compositeProcessorClass_{
+	// Initialize the class constants.
+}
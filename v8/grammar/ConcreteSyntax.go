@@ -0,0 +1,318 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ConcreteSyntaxClass() ConcreteSyntaxClassLike {
+	return concreteSyntaxClass()
+}
+
+// Constructor Methods
+
+func (c *concreteSyntaxClass_) ConcreteSyntax(
+	source string,
+) ConcreteSyntaxLike {
+	if uti.IsUndefined(source) {
+		panic("The \"source\" attribute is required by this class.")
+	}
+	var instance = &concreteSyntax_{
+		// Initialize the instance attributes.
+		source_: source,
+	}
+	instance.model_ = ParserClass().Parser().ParseSource(source)
+	instance.tokens_ = instance.scanTokens(source)
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *concreteSyntax_) GetClass() ConcreteSyntaxClassLike {
+	return concreteSyntaxClass()
+}
+
+func (v *concreteSyntax_) FormatModel(
+	model ast.ModelLike,
+) string {
+	// Align the original tokens with those of the canonical edited source.
+	var canonical = FormatterClass().Formatter().FormatModel(model)
+	if sts.Contains(v.source_, "\r\n") {
+		// Any edits must use the same line endings as the original source.
+		canonical = sts.ReplaceAll(canonical, "\r\n", "\n")
+		canonical = sts.ReplaceAll(canonical, "\n", "\r\n")
+	}
+	var original = v.tokens_.AsArray()
+	var edited = v.scanTokens(canonical).AsArray()
+	var partners = v.alignTokens(original, edited)
+	var originalStarts, originalEnds = v.locateTokens(original)
+	var editedStarts, editedEnds = v.locateTokens(edited)
+
+	// Keep the original trivia between each pair of adjacent unchanged tokens
+	// and use the canonical trivia everywhere else.
+	var builder sts.Builder
+	var previous, previousPartner = -1, -1
+	var head, first int
+	for index, partner := range partners {
+		if partner < 0 {
+			continue
+		}
+		if index == previous+1 && partner == previousPartner+1 {
+			builder.WriteString(v.source_[head:originalStarts[index]])
+		} else {
+			builder.WriteString(canonical[first:editedStarts[partner]])
+		}
+		builder.WriteString(v.source_[originalStarts[index]:originalEnds[index]])
+		previous, previousPartner = index, partner
+		head, first = originalEnds[index], editedEnds[partner]
+	}
+	if previous == len(original)-1 && previousPartner == len(edited)-1 {
+		builder.WriteString(v.source_[head:])
+	} else {
+		builder.WriteString(canonical[first:])
+	}
+	return builder.String()
+}
+
+// Attribute Methods
+
+func (v *concreteSyntax_) GetSource() string {
+	return v.source_
+}
+
+func (v *concreteSyntax_) GetModel() ast.ModelLike {
+	return v.model_
+}
+
+func (v *concreteSyntax_) GetTokens() com.Sequential[ConcreteTokenLike] {
+	return v.tokens_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *concreteSyntax_) alignTokens(
+	original []ConcreteTokenLike,
+	edited []ConcreteTokenLike,
+) []int {
+	// Find the shortest edit script between the token sequences (Myers).
+	var originalSize = len(original)
+	var editedSize = len(edited)
+	var offset = originalSize + editedSize + 1
+	var frontier = make([]int, 2*offset+1)
+	var trace [][]int
+	var distance int
+	for found := false; !found; distance++ {
+		trace = append(trace, append([]int{}, frontier...))
+		for diagonal := -distance; diagonal <= distance; diagonal += 2 {
+			var x int
+			if diagonal == -distance || (diagonal != distance &&
+				frontier[offset+diagonal-1] < frontier[offset+diagonal+1]) {
+				x = frontier[offset+diagonal+1]
+			} else {
+				x = frontier[offset+diagonal-1] + 1
+			}
+			var y = x - diagonal
+			for x < originalSize && y < editedSize &&
+				v.matchTokens(original[x], edited[y]) {
+				x++
+				y++
+			}
+			frontier[offset+diagonal] = x
+			if x >= originalSize && y >= editedSize {
+				found = true
+				break
+			}
+		}
+	}
+
+	// Walk the edit script backwards pairing up each unchanged token.
+	var partners = make([]int, originalSize)
+	for index := range partners {
+		partners[index] = -1
+	}
+	var x, y = originalSize, editedSize
+	for distance--; distance >= 0; distance-- {
+		var previousX, previousY int
+		if distance > 0 {
+			var previous = trace[distance]
+			var diagonal = x - y
+			var previousDiagonal = diagonal - 1
+			if diagonal == -distance || (diagonal != distance &&
+				previous[offset+diagonal-1] < previous[offset+diagonal+1]) {
+				previousDiagonal = diagonal + 1
+			}
+			previousX = previous[offset+previousDiagonal]
+			previousY = previousX - previousDiagonal
+		}
+		for x > previousX && y > previousY {
+			x--
+			y--
+			partners[x] = y
+		}
+		x, y = previousX, previousY
+	}
+	return partners
+}
+
+func (v *concreteSyntax_) getText(
+	token TokenLike,
+) string {
+	// The scanner renames any lone control character.
+	var text = token.GetValue()
+	var control, ok = concreteSyntaxClass().controls_[text]
+	if ok {
+		text = control
+	}
+	return text
+}
+
+func (v *concreteSyntax_) isTrivia(
+	token TokenLike,
+) bool {
+	switch token.GetType() {
+	case SpaceToken, NewlineToken:
+		return true
+	default:
+		return false
+	}
+}
+
+func (v *concreteSyntax_) locateTokens(
+	tokens []ConcreteTokenLike,
+) (
+	starts []int,
+	ends []int,
+) {
+	// Determine the byte offsets of the text of each token.
+	var offset int
+	for _, token := range tokens {
+		offset += len(token.GetLeading())
+		starts = append(starts, offset)
+		offset += len(v.getText(token.GetToken()))
+		ends = append(ends, offset)
+		offset += len(token.GetTrailing())
+	}
+	return
+}
+
+func (v *concreteSyntax_) matchTokens(
+	first ConcreteTokenLike,
+	second ConcreteTokenLike,
+) bool {
+	// The parser expands any tabs so they must be expanded here as well.
+	var firstToken = first.GetToken()
+	var secondToken = second.GetToken()
+	var firstText = sts.ReplaceAll(v.getText(firstToken), "\t", "    ")
+	var secondText = sts.ReplaceAll(v.getText(secondToken), "\t", "    ")
+	return firstToken.GetType() == secondToken.GetType() && firstText == secondText
+}
+
+func (v *concreteSyntax_) scanTokens(
+	source string,
+) com.Sequential[ConcreteTokenLike] {
+	var class = ConcreteTokenClass()
+	var tokens = com.List[ConcreteTokenLike]()
+	var queue = com.Queue[TokenLike]()
+	ScannerClass().Scanner(source, queue)
+
+	// Attach any trivia on the same line as a token to it as trailing trivia,
+	// and any other trivia to the next token as leading trivia.
+	var leading, trailing, gap string
+	var pending TokenLike
+	var closed bool
+	var token, ok = queue.RemoveFirst()
+	for ok {
+		var text = v.getText(token)
+		switch {
+		case token.GetType() == ErrorToken:
+			var message = "An unexpected token was received by the scanner: "
+			panic(message + ScannerClass().FormatToken(token))
+		case !v.isTrivia(token):
+			if uti.IsDefined(pending) {
+				tokens.AppendValue(class.ConcreteToken(leading, pending, trailing))
+			}
+			leading = gap
+			trailing = ""
+			gap = ""
+			pending = token
+			closed = false
+		case uti.IsUndefined(pending) || closed:
+			gap += text
+		default:
+			trailing += text
+			closed = token.GetType() == NewlineToken
+		}
+		token, ok = queue.RemoveFirst()
+	}
+	if uti.IsDefined(pending) {
+		// Any trivia following the last token is also trailing trivia.
+		tokens.AppendValue(class.ConcreteToken(leading, pending, trailing+gap))
+	}
+	return tokens
+}
+
+// Instance Structure
+
+type concreteSyntax_ struct {
+	// Declare the instance attributes.
+	source_ string
+	model_  ast.ModelLike
+	tokens_ com.Sequential[ConcreteTokenLike]
+}
+
+// Class Structure
+
+type concreteSyntaxClass_ struct {
+	// Declare the class constants.
+	controls_ map[string]string
+}
+
+// Class Reference
+
+func concreteSyntaxClass() *concreteSyntaxClass_ {
+	return concreteSyntaxClassReference_
+}
+
+var concreteSyntaxClassReference_ = &concreteSyntaxClass_{
+	// Initialize the class constants.
+	controls_: map[string]string{
+		"<NULL>": "\x00",
+		"<BELL>": "\a",
+		"<BKSP>": "\b",
+		"<HTAB>": "\t",
+		"<FMFD>": "\f",
+		"<CRTN>": "\r",
+		"<VTAB>": "\v",
+	},
+}
@@ -0,0 +1,104 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ConcreteTokenClass() ConcreteTokenClassLike {
+	return concreteTokenClass()
+}
+
+// Constructor Methods
+
+func (c *concreteTokenClass_) ConcreteToken(
+	leading string,
+	token TokenLike,
+	trailing string,
+) ConcreteTokenLike {
+	if uti.IsUndefined(token) {
+		panic("The \"token\" attribute is required by this class.")
+	}
+	var instance = &concreteToken_{
+		// Initialize the instance attributes.
+		leading_:  leading,
+		token_:    token,
+		trailing_: trailing,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *concreteToken_) GetClass() ConcreteTokenClassLike {
+	return concreteTokenClass()
+}
+
+// Attribute Methods
+
+func (v *concreteToken_) GetLeading() string {
+	return v.leading_
+}
+
+func (v *concreteToken_) GetToken() TokenLike {
+	return v.token_
+}
+
+func (v *concreteToken_) GetTrailing() string {
+	return v.trailing_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type concreteToken_ struct {
+	// Declare the instance attributes.
+	leading_  string
+	token_    TokenLike
+	trailing_ string
+}
+
+// Class Structure
+
+type concreteTokenClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func concreteTokenClass() *concreteTokenClass_ {
+	return concreteTokenClassReference_
+}
+
+var concreteTokenClassReference_ = &concreteTokenClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,1272 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DecoderClass() DecoderClassLike {
+	return decoderClass()
+}
+
+// Constructor Methods
+
+func (c *decoderClass_) Decoder() DecoderLike {
+	var instance = &decoder_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *decoder_) GetClass() DecoderClassLike {
+	return decoderClass()
+}
+
+func (v *decoder_) DecodeModel(
+	source string,
+) ast.ModelLike {
+	var value any
+	var err = jsn.Unmarshal([]byte(source), &value)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The JSON source is not valid: %v",
+			err,
+		)
+		panic(message)
+	}
+	return v.decodeModel(value)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *decoder_) decodeAbstraction(
+	encoded any,
+) ast.AbstractionLike {
+	var node = v.getNode(encoded, "Abstraction")
+	var optionalWrapper ast.WrapperLike
+	if uti.IsDefined(node["optionalWrapper"]) {
+		optionalWrapper = v.decodeWrapper(node["optionalWrapper"])
+	}
+	var type_ = v.decodeType(v.getValue(node, "type"))
+	return ast.AbstractionClass().Abstraction(
+		optionalWrapper,
+		type_,
+	)
+}
+
+func (v *decoder_) decodeAdditionalArgument(
+	encoded any,
+) ast.AdditionalArgumentLike {
+	var node = v.getNode(encoded, "AdditionalArgument")
+	var delimiter = v.getString(node, "delimiter")
+	var argument = v.decodeArgument(v.getValue(node, "argument"))
+	return ast.AdditionalArgumentClass().AdditionalArgument(
+		delimiter,
+		argument,
+	)
+}
+
+func (v *decoder_) decodeAdditionalConstraint(
+	encoded any,
+) ast.AdditionalConstraintLike {
+	var node = v.getNode(encoded, "AdditionalConstraint")
+	var delimiter = v.getString(node, "delimiter")
+	var constraint = v.decodeConstraint(v.getValue(node, "constraint"))
+	return ast.AdditionalConstraintClass().AdditionalConstraint(
+		delimiter,
+		constraint,
+	)
+}
+
+func (v *decoder_) decodeAdditionalValue(
+	encoded any,
+) ast.AdditionalValueLike {
+	var node = v.getNode(encoded, "AdditionalValue")
+	var name = v.getString(node, "name")
+	var specification = v.decodeSpecification(v.getValue(node, "specification"))
+	return ast.AdditionalValueClass().AdditionalValue(
+		name,
+		specification,
+	)
+}
+
+func (v *decoder_) decodeArgument(
+	encoded any,
+) ast.ArgumentLike {
+	var node = v.getNode(encoded, "Argument")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	return ast.ArgumentClass().Argument(abstraction)
+}
+
+func (v *decoder_) decodeArguments(
+	encoded any,
+) ast.ArgumentsLike {
+	var node = v.getNode(encoded, "Arguments")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var argument = v.decodeArgument(v.getValue(node, "argument"))
+	var additionalArguments = com.List[ast.AdditionalArgumentLike]()
+	for _, element := range v.getArray(node, "additionalArguments") {
+		additionalArguments.AppendValue(v.decodeAdditionalArgument(element))
+	}
+	var delimiter2 = v.getString(node, "delimiter2")
+	return ast.ArgumentsClass().Arguments(
+		delimiter1,
+		argument,
+		additionalArguments,
+		delimiter2,
+	)
+}
+
+func (v *decoder_) decodeArray(
+	encoded any,
+) ast.ArrayLike {
+	var node = v.getNode(encoded, "Array")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	return ast.ArrayClass().Array(
+		delimiter1,
+		delimiter2,
+	)
+}
+
+func (v *decoder_) decodeAspectDeclaration(
+	encoded any,
+) ast.AspectDeclarationLike {
+	var node = v.getNode(encoded, "AspectDeclaration")
+	var declaration = v.decodeDeclaration(v.getValue(node, "declaration"))
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var aspectMembers = com.List[ast.AspectMemberLike]()
+	for _, element := range v.getArray(node, "aspectMembers") {
+		aspectMembers.AppendValue(v.decodeAspectMember(element))
+	}
+	var delimiter3 = v.getString(node, "delimiter3")
+	return ast.AspectDeclarationClass().AspectDeclaration(
+		declaration,
+		delimiter1,
+		delimiter2,
+		aspectMembers,
+		delimiter3,
+	)
+}
+
+func (v *decoder_) decodeAspectInterface(
+	encoded any,
+) ast.AspectInterfaceLike {
+	var node = v.getNode(encoded, "AspectInterface")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	return ast.AspectInterfaceClass().AspectInterface(abstraction)
+}
+
+func (v *decoder_) decodeAspectMember(
+	encoded any,
+) ast.AspectMemberLike {
+	var node = v.getNode(encoded, "AspectMember")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case map[string]any:
+		switch actual["kind"] {
+		case "AspectMethod":
+			any_ = v.decodeAspectMethod(actual)
+		case "AspectInterface":
+			any_ = v.decodeAspectInterface(actual)
+		default:
+			var message = fmt.Sprintf(
+				"An unexpected aspectMember kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected aspectMember value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.AspectMemberClass().AspectMember(any_)
+}
+
+func (v *decoder_) decodeAspectMethod(
+	encoded any,
+) ast.AspectMethodLike {
+	var node = v.getNode(encoded, "AspectMethod")
+	var method = v.decodeMethod(v.getValue(node, "method"))
+	return ast.AspectMethodClass().AspectMethod(method)
+}
+
+func (v *decoder_) decodeAspectSection(
+	encoded any,
+) ast.AspectSectionLike {
+	var node = v.getNode(encoded, "AspectSection")
+	var delimiter = v.getString(node, "delimiter")
+	var aspectDeclarations = com.List[ast.AspectDeclarationLike]()
+	for _, element := range v.getArray(node, "aspectDeclarations") {
+		aspectDeclarations.AppendValue(v.decodeAspectDeclaration(element))
+	}
+	return ast.AspectSectionClass().AspectSection(
+		delimiter,
+		aspectDeclarations,
+	)
+}
+
+func (v *decoder_) decodeAspectSubsection(
+	encoded any,
+) ast.AspectSubsectionLike {
+	var node = v.getNode(encoded, "AspectSubsection")
+	var delimiter = v.getString(node, "delimiter")
+	var aspectInterfaces = com.List[ast.AspectInterfaceLike]()
+	for _, element := range v.getArray(node, "aspectInterfaces") {
+		aspectInterfaces.AppendValue(v.decodeAspectInterface(element))
+	}
+	return ast.AspectSubsectionClass().AspectSubsection(
+		delimiter,
+		aspectInterfaces,
+	)
+}
+
+func (v *decoder_) decodeAssignment(
+	encoded any,
+) ast.AssignmentLike {
+	var node = v.getNode(encoded, "Assignment")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	var delimiter = v.getString(node, "delimiter")
+	var expression = v.decodeExpression(v.getValue(node, "expression"))
+	return ast.AssignmentClass().Assignment(
+		abstraction,
+		delimiter,
+		expression,
+	)
+}
+
+func (v *decoder_) decodeAttributeMethod(
+	encoded any,
+) ast.AttributeMethodLike {
+	var node = v.getNode(encoded, "AttributeMethod")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case map[string]any:
+		switch actual["kind"] {
+		case "GetterMethod":
+			any_ = v.decodeGetterMethod(actual)
+		case "SetterMethod":
+			any_ = v.decodeSetterMethod(actual)
+		default:
+			var message = fmt.Sprintf(
+				"An unexpected attributeMethod kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected attributeMethod value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.AttributeMethodClass().AttributeMethod(any_)
+}
+
+func (v *decoder_) decodeAttributeSubsection(
+	encoded any,
+) ast.AttributeSubsectionLike {
+	var node = v.getNode(encoded, "AttributeSubsection")
+	var delimiter = v.getString(node, "delimiter")
+	var attributeMethods = com.List[ast.AttributeMethodLike]()
+	for _, element := range v.getArray(node, "attributeMethods") {
+		attributeMethods.AppendValue(v.decodeAttributeMethod(element))
+	}
+	return ast.AttributeSubsectionClass().AttributeSubsection(
+		delimiter,
+		attributeMethods,
+	)
+}
+
+func (v *decoder_) decodeChannel(
+	encoded any,
+) ast.ChannelLike {
+	var node = v.getNode(encoded, "Channel")
+	var delimiter = v.getString(node, "delimiter")
+	return ast.ChannelClass().Channel(delimiter)
+}
+
+func (v *decoder_) decodeClassDeclaration(
+	encoded any,
+) ast.ClassDeclarationLike {
+	var node = v.getNode(encoded, "ClassDeclaration")
+	var declaration = v.decodeDeclaration(v.getValue(node, "declaration"))
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var classMethods = v.decodeClassMethods(v.getValue(node, "classMethods"))
+	var delimiter3 = v.getString(node, "delimiter3")
+	return ast.ClassDeclarationClass().ClassDeclaration(
+		declaration,
+		delimiter1,
+		delimiter2,
+		classMethods,
+		delimiter3,
+	)
+}
+
+func (v *decoder_) decodeClassMethods(
+	encoded any,
+) ast.ClassMethodsLike {
+	var node = v.getNode(encoded, "ClassMethods")
+	var constructorSubsection = v.decodeConstructorSubsection(v.getValue(node, "constructorSubsection"))
+	var optionalConstantSubsection ast.ConstantSubsectionLike
+	if uti.IsDefined(node["optionalConstantSubsection"]) {
+		optionalConstantSubsection = v.decodeConstantSubsection(node["optionalConstantSubsection"])
+	}
+	var optionalFunctionSubsection ast.FunctionSubsectionLike
+	if uti.IsDefined(node["optionalFunctionSubsection"]) {
+		optionalFunctionSubsection = v.decodeFunctionSubsection(node["optionalFunctionSubsection"])
+	}
+	return ast.ClassMethodsClass().ClassMethods(
+		constructorSubsection,
+		optionalConstantSubsection,
+		optionalFunctionSubsection,
+	)
+}
+
+func (v *decoder_) decodeClassSection(
+	encoded any,
+) ast.ClassSectionLike {
+	var node = v.getNode(encoded, "ClassSection")
+	var delimiter = v.getString(node, "delimiter")
+	var classDeclarations = com.List[ast.ClassDeclarationLike]()
+	for _, element := range v.getArray(node, "classDeclarations") {
+		classDeclarations.AppendValue(v.decodeClassDeclaration(element))
+	}
+	return ast.ClassSectionClass().ClassSection(
+		delimiter,
+		classDeclarations,
+	)
+}
+
+func (v *decoder_) decodeConstantMethod(
+	encoded any,
+) ast.ConstantMethodLike {
+	var node = v.getNode(encoded, "ConstantMethod")
+	var optionalNote = v.getOptionalString(node, "optionalNote")
+	var name = v.getString(node, "name")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	return ast.ConstantMethodClass().ConstantMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		delimiter2,
+		abstraction,
+	)
+}
+
+func (v *decoder_) decodeConstantSubsection(
+	encoded any,
+) ast.ConstantSubsectionLike {
+	var node = v.getNode(encoded, "ConstantSubsection")
+	var delimiter = v.getString(node, "delimiter")
+	var constantMethods = com.List[ast.ConstantMethodLike]()
+	for _, element := range v.getArray(node, "constantMethods") {
+		constantMethods.AppendValue(v.decodeConstantMethod(element))
+	}
+	return ast.ConstantSubsectionClass().ConstantSubsection(
+		delimiter,
+		constantMethods,
+	)
+}
+
+func (v *decoder_) decodeConstraint(
+	encoded any,
+) ast.ConstraintLike {
+	var node = v.getNode(encoded, "Constraint")
+	var name = v.getString(node, "name")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	return ast.ConstraintClass().Constraint(
+		name,
+		abstraction,
+	)
+}
+
+func (v *decoder_) decodeConstraints(
+	encoded any,
+) ast.ConstraintsLike {
+	var node = v.getNode(encoded, "Constraints")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var constraint = v.decodeConstraint(v.getValue(node, "constraint"))
+	var additionalConstraints = com.List[ast.AdditionalConstraintLike]()
+	for _, element := range v.getArray(node, "additionalConstraints") {
+		additionalConstraints.AppendValue(v.decodeAdditionalConstraint(element))
+	}
+	var delimiter2 = v.getString(node, "delimiter2")
+	return ast.ConstraintsClass().Constraints(
+		delimiter1,
+		constraint,
+		additionalConstraints,
+		delimiter2,
+	)
+}
+
+func (v *decoder_) decodeConstructorMethod(
+	encoded any,
+) ast.ConstructorMethodLike {
+	var node = v.getNode(encoded, "ConstructorMethod")
+	var optionalNote = v.getOptionalString(node, "optionalNote")
+	var name = v.getString(node, "name")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var optionalParameterList ast.ParameterListLike
+	if uti.IsDefined(node["optionalParameterList"]) {
+		optionalParameterList = v.decodeParameterList(node["optionalParameterList"])
+	}
+	var delimiter2 = v.getString(node, "delimiter2")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	return ast.ConstructorMethodClass().ConstructorMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		optionalParameterList,
+		delimiter2,
+		abstraction,
+	)
+}
+
+func (v *decoder_) decodeConstructorSubsection(
+	encoded any,
+) ast.ConstructorSubsectionLike {
+	var node = v.getNode(encoded, "ConstructorSubsection")
+	var delimiter = v.getString(node, "delimiter")
+	var constructorMethods = com.List[ast.ConstructorMethodLike]()
+	for _, element := range v.getArray(node, "constructorMethods") {
+		constructorMethods.AppendValue(v.decodeConstructorMethod(element))
+	}
+	return ast.ConstructorSubsectionClass().ConstructorSubsection(
+		delimiter,
+		constructorMethods,
+	)
+}
+
+func (v *decoder_) decodeDeclaration(
+	encoded any,
+) ast.DeclarationLike {
+	var node = v.getNode(encoded, "Declaration")
+	var comment = v.getString(node, "comment")
+	var delimiter = v.getString(node, "delimiter")
+	var name = v.getString(node, "name")
+	var optionalConstraints ast.ConstraintsLike
+	if uti.IsDefined(node["optionalConstraints"]) {
+		optionalConstraints = v.decodeConstraints(node["optionalConstraints"])
+	}
+	return ast.DeclarationClass().Declaration(
+		comment,
+		delimiter,
+		name,
+		optionalConstraints,
+	)
+}
+
+func (v *decoder_) decodeDots(
+	encoded any,
+) ast.DotsLike {
+	var node = v.getNode(encoded, "Dots")
+	var delimiter = v.getString(node, "delimiter")
+	return ast.DotsClass().Dots(delimiter)
+}
+
+func (v *decoder_) decodeEnumeration(
+	encoded any,
+) ast.EnumerationLike {
+	var node = v.getNode(encoded, "Enumeration")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var value = v.decodeValue(v.getValue(node, "value"))
+	var additionalValues = com.List[ast.AdditionalValueLike]()
+	for _, element := range v.getArray(node, "additionalValues") {
+		additionalValues.AppendValue(v.decodeAdditionalValue(element))
+	}
+	var delimiter3 = v.getString(node, "delimiter3")
+	return ast.EnumerationClass().Enumeration(
+		delimiter1,
+		delimiter2,
+		value,
+		additionalValues,
+		delimiter3,
+	)
+}
+
+func (v *decoder_) decodeExpression(
+	encoded any,
+) ast.ExpressionLike {
+	var node = v.getNode(encoded, "Expression")
+	var operand = v.decodeOperand(v.getValue(node, "operand"))
+	var optionalOperation ast.OperationLike
+	if uti.IsDefined(node["optionalOperation"]) {
+		optionalOperation = v.decodeOperation(node["optionalOperation"])
+	}
+	return ast.ExpressionClass().Expression(
+		operand,
+		optionalOperation,
+	)
+}
+
+func (v *decoder_) decodeFunctionMethod(
+	encoded any,
+) ast.FunctionMethodLike {
+	var node = v.getNode(encoded, "FunctionMethod")
+	var optionalNote = v.getOptionalString(node, "optionalNote")
+	var name = v.getString(node, "name")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var optionalParameterList ast.ParameterListLike
+	if uti.IsDefined(node["optionalParameterList"]) {
+		optionalParameterList = v.decodeParameterList(node["optionalParameterList"])
+	}
+	var delimiter2 = v.getString(node, "delimiter2")
+	var result = v.decodeResult(v.getValue(node, "result"))
+	return ast.FunctionMethodClass().FunctionMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		optionalParameterList,
+		delimiter2,
+		result,
+	)
+}
+
+func (v *decoder_) decodeFunctionSubsection(
+	encoded any,
+) ast.FunctionSubsectionLike {
+	var node = v.getNode(encoded, "FunctionSubsection")
+	var delimiter = v.getString(node, "delimiter")
+	var functionMethods = com.List[ast.FunctionMethodLike]()
+	for _, element := range v.getArray(node, "functionMethods") {
+		functionMethods.AppendValue(v.decodeFunctionMethod(element))
+	}
+	return ast.FunctionSubsectionClass().FunctionSubsection(
+		delimiter,
+		functionMethods,
+	)
+}
+
+func (v *decoder_) decodeFunctional(
+	encoded any,
+) ast.FunctionalLike {
+	var node = v.getNode(encoded, "Functional")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var optionalParameterList ast.ParameterListLike
+	if uti.IsDefined(node["optionalParameterList"]) {
+		optionalParameterList = v.decodeParameterList(node["optionalParameterList"])
+	}
+	var delimiter3 = v.getString(node, "delimiter3")
+	var optionalResult ast.ResultLike
+	if uti.IsDefined(node["optionalResult"]) {
+		optionalResult = v.decodeResult(node["optionalResult"])
+	}
+	return ast.FunctionalClass().Functional(
+		delimiter1,
+		delimiter2,
+		optionalParameterList,
+		delimiter3,
+		optionalResult,
+	)
+}
+
+func (v *decoder_) decodeFunctionalDeclaration(
+	encoded any,
+) ast.FunctionalDeclarationLike {
+	var node = v.getNode(encoded, "FunctionalDeclaration")
+	var declaration = v.decodeDeclaration(v.getValue(node, "declaration"))
+	var functional = v.decodeFunctional(v.getValue(node, "functional"))
+	return ast.FunctionalDeclarationClass().FunctionalDeclaration(
+		declaration,
+		functional,
+	)
+}
+
+func (v *decoder_) decodeFunctionalSection(
+	encoded any,
+) ast.FunctionalSectionLike {
+	var node = v.getNode(encoded, "FunctionalSection")
+	var delimiter = v.getString(node, "delimiter")
+	var functionalDeclarations = com.List[ast.FunctionalDeclarationLike]()
+	for _, element := range v.getArray(node, "functionalDeclarations") {
+		functionalDeclarations.AppendValue(v.decodeFunctionalDeclaration(element))
+	}
+	return ast.FunctionalSectionClass().FunctionalSection(
+		delimiter,
+		functionalDeclarations,
+	)
+}
+
+func (v *decoder_) decodeGetterMethod(
+	encoded any,
+) ast.GetterMethodLike {
+	var node = v.getNode(encoded, "GetterMethod")
+	var optionalNote = v.getOptionalString(node, "optionalNote")
+	var name = v.getString(node, "name")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	return ast.GetterMethodClass().GetterMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		delimiter2,
+		abstraction,
+	)
+}
+
+func (v *decoder_) decodeImportList(
+	encoded any,
+) ast.ImportListLike {
+	var node = v.getNode(encoded, "ImportList")
+	var importedPackages = com.List[ast.ImportedPackageLike]()
+	for _, element := range v.getArray(node, "importedPackages") {
+		importedPackages.AppendValue(v.decodeImportedPackage(element))
+	}
+	return ast.ImportListClass().ImportList(importedPackages)
+}
+
+func (v *decoder_) decodeImportedPackage(
+	encoded any,
+) ast.ImportedPackageLike {
+	var node = v.getNode(encoded, "ImportedPackage")
+	var name = v.getString(node, "name")
+	var string_ = v.getString(node, "string")
+	return ast.ImportedPackageClass().ImportedPackage(
+		name,
+		string_,
+	)
+}
+
+func (v *decoder_) decodeInstanceDeclaration(
+	encoded any,
+) ast.InstanceDeclarationLike {
+	var node = v.getNode(encoded, "InstanceDeclaration")
+	var declaration = v.decodeDeclaration(v.getValue(node, "declaration"))
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var instanceMethods = v.decodeInstanceMethods(v.getValue(node, "instanceMethods"))
+	var delimiter3 = v.getString(node, "delimiter3")
+	return ast.InstanceDeclarationClass().InstanceDeclaration(
+		declaration,
+		delimiter1,
+		delimiter2,
+		instanceMethods,
+		delimiter3,
+	)
+}
+
+func (v *decoder_) decodeInstanceMethods(
+	encoded any,
+) ast.InstanceMethodsLike {
+	var node = v.getNode(encoded, "InstanceMethods")
+	var principalSubsection = v.decodePrincipalSubsection(v.getValue(node, "principalSubsection"))
+	var optionalAttributeSubsection ast.AttributeSubsectionLike
+	if uti.IsDefined(node["optionalAttributeSubsection"]) {
+		optionalAttributeSubsection = v.decodeAttributeSubsection(node["optionalAttributeSubsection"])
+	}
+	var optionalAspectSubsection ast.AspectSubsectionLike
+	if uti.IsDefined(node["optionalAspectSubsection"]) {
+		optionalAspectSubsection = v.decodeAspectSubsection(node["optionalAspectSubsection"])
+	}
+	return ast.InstanceMethodsClass().InstanceMethods(
+		principalSubsection,
+		optionalAttributeSubsection,
+		optionalAspectSubsection,
+	)
+}
+
+func (v *decoder_) decodeInstanceSection(
+	encoded any,
+) ast.InstanceSectionLike {
+	var node = v.getNode(encoded, "InstanceSection")
+	var delimiter = v.getString(node, "delimiter")
+	var instanceDeclarations = com.List[ast.InstanceDeclarationLike]()
+	for _, element := range v.getArray(node, "instanceDeclarations") {
+		instanceDeclarations.AppendValue(v.decodeInstanceDeclaration(element))
+	}
+	return ast.InstanceSectionClass().InstanceSection(
+		delimiter,
+		instanceDeclarations,
+	)
+}
+
+func (v *decoder_) decodeInterfaceDeclarations(
+	encoded any,
+) ast.InterfaceDeclarationsLike {
+	var node = v.getNode(encoded, "InterfaceDeclarations")
+	var classSection = v.decodeClassSection(v.getValue(node, "classSection"))
+	var instanceSection = v.decodeInstanceSection(v.getValue(node, "instanceSection"))
+	var aspectSection = v.decodeAspectSection(v.getValue(node, "aspectSection"))
+	return ast.InterfaceDeclarationsClass().InterfaceDeclarations(
+		classSection,
+		instanceSection,
+		aspectSection,
+	)
+}
+
+func (v *decoder_) decodeLegalNotice(
+	encoded any,
+) ast.LegalNoticeLike {
+	var node = v.getNode(encoded, "LegalNotice")
+	var comment = v.getString(node, "comment")
+	return ast.LegalNoticeClass().LegalNotice(comment)
+}
+
+func (v *decoder_) decodeMap(
+	encoded any,
+) ast.MapLike {
+	var node = v.getNode(encoded, "Map")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var name = v.getString(node, "name")
+	var delimiter3 = v.getString(node, "delimiter3")
+	return ast.MapClass().Map(
+		delimiter1,
+		delimiter2,
+		name,
+		delimiter3,
+	)
+}
+
+func (v *decoder_) decodeMethod(
+	encoded any,
+) ast.MethodLike {
+	var node = v.getNode(encoded, "Method")
+	var optionalNote = v.getOptionalString(node, "optionalNote")
+	var name = v.getString(node, "name")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var optionalParameterList ast.ParameterListLike
+	if uti.IsDefined(node["optionalParameterList"]) {
+		optionalParameterList = v.decodeParameterList(node["optionalParameterList"])
+	}
+	var delimiter2 = v.getString(node, "delimiter2")
+	var result = v.decodeResult(v.getValue(node, "result"))
+	return ast.MethodClass().Method(
+		optionalNote,
+		name,
+		delimiter1,
+		optionalParameterList,
+		delimiter2,
+		result,
+	)
+}
+
+func (v *decoder_) decodeModel(
+	encoded any,
+) ast.ModelLike {
+	var node = v.getNode(encoded, "Model")
+	var packageDeclaration = v.decodePackageDeclaration(v.getValue(node, "packageDeclaration"))
+	var primitiveDeclarations = v.decodePrimitiveDeclarations(v.getValue(node, "primitiveDeclarations"))
+	var interfaceDeclarations = v.decodeInterfaceDeclarations(v.getValue(node, "interfaceDeclarations"))
+	return ast.ModelClass().Model(
+		packageDeclaration,
+		primitiveDeclarations,
+		interfaceDeclarations,
+	)
+}
+
+func (v *decoder_) decodeMultivalue(
+	encoded any,
+) ast.MultivalueLike {
+	var node = v.getNode(encoded, "Multivalue")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var parameterList = v.decodeParameterList(v.getValue(node, "parameterList"))
+	var delimiter2 = v.getString(node, "delimiter2")
+	return ast.MultivalueClass().Multivalue(
+		delimiter1,
+		parameterList,
+		delimiter2,
+	)
+}
+
+func (v *decoder_) decodeNamed(
+	encoded any,
+) ast.NamedLike {
+	var node = v.getNode(encoded, "Named")
+	var optionalPrefix = v.getOptionalString(node, "optionalPrefix")
+	var name = v.getString(node, "name")
+	var optionalArguments ast.ArgumentsLike
+	if uti.IsDefined(node["optionalArguments"]) {
+		optionalArguments = v.decodeArguments(node["optionalArguments"])
+	}
+	return ast.NamedClass().Named(
+		optionalPrefix,
+		name,
+		optionalArguments,
+	)
+}
+
+func (v *decoder_) decodeNone(
+	encoded any,
+) ast.NoneLike {
+	var node = v.getNode(encoded, "None")
+	var newline = v.getString(node, "newline")
+	return ast.NoneClass().None(newline)
+}
+
+func (v *decoder_) decodeOperand(
+	encoded any,
+) ast.OperandLike {
+	var node = v.getNode(encoded, "Operand")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case string:
+		any_ = actual
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected operand value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.OperandClass().Operand(any_)
+}
+
+func (v *decoder_) decodeOperation(
+	encoded any,
+) ast.OperationLike {
+	var node = v.getNode(encoded, "Operation")
+	var operator = v.getString(node, "operator")
+	var operand = v.decodeOperand(v.getValue(node, "operand"))
+	return ast.OperationClass().Operation(
+		operator,
+		operand,
+	)
+}
+
+func (v *decoder_) decodePackageDeclaration(
+	encoded any,
+) ast.PackageDeclarationLike {
+	var node = v.getNode(encoded, "PackageDeclaration")
+	var legalNotice = v.decodeLegalNotice(v.getValue(node, "legalNotice"))
+	var packageHeader = v.decodePackageHeader(v.getValue(node, "packageHeader"))
+	var packageImports = v.decodePackageImports(v.getValue(node, "packageImports"))
+	return ast.PackageDeclarationClass().PackageDeclaration(
+		legalNotice,
+		packageHeader,
+		packageImports,
+	)
+}
+
+func (v *decoder_) decodePackageHeader(
+	encoded any,
+) ast.PackageHeaderLike {
+	var node = v.getNode(encoded, "PackageHeader")
+	var comment = v.getString(node, "comment")
+	var delimiter = v.getString(node, "delimiter")
+	var name = v.getString(node, "name")
+	return ast.PackageHeaderClass().PackageHeader(
+		comment,
+		delimiter,
+		name,
+	)
+}
+
+func (v *decoder_) decodePackageImports(
+	encoded any,
+) ast.PackageImportsLike {
+	var node = v.getNode(encoded, "PackageImports")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var delimiter2 = v.getString(node, "delimiter2")
+	var optionalImportList ast.ImportListLike
+	if uti.IsDefined(node["optionalImportList"]) {
+		optionalImportList = v.decodeImportList(node["optionalImportList"])
+	}
+	var delimiter3 = v.getString(node, "delimiter3")
+	return ast.PackageImportsClass().PackageImports(
+		delimiter1,
+		delimiter2,
+		optionalImportList,
+		delimiter3,
+	)
+}
+
+func (v *decoder_) decodeParameter(
+	encoded any,
+) ast.ParameterLike {
+	var node = v.getNode(encoded, "Parameter")
+	var name = v.getString(node, "name")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	var delimiter = v.getString(node, "delimiter")
+	return ast.ParameterClass().Parameter(
+		name,
+		abstraction,
+		delimiter,
+	)
+}
+
+func (v *decoder_) decodeParameterList(
+	encoded any,
+) ast.ParameterListLike {
+	var node = v.getNode(encoded, "ParameterList")
+	var parameters = com.List[ast.ParameterLike]()
+	for _, element := range v.getArray(node, "parameters") {
+		parameters.AppendValue(v.decodeParameter(element))
+	}
+	return ast.ParameterListClass().ParameterList(parameters)
+}
+
+func (v *decoder_) decodePrimitiveDeclarations(
+	encoded any,
+) ast.PrimitiveDeclarationsLike {
+	var node = v.getNode(encoded, "PrimitiveDeclarations")
+	var typeSection = v.decodeTypeSection(v.getValue(node, "typeSection"))
+	var functionalSection = v.decodeFunctionalSection(v.getValue(node, "functionalSection"))
+	return ast.PrimitiveDeclarationsClass().PrimitiveDeclarations(
+		typeSection,
+		functionalSection,
+	)
+}
+
+func (v *decoder_) decodePrincipalMethod(
+	encoded any,
+) ast.PrincipalMethodLike {
+	var node = v.getNode(encoded, "PrincipalMethod")
+	var method = v.decodeMethod(v.getValue(node, "method"))
+	return ast.PrincipalMethodClass().PrincipalMethod(method)
+}
+
+func (v *decoder_) decodePrincipalSubsection(
+	encoded any,
+) ast.PrincipalSubsectionLike {
+	var node = v.getNode(encoded, "PrincipalSubsection")
+	var delimiter = v.getString(node, "delimiter")
+	var principalMethods = com.List[ast.PrincipalMethodLike]()
+	for _, element := range v.getArray(node, "principalMethods") {
+		principalMethods.AppendValue(v.decodePrincipalMethod(element))
+	}
+	return ast.PrincipalSubsectionClass().PrincipalSubsection(
+		delimiter,
+		principalMethods,
+	)
+}
+
+func (v *decoder_) decodeResult(
+	encoded any,
+) ast.ResultLike {
+	var node = v.getNode(encoded, "Result")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case map[string]any:
+		switch actual["kind"] {
+		case "None":
+			any_ = v.decodeNone(actual)
+		case "Abstraction":
+			any_ = v.decodeAbstraction(actual)
+		case "Multivalue":
+			any_ = v.decodeMultivalue(actual)
+		default:
+			var message = fmt.Sprintf(
+				"An unexpected result kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected result value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.ResultClass().Result(any_)
+}
+
+func (v *decoder_) decodeSetterMethod(
+	encoded any,
+) ast.SetterMethodLike {
+	var node = v.getNode(encoded, "SetterMethod")
+	var optionalNote = v.getOptionalString(node, "optionalNote")
+	var name = v.getString(node, "name")
+	var delimiter1 = v.getString(node, "delimiter1")
+	var parameter = v.decodeParameter(v.getValue(node, "parameter"))
+	var delimiter2 = v.getString(node, "delimiter2")
+	return ast.SetterMethodClass().SetterMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		parameter,
+		delimiter2,
+	)
+}
+
+func (v *decoder_) decodeSpecification(
+	encoded any,
+) ast.SpecificationLike {
+	var node = v.getNode(encoded, "Specification")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case map[string]any:
+		switch actual["kind"] {
+		case "None":
+			any_ = v.decodeNone(actual)
+		case "Assignment":
+			any_ = v.decodeAssignment(actual)
+		default:
+			var message = fmt.Sprintf(
+				"An unexpected specification kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected specification value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.SpecificationClass().Specification(any_)
+}
+
+func (v *decoder_) decodeStar(
+	encoded any,
+) ast.StarLike {
+	var node = v.getNode(encoded, "Star")
+	var delimiter = v.getString(node, "delimiter")
+	return ast.StarClass().Star(delimiter)
+}
+
+func (v *decoder_) decodeType(
+	encoded any,
+) ast.TypeLike {
+	var node = v.getNode(encoded, "Type")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case map[string]any:
+		switch actual["kind"] {
+		case "Named":
+			any_ = v.decodeNamed(actual)
+		case "Functional":
+			any_ = v.decodeFunctional(actual)
+		default:
+			var message = fmt.Sprintf(
+				"An unexpected type kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected type value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.TypeClass().Type(any_)
+}
+
+func (v *decoder_) decodeTypeDeclaration(
+	encoded any,
+) ast.TypeDeclarationLike {
+	var node = v.getNode(encoded, "TypeDeclaration")
+	var declaration = v.decodeDeclaration(v.getValue(node, "declaration"))
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	var optionalEnumeration ast.EnumerationLike
+	if uti.IsDefined(node["optionalEnumeration"]) {
+		optionalEnumeration = v.decodeEnumeration(node["optionalEnumeration"])
+	}
+	return ast.TypeDeclarationClass().TypeDeclaration(
+		declaration,
+		abstraction,
+		optionalEnumeration,
+	)
+}
+
+func (v *decoder_) decodeTypeSection(
+	encoded any,
+) ast.TypeSectionLike {
+	var node = v.getNode(encoded, "TypeSection")
+	var delimiter = v.getString(node, "delimiter")
+	var typeDeclarations = com.List[ast.TypeDeclarationLike]()
+	for _, element := range v.getArray(node, "typeDeclarations") {
+		typeDeclarations.AppendValue(v.decodeTypeDeclaration(element))
+	}
+	return ast.TypeSectionClass().TypeSection(
+		delimiter,
+		typeDeclarations,
+	)
+}
+
+func (v *decoder_) decodeValue(
+	encoded any,
+) ast.ValueLike {
+	var node = v.getNode(encoded, "Value")
+	var name = v.getString(node, "name")
+	var abstraction = v.decodeAbstraction(v.getValue(node, "abstraction"))
+	var delimiter = v.getString(node, "delimiter")
+	var expression = v.decodeExpression(v.getValue(node, "expression"))
+	return ast.ValueClass().Value(
+		name,
+		abstraction,
+		delimiter,
+		expression,
+	)
+}
+
+func (v *decoder_) decodeWrapper(
+	encoded any,
+) ast.WrapperLike {
+	var node = v.getNode(encoded, "Wrapper")
+	var any_ any
+	switch actual := v.getValue(node, "any").(type) {
+	case map[string]any:
+		switch actual["kind"] {
+		case "Dots":
+			any_ = v.decodeDots(actual)
+		case "Star":
+			any_ = v.decodeStar(actual)
+		case "Array":
+			any_ = v.decodeArray(actual)
+		case "Channel":
+			any_ = v.decodeChannel(actual)
+		case "Map":
+			any_ = v.decodeMap(actual)
+		default:
+			var message = fmt.Sprintf(
+				"An unexpected wrapper kind was found: %v",
+				actual["kind"],
+			)
+			panic(message)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"An unexpected wrapper value was found: %v",
+			actual,
+		)
+		panic(message)
+	}
+	return ast.WrapperClass().Wrapper(any_)
+}
+
+func (v *decoder_) getArray(
+	node map[string]any,
+	name string,
+) []any {
+	var array, ok = v.getValue(node, name).([]any)
+	if !ok {
+		var message = fmt.Sprintf(
+			"The %q attribute of a %v node must be an array.",
+			name,
+			node["kind"],
+		)
+		panic(message)
+	}
+	return array
+}
+
+func (v *decoder_) getNode(
+	value any,
+	kind string,
+) map[string]any {
+	var node, ok = value.(map[string]any)
+	if !ok || node["kind"] != kind {
+		var message = fmt.Sprintf(
+			"Expected a %s node but found: %v",
+			kind,
+			value,
+		)
+		panic(message)
+	}
+	return node
+}
+
+func (v *decoder_) getOptionalString(
+	node map[string]any,
+	name string,
+) string {
+	if uti.IsUndefined(node[name]) {
+		return ""
+	}
+	return v.getString(node, name)
+}
+
+func (v *decoder_) getString(
+	node map[string]any,
+	name string,
+) string {
+	var value, ok = v.getValue(node, name).(string)
+	if !ok {
+		var message = fmt.Sprintf(
+			"The %q attribute of a %v node must be a string.",
+			name,
+			node["kind"],
+		)
+		panic(message)
+	}
+	return value
+}
+
+func (v *decoder_) getValue(
+	node map[string]any,
+	name string,
+) any {
+	var value, ok = node[name]
+	if !ok {
+		var message = fmt.Sprintf(
+			"The %q attribute is required by a %v node.",
+			name,
+			node["kind"],
+		)
+		panic(message)
+	}
+	return value
+}
+
+// Instance Structure
+
+type decoder_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type decoderClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func decoderClass() *decoderClass_ {
+	return decoderClassReference_
+}
+
+var decoderClassReference_ = &decoderClass_{
+	// Initialize the class constants.
+}
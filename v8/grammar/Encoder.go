@@ -0,0 +1,1093 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func EncoderClass() EncoderClassLike {
+	return encoderClass()
+}
+
+// Constructor Methods
+
+func (c *encoderClass_) Encoder() EncoderLike {
+	var instance = &encoder_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *encoder_) GetClass() EncoderClassLike {
+	return encoderClass()
+}
+
+func (v *encoder_) EncodeModel(
+	model ast.ModelLike,
+) string {
+	var node = v.encodeModel(model)
+	var bytes, err = jsn.MarshalIndent(node, "", "  ")
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The model could not be encoded as JSON: %v",
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes) + "\n"
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *encoder_) encodeAbstraction(
+	abstraction ast.AbstractionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Abstraction",
+	}
+	var optionalWrapper = abstraction.GetOptionalWrapper()
+	if uti.IsDefined(optionalWrapper) {
+		node["optionalWrapper"] = v.encodeWrapper(optionalWrapper)
+	}
+	node["type"] = v.encodeType(abstraction.GetType())
+	return node
+}
+
+func (v *encoder_) encodeAdditionalArgument(
+	additionalArgument ast.AdditionalArgumentLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AdditionalArgument",
+	}
+	node["delimiter"] = additionalArgument.GetDelimiter()
+	node["argument"] = v.encodeArgument(additionalArgument.GetArgument())
+	return node
+}
+
+func (v *encoder_) encodeAdditionalConstraint(
+	additionalConstraint ast.AdditionalConstraintLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AdditionalConstraint",
+	}
+	node["delimiter"] = additionalConstraint.GetDelimiter()
+	node["constraint"] = v.encodeConstraint(additionalConstraint.GetConstraint())
+	return node
+}
+
+func (v *encoder_) encodeAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AdditionalValue",
+	}
+	node["name"] = additionalValue.GetName()
+	node["specification"] = v.encodeSpecification(additionalValue.GetSpecification())
+	return node
+}
+
+func (v *encoder_) encodeArgument(
+	argument ast.ArgumentLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Argument",
+	}
+	node["abstraction"] = v.encodeAbstraction(argument.GetAbstraction())
+	return node
+}
+
+func (v *encoder_) encodeArguments(
+	arguments ast.ArgumentsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Arguments",
+	}
+	node["delimiter1"] = arguments.GetDelimiter1()
+	node["argument"] = v.encodeArgument(arguments.GetArgument())
+	var additionalArguments = []any{}
+	var additionalArgumentsIterator = arguments.GetAdditionalArguments().GetIterator()
+	for additionalArgumentsIterator.HasNext() {
+		var rule = additionalArgumentsIterator.GetNext()
+		additionalArguments = append(additionalArguments, v.encodeAdditionalArgument(rule))
+	}
+	node["additionalArguments"] = additionalArguments
+	node["delimiter2"] = arguments.GetDelimiter2()
+	return node
+}
+
+func (v *encoder_) encodeArray(
+	array ast.ArrayLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Array",
+	}
+	node["delimiter1"] = array.GetDelimiter1()
+	node["delimiter2"] = array.GetDelimiter2()
+	return node
+}
+
+func (v *encoder_) encodeAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AspectDeclaration",
+	}
+	node["declaration"] = v.encodeDeclaration(aspectDeclaration.GetDeclaration())
+	node["delimiter1"] = aspectDeclaration.GetDelimiter1()
+	node["delimiter2"] = aspectDeclaration.GetDelimiter2()
+	var aspectMembers = []any{}
+	var aspectMembersIterator = aspectDeclaration.GetAspectMembers().GetIterator()
+	for aspectMembersIterator.HasNext() {
+		var rule = aspectMembersIterator.GetNext()
+		aspectMembers = append(aspectMembers, v.encodeAspectMember(rule))
+	}
+	node["aspectMembers"] = aspectMembers
+	node["delimiter3"] = aspectDeclaration.GetDelimiter3()
+	return node
+}
+
+func (v *encoder_) encodeAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AspectInterface",
+	}
+	node["abstraction"] = v.encodeAbstraction(aspectInterface.GetAbstraction())
+	return node
+}
+
+func (v *encoder_) encodeAspectMember(
+	aspectMember ast.AspectMemberLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AspectMember",
+	}
+	switch actual := aspectMember.GetAny().(type) {
+	case ast.AspectMethodLike:
+		node["any"] = v.encodeAspectMethod(actual)
+	case ast.AspectInterfaceLike:
+		node["any"] = v.encodeAspectInterface(actual)
+	}
+	return node
+}
+
+func (v *encoder_) encodeAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AspectMethod",
+	}
+	node["method"] = v.encodeMethod(aspectMethod.GetMethod())
+	return node
+}
+
+func (v *encoder_) encodeAspectSection(
+	aspectSection ast.AspectSectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AspectSection",
+	}
+	node["delimiter"] = aspectSection.GetDelimiter()
+	var aspectDeclarations = []any{}
+	var aspectDeclarationsIterator = aspectSection.GetAspectDeclarations().GetIterator()
+	for aspectDeclarationsIterator.HasNext() {
+		var rule = aspectDeclarationsIterator.GetNext()
+		aspectDeclarations = append(aspectDeclarations, v.encodeAspectDeclaration(rule))
+	}
+	node["aspectDeclarations"] = aspectDeclarations
+	return node
+}
+
+func (v *encoder_) encodeAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AspectSubsection",
+	}
+	node["delimiter"] = aspectSubsection.GetDelimiter()
+	var aspectInterfaces = []any{}
+	var aspectInterfacesIterator = aspectSubsection.GetAspectInterfaces().GetIterator()
+	for aspectInterfacesIterator.HasNext() {
+		var rule = aspectInterfacesIterator.GetNext()
+		aspectInterfaces = append(aspectInterfaces, v.encodeAspectInterface(rule))
+	}
+	node["aspectInterfaces"] = aspectInterfaces
+	return node
+}
+
+func (v *encoder_) encodeAssignment(
+	assignment ast.AssignmentLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Assignment",
+	}
+	node["abstraction"] = v.encodeAbstraction(assignment.GetAbstraction())
+	node["delimiter"] = assignment.GetDelimiter()
+	node["expression"] = v.encodeExpression(assignment.GetExpression())
+	return node
+}
+
+func (v *encoder_) encodeAttributeMethod(
+	attributeMethod ast.AttributeMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AttributeMethod",
+	}
+	switch actual := attributeMethod.GetAny().(type) {
+	case ast.GetterMethodLike:
+		node["any"] = v.encodeGetterMethod(actual)
+	case ast.SetterMethodLike:
+		node["any"] = v.encodeSetterMethod(actual)
+	}
+	return node
+}
+
+func (v *encoder_) encodeAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "AttributeSubsection",
+	}
+	node["delimiter"] = attributeSubsection.GetDelimiter()
+	var attributeMethods = []any{}
+	var attributeMethodsIterator = attributeSubsection.GetAttributeMethods().GetIterator()
+	for attributeMethodsIterator.HasNext() {
+		var rule = attributeMethodsIterator.GetNext()
+		attributeMethods = append(attributeMethods, v.encodeAttributeMethod(rule))
+	}
+	node["attributeMethods"] = attributeMethods
+	return node
+}
+
+func (v *encoder_) encodeChannel(
+	channel ast.ChannelLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Channel",
+	}
+	node["delimiter"] = channel.GetDelimiter()
+	return node
+}
+
+func (v *encoder_) encodeClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ClassDeclaration",
+	}
+	node["declaration"] = v.encodeDeclaration(classDeclaration.GetDeclaration())
+	node["delimiter1"] = classDeclaration.GetDelimiter1()
+	node["delimiter2"] = classDeclaration.GetDelimiter2()
+	node["classMethods"] = v.encodeClassMethods(classDeclaration.GetClassMethods())
+	node["delimiter3"] = classDeclaration.GetDelimiter3()
+	return node
+}
+
+func (v *encoder_) encodeClassMethods(
+	classMethods ast.ClassMethodsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ClassMethods",
+	}
+	node["constructorSubsection"] = v.encodeConstructorSubsection(classMethods.GetConstructorSubsection())
+	var optionalConstantSubsection = classMethods.GetOptionalConstantSubsection()
+	if uti.IsDefined(optionalConstantSubsection) {
+		node["optionalConstantSubsection"] = v.encodeConstantSubsection(optionalConstantSubsection)
+	}
+	var optionalFunctionSubsection = classMethods.GetOptionalFunctionSubsection()
+	if uti.IsDefined(optionalFunctionSubsection) {
+		node["optionalFunctionSubsection"] = v.encodeFunctionSubsection(optionalFunctionSubsection)
+	}
+	return node
+}
+
+func (v *encoder_) encodeClassSection(
+	classSection ast.ClassSectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ClassSection",
+	}
+	node["delimiter"] = classSection.GetDelimiter()
+	var classDeclarations = []any{}
+	var classDeclarationsIterator = classSection.GetClassDeclarations().GetIterator()
+	for classDeclarationsIterator.HasNext() {
+		var rule = classDeclarationsIterator.GetNext()
+		classDeclarations = append(classDeclarations, v.encodeClassDeclaration(rule))
+	}
+	node["classDeclarations"] = classDeclarations
+	return node
+}
+
+func (v *encoder_) encodeConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ConstantMethod",
+	}
+	var optionalNote = constantMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		node["optionalNote"] = optionalNote
+	}
+	node["name"] = constantMethod.GetName()
+	node["delimiter1"] = constantMethod.GetDelimiter1()
+	node["delimiter2"] = constantMethod.GetDelimiter2()
+	node["abstraction"] = v.encodeAbstraction(constantMethod.GetAbstraction())
+	return node
+}
+
+func (v *encoder_) encodeConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ConstantSubsection",
+	}
+	node["delimiter"] = constantSubsection.GetDelimiter()
+	var constantMethods = []any{}
+	var constantMethodsIterator = constantSubsection.GetConstantMethods().GetIterator()
+	for constantMethodsIterator.HasNext() {
+		var rule = constantMethodsIterator.GetNext()
+		constantMethods = append(constantMethods, v.encodeConstantMethod(rule))
+	}
+	node["constantMethods"] = constantMethods
+	return node
+}
+
+func (v *encoder_) encodeConstraint(
+	constraint ast.ConstraintLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Constraint",
+	}
+	node["name"] = constraint.GetName()
+	node["abstraction"] = v.encodeAbstraction(constraint.GetAbstraction())
+	return node
+}
+
+func (v *encoder_) encodeConstraints(
+	constraints ast.ConstraintsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Constraints",
+	}
+	node["delimiter1"] = constraints.GetDelimiter1()
+	node["constraint"] = v.encodeConstraint(constraints.GetConstraint())
+	var additionalConstraints = []any{}
+	var additionalConstraintsIterator = constraints.GetAdditionalConstraints().GetIterator()
+	for additionalConstraintsIterator.HasNext() {
+		var rule = additionalConstraintsIterator.GetNext()
+		additionalConstraints = append(additionalConstraints, v.encodeAdditionalConstraint(rule))
+	}
+	node["additionalConstraints"] = additionalConstraints
+	node["delimiter2"] = constraints.GetDelimiter2()
+	return node
+}
+
+func (v *encoder_) encodeConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ConstructorMethod",
+	}
+	var optionalNote = constructorMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		node["optionalNote"] = optionalNote
+	}
+	node["name"] = constructorMethod.GetName()
+	node["delimiter1"] = constructorMethod.GetDelimiter1()
+	var optionalParameterList = constructorMethod.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		node["optionalParameterList"] = v.encodeParameterList(optionalParameterList)
+	}
+	node["delimiter2"] = constructorMethod.GetDelimiter2()
+	node["abstraction"] = v.encodeAbstraction(constructorMethod.GetAbstraction())
+	return node
+}
+
+func (v *encoder_) encodeConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ConstructorSubsection",
+	}
+	node["delimiter"] = constructorSubsection.GetDelimiter()
+	var constructorMethods = []any{}
+	var constructorMethodsIterator = constructorSubsection.GetConstructorMethods().GetIterator()
+	for constructorMethodsIterator.HasNext() {
+		var rule = constructorMethodsIterator.GetNext()
+		constructorMethods = append(constructorMethods, v.encodeConstructorMethod(rule))
+	}
+	node["constructorMethods"] = constructorMethods
+	return node
+}
+
+func (v *encoder_) encodeDeclaration(
+	declaration ast.DeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Declaration",
+	}
+	node["comment"] = declaration.GetComment()
+	node["delimiter"] = declaration.GetDelimiter()
+	node["name"] = declaration.GetName()
+	var optionalConstraints = declaration.GetOptionalConstraints()
+	if uti.IsDefined(optionalConstraints) {
+		node["optionalConstraints"] = v.encodeConstraints(optionalConstraints)
+	}
+	return node
+}
+
+func (v *encoder_) encodeDots(
+	dots ast.DotsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Dots",
+	}
+	node["delimiter"] = dots.GetDelimiter()
+	return node
+}
+
+func (v *encoder_) encodeEnumeration(
+	enumeration ast.EnumerationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Enumeration",
+	}
+	node["delimiter1"] = enumeration.GetDelimiter1()
+	node["delimiter2"] = enumeration.GetDelimiter2()
+	node["value"] = v.encodeValue(enumeration.GetValue())
+	var additionalValues = []any{}
+	var additionalValuesIterator = enumeration.GetAdditionalValues().GetIterator()
+	for additionalValuesIterator.HasNext() {
+		var rule = additionalValuesIterator.GetNext()
+		additionalValues = append(additionalValues, v.encodeAdditionalValue(rule))
+	}
+	node["additionalValues"] = additionalValues
+	node["delimiter3"] = enumeration.GetDelimiter3()
+	return node
+}
+
+func (v *encoder_) encodeExpression(
+	expression ast.ExpressionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Expression",
+	}
+	node["operand"] = v.encodeOperand(expression.GetOperand())
+	var optionalOperation = expression.GetOptionalOperation()
+	if uti.IsDefined(optionalOperation) {
+		node["optionalOperation"] = v.encodeOperation(optionalOperation)
+	}
+	return node
+}
+
+func (v *encoder_) encodeFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "FunctionMethod",
+	}
+	var optionalNote = functionMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		node["optionalNote"] = optionalNote
+	}
+	node["name"] = functionMethod.GetName()
+	node["delimiter1"] = functionMethod.GetDelimiter1()
+	var optionalParameterList = functionMethod.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		node["optionalParameterList"] = v.encodeParameterList(optionalParameterList)
+	}
+	node["delimiter2"] = functionMethod.GetDelimiter2()
+	node["result"] = v.encodeResult(functionMethod.GetResult())
+	return node
+}
+
+func (v *encoder_) encodeFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "FunctionSubsection",
+	}
+	node["delimiter"] = functionSubsection.GetDelimiter()
+	var functionMethods = []any{}
+	var functionMethodsIterator = functionSubsection.GetFunctionMethods().GetIterator()
+	for functionMethodsIterator.HasNext() {
+		var rule = functionMethodsIterator.GetNext()
+		functionMethods = append(functionMethods, v.encodeFunctionMethod(rule))
+	}
+	node["functionMethods"] = functionMethods
+	return node
+}
+
+func (v *encoder_) encodeFunctional(
+	functional ast.FunctionalLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Functional",
+	}
+	node["delimiter1"] = functional.GetDelimiter1()
+	node["delimiter2"] = functional.GetDelimiter2()
+	var optionalParameterList = functional.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		node["optionalParameterList"] = v.encodeParameterList(optionalParameterList)
+	}
+	node["delimiter3"] = functional.GetDelimiter3()
+	var optionalResult = functional.GetOptionalResult()
+	if uti.IsDefined(optionalResult) {
+		node["optionalResult"] = v.encodeResult(optionalResult)
+	}
+	return node
+}
+
+func (v *encoder_) encodeFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "FunctionalDeclaration",
+	}
+	node["declaration"] = v.encodeDeclaration(functionalDeclaration.GetDeclaration())
+	node["functional"] = v.encodeFunctional(functionalDeclaration.GetFunctional())
+	return node
+}
+
+func (v *encoder_) encodeFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "FunctionalSection",
+	}
+	node["delimiter"] = functionalSection.GetDelimiter()
+	var functionalDeclarations = []any{}
+	var functionalDeclarationsIterator = functionalSection.GetFunctionalDeclarations().GetIterator()
+	for functionalDeclarationsIterator.HasNext() {
+		var rule = functionalDeclarationsIterator.GetNext()
+		functionalDeclarations = append(functionalDeclarations, v.encodeFunctionalDeclaration(rule))
+	}
+	node["functionalDeclarations"] = functionalDeclarations
+	return node
+}
+
+func (v *encoder_) encodeGetterMethod(
+	getterMethod ast.GetterMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "GetterMethod",
+	}
+	var optionalNote = getterMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		node["optionalNote"] = optionalNote
+	}
+	node["name"] = getterMethod.GetName()
+	node["delimiter1"] = getterMethod.GetDelimiter1()
+	node["delimiter2"] = getterMethod.GetDelimiter2()
+	node["abstraction"] = v.encodeAbstraction(getterMethod.GetAbstraction())
+	return node
+}
+
+func (v *encoder_) encodeImportList(
+	importList ast.ImportListLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ImportList",
+	}
+	var importedPackages = []any{}
+	var importedPackagesIterator = importList.GetImportedPackages().GetIterator()
+	for importedPackagesIterator.HasNext() {
+		var rule = importedPackagesIterator.GetNext()
+		importedPackages = append(importedPackages, v.encodeImportedPackage(rule))
+	}
+	node["importedPackages"] = importedPackages
+	return node
+}
+
+func (v *encoder_) encodeImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ImportedPackage",
+	}
+	node["name"] = importedPackage.GetName()
+	node["string"] = importedPackage.GetString()
+	return node
+}
+
+func (v *encoder_) encodeInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "InstanceDeclaration",
+	}
+	node["declaration"] = v.encodeDeclaration(instanceDeclaration.GetDeclaration())
+	node["delimiter1"] = instanceDeclaration.GetDelimiter1()
+	node["delimiter2"] = instanceDeclaration.GetDelimiter2()
+	node["instanceMethods"] = v.encodeInstanceMethods(instanceDeclaration.GetInstanceMethods())
+	node["delimiter3"] = instanceDeclaration.GetDelimiter3()
+	return node
+}
+
+func (v *encoder_) encodeInstanceMethods(
+	instanceMethods ast.InstanceMethodsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "InstanceMethods",
+	}
+	node["principalSubsection"] = v.encodePrincipalSubsection(instanceMethods.GetPrincipalSubsection())
+	var optionalAttributeSubsection = instanceMethods.GetOptionalAttributeSubsection()
+	if uti.IsDefined(optionalAttributeSubsection) {
+		node["optionalAttributeSubsection"] = v.encodeAttributeSubsection(optionalAttributeSubsection)
+	}
+	var optionalAspectSubsection = instanceMethods.GetOptionalAspectSubsection()
+	if uti.IsDefined(optionalAspectSubsection) {
+		node["optionalAspectSubsection"] = v.encodeAspectSubsection(optionalAspectSubsection)
+	}
+	return node
+}
+
+func (v *encoder_) encodeInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "InstanceSection",
+	}
+	node["delimiter"] = instanceSection.GetDelimiter()
+	var instanceDeclarations = []any{}
+	var instanceDeclarationsIterator = instanceSection.GetInstanceDeclarations().GetIterator()
+	for instanceDeclarationsIterator.HasNext() {
+		var rule = instanceDeclarationsIterator.GetNext()
+		instanceDeclarations = append(instanceDeclarations, v.encodeInstanceDeclaration(rule))
+	}
+	node["instanceDeclarations"] = instanceDeclarations
+	return node
+}
+
+func (v *encoder_) encodeInterfaceDeclarations(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "InterfaceDeclarations",
+	}
+	node["classSection"] = v.encodeClassSection(interfaceDeclarations.GetClassSection())
+	node["instanceSection"] = v.encodeInstanceSection(interfaceDeclarations.GetInstanceSection())
+	node["aspectSection"] = v.encodeAspectSection(interfaceDeclarations.GetAspectSection())
+	return node
+}
+
+func (v *encoder_) encodeLegalNotice(
+	legalNotice ast.LegalNoticeLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "LegalNotice",
+	}
+	node["comment"] = legalNotice.GetComment()
+	return node
+}
+
+func (v *encoder_) encodeMap(
+	map_ ast.MapLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Map",
+	}
+	node["delimiter1"] = map_.GetDelimiter1()
+	node["delimiter2"] = map_.GetDelimiter2()
+	node["name"] = map_.GetName()
+	node["delimiter3"] = map_.GetDelimiter3()
+	return node
+}
+
+func (v *encoder_) encodeMethod(
+	method ast.MethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Method",
+	}
+	var optionalNote = method.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		node["optionalNote"] = optionalNote
+	}
+	node["name"] = method.GetName()
+	node["delimiter1"] = method.GetDelimiter1()
+	var optionalParameterList = method.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		node["optionalParameterList"] = v.encodeParameterList(optionalParameterList)
+	}
+	node["delimiter2"] = method.GetDelimiter2()
+	node["result"] = v.encodeResult(method.GetResult())
+	return node
+}
+
+func (v *encoder_) encodeModel(
+	model ast.ModelLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Model",
+	}
+	node["packageDeclaration"] = v.encodePackageDeclaration(model.GetPackageDeclaration())
+	node["primitiveDeclarations"] = v.encodePrimitiveDeclarations(model.GetPrimitiveDeclarations())
+	node["interfaceDeclarations"] = v.encodeInterfaceDeclarations(model.GetInterfaceDeclarations())
+	return node
+}
+
+func (v *encoder_) encodeMultivalue(
+	multivalue ast.MultivalueLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Multivalue",
+	}
+	node["delimiter1"] = multivalue.GetDelimiter1()
+	node["parameterList"] = v.encodeParameterList(multivalue.GetParameterList())
+	node["delimiter2"] = multivalue.GetDelimiter2()
+	return node
+}
+
+func (v *encoder_) encodeNamed(
+	named ast.NamedLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Named",
+	}
+	var optionalPrefix = named.GetOptionalPrefix()
+	if uti.IsDefined(optionalPrefix) {
+		node["optionalPrefix"] = optionalPrefix
+	}
+	node["name"] = named.GetName()
+	var optionalArguments = named.GetOptionalArguments()
+	if uti.IsDefined(optionalArguments) {
+		node["optionalArguments"] = v.encodeArguments(optionalArguments)
+	}
+	return node
+}
+
+func (v *encoder_) encodeNone(
+	none ast.NoneLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "None",
+	}
+	node["newline"] = none.GetNewline()
+	return node
+}
+
+func (v *encoder_) encodeOperand(
+	operand ast.OperandLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Operand",
+	}
+	switch actual := operand.GetAny().(type) {
+	case string:
+		node["any"] = actual
+	}
+	return node
+}
+
+func (v *encoder_) encodeOperation(
+	operation ast.OperationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Operation",
+	}
+	node["operator"] = operation.GetOperator()
+	node["operand"] = v.encodeOperand(operation.GetOperand())
+	return node
+}
+
+func (v *encoder_) encodePackageDeclaration(
+	packageDeclaration ast.PackageDeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "PackageDeclaration",
+	}
+	node["legalNotice"] = v.encodeLegalNotice(packageDeclaration.GetLegalNotice())
+	node["packageHeader"] = v.encodePackageHeader(packageDeclaration.GetPackageHeader())
+	node["packageImports"] = v.encodePackageImports(packageDeclaration.GetPackageImports())
+	return node
+}
+
+func (v *encoder_) encodePackageHeader(
+	packageHeader ast.PackageHeaderLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "PackageHeader",
+	}
+	node["comment"] = packageHeader.GetComment()
+	node["delimiter"] = packageHeader.GetDelimiter()
+	node["name"] = packageHeader.GetName()
+	return node
+}
+
+func (v *encoder_) encodePackageImports(
+	packageImports ast.PackageImportsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "PackageImports",
+	}
+	node["delimiter1"] = packageImports.GetDelimiter1()
+	node["delimiter2"] = packageImports.GetDelimiter2()
+	var optionalImportList = packageImports.GetOptionalImportList()
+	if uti.IsDefined(optionalImportList) {
+		node["optionalImportList"] = v.encodeImportList(optionalImportList)
+	}
+	node["delimiter3"] = packageImports.GetDelimiter3()
+	return node
+}
+
+func (v *encoder_) encodeParameter(
+	parameter ast.ParameterLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Parameter",
+	}
+	node["name"] = parameter.GetName()
+	node["abstraction"] = v.encodeAbstraction(parameter.GetAbstraction())
+	node["delimiter"] = parameter.GetDelimiter()
+	return node
+}
+
+func (v *encoder_) encodeParameterList(
+	parameterList ast.ParameterListLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "ParameterList",
+	}
+	var parameters = []any{}
+	var parametersIterator = parameterList.GetParameters().GetIterator()
+	for parametersIterator.HasNext() {
+		var rule = parametersIterator.GetNext()
+		parameters = append(parameters, v.encodeParameter(rule))
+	}
+	node["parameters"] = parameters
+	return node
+}
+
+func (v *encoder_) encodePrimitiveDeclarations(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "PrimitiveDeclarations",
+	}
+	node["typeSection"] = v.encodeTypeSection(primitiveDeclarations.GetTypeSection())
+	node["functionalSection"] = v.encodeFunctionalSection(primitiveDeclarations.GetFunctionalSection())
+	return node
+}
+
+func (v *encoder_) encodePrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "PrincipalMethod",
+	}
+	node["method"] = v.encodeMethod(principalMethod.GetMethod())
+	return node
+}
+
+func (v *encoder_) encodePrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "PrincipalSubsection",
+	}
+	node["delimiter"] = principalSubsection.GetDelimiter()
+	var principalMethods = []any{}
+	var principalMethodsIterator = principalSubsection.GetPrincipalMethods().GetIterator()
+	for principalMethodsIterator.HasNext() {
+		var rule = principalMethodsIterator.GetNext()
+		principalMethods = append(principalMethods, v.encodePrincipalMethod(rule))
+	}
+	node["principalMethods"] = principalMethods
+	return node
+}
+
+func (v *encoder_) encodeResult(
+	result ast.ResultLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Result",
+	}
+	switch actual := result.GetAny().(type) {
+	case ast.NoneLike:
+		node["any"] = v.encodeNone(actual)
+	case ast.AbstractionLike:
+		node["any"] = v.encodeAbstraction(actual)
+	case ast.MultivalueLike:
+		node["any"] = v.encodeMultivalue(actual)
+	}
+	return node
+}
+
+func (v *encoder_) encodeSetterMethod(
+	setterMethod ast.SetterMethodLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "SetterMethod",
+	}
+	var optionalNote = setterMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		node["optionalNote"] = optionalNote
+	}
+	node["name"] = setterMethod.GetName()
+	node["delimiter1"] = setterMethod.GetDelimiter1()
+	node["parameter"] = v.encodeParameter(setterMethod.GetParameter())
+	node["delimiter2"] = setterMethod.GetDelimiter2()
+	return node
+}
+
+func (v *encoder_) encodeSpecification(
+	specification ast.SpecificationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Specification",
+	}
+	switch actual := specification.GetAny().(type) {
+	case ast.NoneLike:
+		node["any"] = v.encodeNone(actual)
+	case ast.AssignmentLike:
+		node["any"] = v.encodeAssignment(actual)
+	}
+	return node
+}
+
+func (v *encoder_) encodeStar(
+	star ast.StarLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Star",
+	}
+	node["delimiter"] = star.GetDelimiter()
+	return node
+}
+
+func (v *encoder_) encodeType(
+	type_ ast.TypeLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Type",
+	}
+	switch actual := type_.GetAny().(type) {
+	case ast.NamedLike:
+		node["any"] = v.encodeNamed(actual)
+	case ast.FunctionalLike:
+		node["any"] = v.encodeFunctional(actual)
+	}
+	return node
+}
+
+func (v *encoder_) encodeTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "TypeDeclaration",
+	}
+	node["declaration"] = v.encodeDeclaration(typeDeclaration.GetDeclaration())
+	node["abstraction"] = v.encodeAbstraction(typeDeclaration.GetAbstraction())
+	var optionalEnumeration = typeDeclaration.GetOptionalEnumeration()
+	if uti.IsDefined(optionalEnumeration) {
+		node["optionalEnumeration"] = v.encodeEnumeration(optionalEnumeration)
+	}
+	return node
+}
+
+func (v *encoder_) encodeTypeSection(
+	typeSection ast.TypeSectionLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "TypeSection",
+	}
+	node["delimiter"] = typeSection.GetDelimiter()
+	var typeDeclarations = []any{}
+	var typeDeclarationsIterator = typeSection.GetTypeDeclarations().GetIterator()
+	for typeDeclarationsIterator.HasNext() {
+		var rule = typeDeclarationsIterator.GetNext()
+		typeDeclarations = append(typeDeclarations, v.encodeTypeDeclaration(rule))
+	}
+	node["typeDeclarations"] = typeDeclarations
+	return node
+}
+
+func (v *encoder_) encodeValue(
+	value ast.ValueLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Value",
+	}
+	node["name"] = value.GetName()
+	node["abstraction"] = v.encodeAbstraction(value.GetAbstraction())
+	node["delimiter"] = value.GetDelimiter()
+	node["expression"] = v.encodeExpression(value.GetExpression())
+	return node
+}
+
+func (v *encoder_) encodeWrapper(
+	wrapper ast.WrapperLike,
+) map[string]any {
+	var node = map[string]any{
+		"kind": "Wrapper",
+	}
+	switch actual := wrapper.GetAny().(type) {
+	case ast.DotsLike:
+		node["any"] = v.encodeDots(actual)
+	case ast.StarLike:
+		node["any"] = v.encodeStar(actual)
+	case ast.ArrayLike:
+		node["any"] = v.encodeArray(actual)
+	case ast.ChannelLike:
+		node["any"] = v.encodeChannel(actual)
+	case ast.MapLike:
+		node["any"] = v.encodeMap(actual)
+	}
+	return node
+}
+
+// Instance Structure
+
+type encoder_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type encoderClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func encoderClass() *encoderClass_ {
+	return encoderClassReference_
+}
+
+var encoderClassReference_ = &encoderClass_{
+	// Initialize the class constants.
+}
@@ -23,6 +23,7 @@ package grammar
 
 import (
 	ast "github.com/craterdog/go-class-model/v8/ast"
+	uti "github.com/craterdog/go-essential-utilities/v8"
 	sts "strings"
 )
 
@@ -46,6 +47,22 @@ func (c *formatterClass_) Formatter() FormatterLike {
 	return instance
 }
 
+func (c *formatterClass_) FormatterWithRendering(
+	rendering RenderingFunction,
+) FormatterLike {
+	if uti.IsUndefined(rendering) {
+		panic("The \"rendering\" attribute is required by this class.")
+	}
+	var instance = &formatter_{
+		// Initialize the instance attributes.
+		rendering_: rendering,
+
+		// Initialize the inherited aspects.
+		Methodical: ProcessorClass().Processor(),
+	}
+	return instance
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -59,6 +76,86 @@ func (v *formatter_) FormatModel(model ast.ModelLike) string {
 	return v.getResult()
 }
 
+func (v *formatter_) FormatAbstraction(
+	abstraction ast.AbstractionLike,
+) string {
+	var result string
+	var wrapper = abstraction.GetOptionalWrapper()
+	if uti.IsDefined(wrapper) {
+		switch actual := wrapper.GetAny().(type) {
+		case ast.DotsLike:
+			result += v.render("...", "delimiter")
+		case ast.StarLike:
+			result += v.render("*", "delimiter")
+		case ast.ArrayLike:
+			result += v.render("[]", "delimiter")
+		case ast.ChannelLike:
+			result += v.render("chan", "delimiter") + " "
+		case ast.MapLike:
+			result += v.render("map", "delimiter") + v.render("[", "delimiter") +
+				v.render(actual.GetName(), "Named") + v.render("]", "delimiter")
+		}
+	}
+	switch actual := abstraction.GetType().GetAny().(type) {
+	case ast.NamedLike:
+		result += v.render(actual.GetOptionalPrefix()+actual.GetName(), "Named")
+		var arguments = actual.GetOptionalArguments()
+		if uti.IsDefined(arguments) {
+			var list = []string{
+				v.FormatAbstraction(arguments.GetArgument().GetAbstraction()),
+			}
+			var additionalArguments = arguments.GetAdditionalArguments().GetIterator()
+			for additionalArguments.HasNext() {
+				var argument = additionalArguments.GetNext().GetArgument()
+				list = append(list, v.FormatAbstraction(argument.GetAbstraction()))
+			}
+			result += v.render("[", "delimiter") + sts.Join(list, ", ") +
+				v.render("]", "delimiter")
+		}
+	case ast.FunctionalLike:
+		result += v.render("func", "delimiter") +
+			v.FormatParameterList(actual.GetOptionalParameterList())
+		var optionalResult = actual.GetOptionalResult()
+		if uti.IsDefined(optionalResult) {
+			result += v.FormatResult(optionalResult)
+		}
+	}
+	return result
+}
+
+func (v *formatter_) FormatParameterList(
+	parameterList ast.ParameterListLike,
+) string {
+	var list []string
+	if uti.IsDefined(parameterList) {
+		var parameters = parameterList.GetParameters().GetIterator()
+		for parameters.HasNext() {
+			var parameter = parameters.GetNext()
+			var abstraction = v.FormatAbstraction(parameter.GetAbstraction())
+			var name = v.render(parameter.GetName(), "Parameter")
+			if len(name) > 0 {
+				abstraction = name + " " + abstraction
+			}
+			list = append(list, abstraction)
+		}
+	}
+	return v.render("(", "delimiter") + sts.Join(list, ", ") +
+		v.render(")", "delimiter")
+}
+
+func (v *formatter_) FormatResult(
+	result ast.ResultLike,
+) string {
+	switch actual := result.GetAny().(type) {
+	case ast.AbstractionLike:
+		return " " + v.FormatAbstraction(actual)
+	case ast.MultivalueLike:
+		return " " + v.FormatParameterList(actual.GetParameterList())
+	default:
+		return ""
+	}
+}
+
 // Methodical Methods
 
 func (v *formatter_) ProcessComment(
@@ -79,10 +176,29 @@ func (v *formatter_) ProcessName(
 	v.appendString(name)
 }
 
-func (v *formatter_) ProcessPath(
-	path string,
+func (v *formatter_) ProcessNote(
+	note string,
+) {
+	// Each line of a note is reindented to the current depth.
+	var lines = sts.Split(note, "\n")
+	for index, line := range lines {
+		if index > 0 {
+			v.appendNewline()
+		}
+		v.appendString(sts.TrimSpace(line))
+	}
+}
+
+func (v *formatter_) ProcessNumber(
+	number string,
 ) {
-	v.appendString(path)
+	v.appendString(number)
+}
+
+func (v *formatter_) ProcessOperator(
+	operator string,
+) {
+	v.appendString(operator)
 }
 
 func (v *formatter_) ProcessPrefix(
@@ -97,6 +213,12 @@ func (v *formatter_) ProcessSpace(
 	v.appendString(space)
 }
 
+func (v *formatter_) ProcessString(
+	string_ string,
+) {
+	v.appendString(string_)
+}
+
 func (v *formatter_) ProcessAdditionalArgumentSlot(
 	additionalArgument ast.AdditionalArgumentLike,
 	slot_ uint,
@@ -161,16 +283,16 @@ func (v *formatter_) PreprocessAspectInterface(
 	v.appendNewline()
 }
 
-func (v *formatter_) PreprocessAspectMethod(
-	aspectMethod ast.AspectMethodLike,
+func (v *formatter_) PreprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
 	index_ uint,
 	count_ uint,
 ) {
 	v.depth_++
 }
 
-func (v *formatter_) PostprocessAspectMethod(
-	aspectMethod ast.AspectMethodLike,
+func (v *formatter_) PostprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
 	index_ uint,
 	count_ uint,
 ) {
@@ -205,6 +327,16 @@ func (v *formatter_) PostprocessAspectSubsection(
 	v.appendNewline()
 }
 
+func (v *formatter_) ProcessAssignmentSlot(
+	assignment ast.AssignmentLike,
+	slot_ uint,
+) {
+	switch slot_ {
+	default:
+		v.appendString(" ")
+	}
+}
+
 func (v *formatter_) PreprocessAttributeSubsection(
 	attributeSubsection ast.AttributeSubsectionLike,
 	index_ uint,
@@ -272,7 +404,9 @@ func (v *formatter_) ProcessConstantMethodSlot(
 	slot_ uint,
 ) {
 	switch slot_ {
-	case 3:
+	case 1:
+		v.appendNoteNewline(constantMethod.GetOptionalNote())
+	case 4:
 		v.appendString(" ")
 	}
 }
@@ -318,7 +452,9 @@ func (v *formatter_) ProcessConstructorMethodSlot(
 	slot_ uint,
 ) {
 	switch slot_ {
-	case 4:
+	case 1:
+		v.appendNoteNewline(constructorMethod.GetOptionalNote())
+	case 5:
 		v.appendString(" ")
 	}
 }
@@ -391,6 +527,16 @@ func (v *formatter_) PreprocessFunctionMethod(
 	v.appendNewline()
 }
 
+func (v *formatter_) ProcessFunctionMethodSlot(
+	functionMethod ast.FunctionMethodLike,
+	slot_ uint,
+) {
+	switch slot_ {
+	case 1:
+		v.appendNoteNewline(functionMethod.GetOptionalNote())
+	}
+}
+
 func (v *formatter_) PreprocessFunctionSubsection(
 	functionSubsection ast.FunctionSubsectionLike,
 	index_ uint,
@@ -458,7 +604,9 @@ func (v *formatter_) ProcessGetterMethodSlot(
 	slot_ uint,
 ) {
 	switch slot_ {
-	case 3:
+	case 1:
+		v.appendNoteNewline(getterMethod.GetOptionalNote())
+	case 4:
 		v.appendString(" ")
 	}
 }
@@ -560,6 +708,34 @@ func (v *formatter_) PreprocessMethod(
 	v.appendNewline()
 }
 
+func (v *formatter_) ProcessMethodSlot(
+	method ast.MethodLike,
+	slot_ uint,
+) {
+	switch slot_ {
+	case 1:
+		v.appendNoteNewline(method.GetOptionalNote())
+	}
+}
+
+func (v *formatter_) PreprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.appendString(" ")
+}
+
+func (v *formatter_) ProcessOperationSlot(
+	operation ast.OperationLike,
+	slot_ uint,
+) {
+	switch slot_ {
+	default:
+		v.appendString(" ")
+	}
+}
+
 func (v *formatter_) PostprocessPackageDeclaration(
 	packageDeclaration ast.PackageDeclarationLike,
 	index_ uint,
@@ -702,14 +878,28 @@ func (v *formatter_) ProcessSetterMethodSlot(
 	slot_ uint,
 ) {
 	switch slot_ {
-	case 2:
-		v.depth_++
+	case 1:
+		v.appendNoteNewline(setterMethod.GetOptionalNote())
 	case 3:
+		v.depth_++
+	case 4:
 		v.depth_--
 		v.appendNewline()
 	}
 }
 
+func (v *formatter_) PreprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+	switch specification.GetAny().(type) {
+	case ast.NoneLike:
+	default:
+		v.appendString(" ")
+	}
+}
+
 func (v *formatter_) PreprocessTypeDeclaration(
 	typeDeclaration ast.TypeDeclarationLike,
 	index_ uint,
@@ -765,6 +955,15 @@ func (v *formatter_) appendNewline() {
 	v.appendString(newline)
 }
 
+func (v *formatter_) appendNoteNewline(
+	note string,
+) {
+	// A note is always followed by the signature on the next line.
+	if uti.IsDefined(note) {
+		v.appendNewline()
+	}
+}
+
 func (v *formatter_) appendString(
 	string_ string,
 ) {
@@ -777,12 +976,23 @@ func (v *formatter_) getResult() string {
 	return result
 }
 
+func (v *formatter_) render(
+	fragment string,
+	name string,
+) string {
+	if uti.IsUndefined(v.rendering_) {
+		return fragment
+	}
+	return v.rendering_(fragment, name)
+}
+
 // Instance Structure
 
 type formatter_ struct {
 	// Declare the instance attributes.
-	depth_  uint
-	result_ sts.Builder
+	rendering_ RenderingFunction
+	depth_     uint
+	result_    sts.Builder
 
 	// Declare the inherited aspects.
 	Methodical
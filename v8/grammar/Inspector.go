@@ -0,0 +1,1659 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func InspectorClass() InspectorClassLike {
+	return inspectorClass()
+}
+
+// Constructor Methods
+
+func (c *inspectorClass_) Inspector(
+	preprocess InspectionFunction,
+	postprocess InspectionFunction,
+) InspectorLike {
+	if uti.IsUndefined(preprocess) {
+		panic("The \"preprocess\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(postprocess) {
+		panic("The \"postprocess\" attribute is required by this class.")
+	}
+	var instance = &inspector_{
+		// Initialize the instance attributes.
+		preprocess_:  preprocess,
+		postprocess_: postprocess,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *inspector_) GetClass() InspectorClassLike {
+	return inspectorClass()
+}
+
+// Methodical Methods
+
+func (v *inspector_) ProcessComment(
+	comment string,
+) {
+	v.preprocess_(comment, "comment")
+	v.postprocess_(comment, "comment")
+}
+
+func (v *inspector_) ProcessDelimiter(
+	delimiter string,
+) {
+	v.preprocess_(delimiter, "delimiter")
+	v.postprocess_(delimiter, "delimiter")
+}
+
+func (v *inspector_) ProcessName(
+	name string,
+) {
+	v.preprocess_(name, "name")
+	v.postprocess_(name, "name")
+}
+
+func (v *inspector_) ProcessNewline(
+	newline string,
+) {
+	v.preprocess_(newline, "newline")
+	v.postprocess_(newline, "newline")
+}
+
+func (v *inspector_) ProcessNote(
+	note string,
+) {
+	v.preprocess_(note, "note")
+	v.postprocess_(note, "note")
+}
+
+func (v *inspector_) ProcessNumber(
+	number string,
+) {
+	v.preprocess_(number, "number")
+	v.postprocess_(number, "number")
+}
+
+func (v *inspector_) ProcessOperator(
+	operator string,
+) {
+	v.preprocess_(operator, "operator")
+	v.postprocess_(operator, "operator")
+}
+
+func (v *inspector_) ProcessPrefix(
+	prefix string,
+) {
+	v.preprocess_(prefix, "prefix")
+	v.postprocess_(prefix, "prefix")
+}
+
+func (v *inspector_) ProcessSpace(
+	space string,
+) {
+	v.preprocess_(space, "space")
+	v.postprocess_(space, "space")
+}
+
+func (v *inspector_) ProcessString(
+	string_ string,
+) {
+	v.preprocess_(string_, "string")
+	v.postprocess_(string_, "string")
+}
+
+func (v *inspector_) PreprocessAbstraction(
+	abstraction ast.AbstractionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(abstraction, "Abstraction")
+}
+
+func (v *inspector_) PostprocessAbstraction(
+	abstraction ast.AbstractionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(abstraction, "Abstraction")
+}
+
+func (v *inspector_) ProcessAbstractionSlot(
+	abstraction ast.AbstractionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAdditionalArgument(
+	additionalArgument ast.AdditionalArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(additionalArgument, "AdditionalArgument")
+}
+
+func (v *inspector_) PostprocessAdditionalArgument(
+	additionalArgument ast.AdditionalArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(additionalArgument, "AdditionalArgument")
+}
+
+func (v *inspector_) ProcessAdditionalArgumentSlot(
+	additionalArgument ast.AdditionalArgumentLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAdditionalConstraint(
+	additionalConstraint ast.AdditionalConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(additionalConstraint, "AdditionalConstraint")
+}
+
+func (v *inspector_) PostprocessAdditionalConstraint(
+	additionalConstraint ast.AdditionalConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(additionalConstraint, "AdditionalConstraint")
+}
+
+func (v *inspector_) ProcessAdditionalConstraintSlot(
+	additionalConstraint ast.AdditionalConstraintLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(additionalValue, "AdditionalValue")
+}
+
+func (v *inspector_) PostprocessAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(additionalValue, "AdditionalValue")
+}
+
+func (v *inspector_) ProcessAdditionalValueSlot(
+	additionalValue ast.AdditionalValueLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessArgument(
+	argument ast.ArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(argument, "Argument")
+}
+
+func (v *inspector_) PostprocessArgument(
+	argument ast.ArgumentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(argument, "Argument")
+}
+
+func (v *inspector_) ProcessArgumentSlot(
+	argument ast.ArgumentLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessArguments(
+	arguments ast.ArgumentsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(arguments, "Arguments")
+}
+
+func (v *inspector_) PostprocessArguments(
+	arguments ast.ArgumentsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(arguments, "Arguments")
+}
+
+func (v *inspector_) ProcessArgumentsSlot(
+	arguments ast.ArgumentsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessArray(
+	array ast.ArrayLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(array, "Array")
+}
+
+func (v *inspector_) PostprocessArray(
+	array ast.ArrayLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(array, "Array")
+}
+
+func (v *inspector_) ProcessArraySlot(
+	array ast.ArrayLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(aspectDeclaration, "AspectDeclaration")
+}
+
+func (v *inspector_) PostprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(aspectDeclaration, "AspectDeclaration")
+}
+
+func (v *inspector_) ProcessAspectDeclarationSlot(
+	aspectDeclaration ast.AspectDeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(aspectInterface, "AspectInterface")
+}
+
+func (v *inspector_) PostprocessAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(aspectInterface, "AspectInterface")
+}
+
+func (v *inspector_) ProcessAspectInterfaceSlot(
+	aspectInterface ast.AspectInterfaceLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(aspectMember, "AspectMember")
+}
+
+func (v *inspector_) PostprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(aspectMember, "AspectMember")
+}
+
+func (v *inspector_) ProcessAspectMemberSlot(
+	aspectMember ast.AspectMemberLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(aspectMethod, "AspectMethod")
+}
+
+func (v *inspector_) PostprocessAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(aspectMethod, "AspectMethod")
+}
+
+func (v *inspector_) ProcessAspectMethodSlot(
+	aspectMethod ast.AspectMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAspectSection(
+	aspectSection ast.AspectSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(aspectSection, "AspectSection")
+}
+
+func (v *inspector_) PostprocessAspectSection(
+	aspectSection ast.AspectSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(aspectSection, "AspectSection")
+}
+
+func (v *inspector_) ProcessAspectSectionSlot(
+	aspectSection ast.AspectSectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(aspectSubsection, "AspectSubsection")
+}
+
+func (v *inspector_) PostprocessAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(aspectSubsection, "AspectSubsection")
+}
+
+func (v *inspector_) ProcessAspectSubsectionSlot(
+	aspectSubsection ast.AspectSubsectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAssignment(
+	assignment ast.AssignmentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(assignment, "Assignment")
+}
+
+func (v *inspector_) PostprocessAssignment(
+	assignment ast.AssignmentLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(assignment, "Assignment")
+}
+
+func (v *inspector_) ProcessAssignmentSlot(
+	assignment ast.AssignmentLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAttributeMethod(
+	attributeMethod ast.AttributeMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(attributeMethod, "AttributeMethod")
+}
+
+func (v *inspector_) PostprocessAttributeMethod(
+	attributeMethod ast.AttributeMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(attributeMethod, "AttributeMethod")
+}
+
+func (v *inspector_) ProcessAttributeMethodSlot(
+	attributeMethod ast.AttributeMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(attributeSubsection, "AttributeSubsection")
+}
+
+func (v *inspector_) PostprocessAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(attributeSubsection, "AttributeSubsection")
+}
+
+func (v *inspector_) ProcessAttributeSubsectionSlot(
+	attributeSubsection ast.AttributeSubsectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessChannel(
+	channel ast.ChannelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(channel, "Channel")
+}
+
+func (v *inspector_) PostprocessChannel(
+	channel ast.ChannelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(channel, "Channel")
+}
+
+func (v *inspector_) ProcessChannelSlot(
+	channel ast.ChannelLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(classDeclaration, "ClassDeclaration")
+}
+
+func (v *inspector_) PostprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(classDeclaration, "ClassDeclaration")
+}
+
+func (v *inspector_) ProcessClassDeclarationSlot(
+	classDeclaration ast.ClassDeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessClassMethods(
+	classMethods ast.ClassMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(classMethods, "ClassMethods")
+}
+
+func (v *inspector_) PostprocessClassMethods(
+	classMethods ast.ClassMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(classMethods, "ClassMethods")
+}
+
+func (v *inspector_) ProcessClassMethodsSlot(
+	classMethods ast.ClassMethodsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessClassSection(
+	classSection ast.ClassSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(classSection, "ClassSection")
+}
+
+func (v *inspector_) PostprocessClassSection(
+	classSection ast.ClassSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(classSection, "ClassSection")
+}
+
+func (v *inspector_) ProcessClassSectionSlot(
+	classSection ast.ClassSectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(constantMethod, "ConstantMethod")
+}
+
+func (v *inspector_) PostprocessConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(constantMethod, "ConstantMethod")
+}
+
+func (v *inspector_) ProcessConstantMethodSlot(
+	constantMethod ast.ConstantMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(constantSubsection, "ConstantSubsection")
+}
+
+func (v *inspector_) PostprocessConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(constantSubsection, "ConstantSubsection")
+}
+
+func (v *inspector_) ProcessConstantSubsectionSlot(
+	constantSubsection ast.ConstantSubsectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessConstraint(
+	constraint ast.ConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(constraint, "Constraint")
+}
+
+func (v *inspector_) PostprocessConstraint(
+	constraint ast.ConstraintLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(constraint, "Constraint")
+}
+
+func (v *inspector_) ProcessConstraintSlot(
+	constraint ast.ConstraintLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessConstraints(
+	constraints ast.ConstraintsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(constraints, "Constraints")
+}
+
+func (v *inspector_) PostprocessConstraints(
+	constraints ast.ConstraintsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(constraints, "Constraints")
+}
+
+func (v *inspector_) ProcessConstraintsSlot(
+	constraints ast.ConstraintsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(constructorMethod, "ConstructorMethod")
+}
+
+func (v *inspector_) PostprocessConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(constructorMethod, "ConstructorMethod")
+}
+
+func (v *inspector_) ProcessConstructorMethodSlot(
+	constructorMethod ast.ConstructorMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(constructorSubsection, "ConstructorSubsection")
+}
+
+func (v *inspector_) PostprocessConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(constructorSubsection, "ConstructorSubsection")
+}
+
+func (v *inspector_) ProcessConstructorSubsectionSlot(
+	constructorSubsection ast.ConstructorSubsectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessDeclaration(
+	declaration ast.DeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(declaration, "Declaration")
+}
+
+func (v *inspector_) PostprocessDeclaration(
+	declaration ast.DeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(declaration, "Declaration")
+}
+
+func (v *inspector_) ProcessDeclarationSlot(
+	declaration ast.DeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessDots(
+	dots ast.DotsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(dots, "Dots")
+}
+
+func (v *inspector_) PostprocessDots(
+	dots ast.DotsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(dots, "Dots")
+}
+
+func (v *inspector_) ProcessDotsSlot(
+	dots ast.DotsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessEnumeration(
+	enumeration ast.EnumerationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(enumeration, "Enumeration")
+}
+
+func (v *inspector_) PostprocessEnumeration(
+	enumeration ast.EnumerationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(enumeration, "Enumeration")
+}
+
+func (v *inspector_) ProcessEnumerationSlot(
+	enumeration ast.EnumerationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessExpression(
+	expression ast.ExpressionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(expression, "Expression")
+}
+
+func (v *inspector_) PostprocessExpression(
+	expression ast.ExpressionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(expression, "Expression")
+}
+
+func (v *inspector_) ProcessExpressionSlot(
+	expression ast.ExpressionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(functionMethod, "FunctionMethod")
+}
+
+func (v *inspector_) PostprocessFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(functionMethod, "FunctionMethod")
+}
+
+func (v *inspector_) ProcessFunctionMethodSlot(
+	functionMethod ast.FunctionMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(functionSubsection, "FunctionSubsection")
+}
+
+func (v *inspector_) PostprocessFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(functionSubsection, "FunctionSubsection")
+}
+
+func (v *inspector_) ProcessFunctionSubsectionSlot(
+	functionSubsection ast.FunctionSubsectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessFunctional(
+	functional ast.FunctionalLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(functional, "Functional")
+}
+
+func (v *inspector_) PostprocessFunctional(
+	functional ast.FunctionalLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(functional, "Functional")
+}
+
+func (v *inspector_) ProcessFunctionalSlot(
+	functional ast.FunctionalLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(functionalDeclaration, "FunctionalDeclaration")
+}
+
+func (v *inspector_) PostprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(functionalDeclaration, "FunctionalDeclaration")
+}
+
+func (v *inspector_) ProcessFunctionalDeclarationSlot(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(functionalSection, "FunctionalSection")
+}
+
+func (v *inspector_) PostprocessFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(functionalSection, "FunctionalSection")
+}
+
+func (v *inspector_) ProcessFunctionalSectionSlot(
+	functionalSection ast.FunctionalSectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(getterMethod, "GetterMethod")
+}
+
+func (v *inspector_) PostprocessGetterMethod(
+	getterMethod ast.GetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(getterMethod, "GetterMethod")
+}
+
+func (v *inspector_) ProcessGetterMethodSlot(
+	getterMethod ast.GetterMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessImportList(
+	importList ast.ImportListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(importList, "ImportList")
+}
+
+func (v *inspector_) PostprocessImportList(
+	importList ast.ImportListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(importList, "ImportList")
+}
+
+func (v *inspector_) ProcessImportListSlot(
+	importList ast.ImportListLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(importedPackage, "ImportedPackage")
+}
+
+func (v *inspector_) PostprocessImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(importedPackage, "ImportedPackage")
+}
+
+func (v *inspector_) ProcessImportedPackageSlot(
+	importedPackage ast.ImportedPackageLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(instanceDeclaration, "InstanceDeclaration")
+}
+
+func (v *inspector_) PostprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(instanceDeclaration, "InstanceDeclaration")
+}
+
+func (v *inspector_) ProcessInstanceDeclarationSlot(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessInstanceMethods(
+	instanceMethods ast.InstanceMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(instanceMethods, "InstanceMethods")
+}
+
+func (v *inspector_) PostprocessInstanceMethods(
+	instanceMethods ast.InstanceMethodsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(instanceMethods, "InstanceMethods")
+}
+
+func (v *inspector_) ProcessInstanceMethodsSlot(
+	instanceMethods ast.InstanceMethodsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(instanceSection, "InstanceSection")
+}
+
+func (v *inspector_) PostprocessInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(instanceSection, "InstanceSection")
+}
+
+func (v *inspector_) ProcessInstanceSectionSlot(
+	instanceSection ast.InstanceSectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessInterfaceDeclarations(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(interfaceDeclarations, "InterfaceDeclarations")
+}
+
+func (v *inspector_) PostprocessInterfaceDeclarations(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(interfaceDeclarations, "InterfaceDeclarations")
+}
+
+func (v *inspector_) ProcessInterfaceDeclarationsSlot(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessLegalNotice(
+	legalNotice ast.LegalNoticeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(legalNotice, "LegalNotice")
+}
+
+func (v *inspector_) PostprocessLegalNotice(
+	legalNotice ast.LegalNoticeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(legalNotice, "LegalNotice")
+}
+
+func (v *inspector_) ProcessLegalNoticeSlot(
+	legalNotice ast.LegalNoticeLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessMap(
+	map_ ast.MapLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(map_, "Map")
+}
+
+func (v *inspector_) PostprocessMap(
+	map_ ast.MapLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(map_, "Map")
+}
+
+func (v *inspector_) ProcessMapSlot(
+	map_ ast.MapLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessMethod(
+	method ast.MethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(method, "Method")
+}
+
+func (v *inspector_) PostprocessMethod(
+	method ast.MethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(method, "Method")
+}
+
+func (v *inspector_) ProcessMethodSlot(
+	method ast.MethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(model, "Model")
+}
+
+func (v *inspector_) PostprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(model, "Model")
+}
+
+func (v *inspector_) ProcessModelSlot(
+	model ast.ModelLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessMultivalue(
+	multivalue ast.MultivalueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(multivalue, "Multivalue")
+}
+
+func (v *inspector_) PostprocessMultivalue(
+	multivalue ast.MultivalueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(multivalue, "Multivalue")
+}
+
+func (v *inspector_) ProcessMultivalueSlot(
+	multivalue ast.MultivalueLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessNamed(
+	named ast.NamedLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(named, "Named")
+}
+
+func (v *inspector_) PostprocessNamed(
+	named ast.NamedLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(named, "Named")
+}
+
+func (v *inspector_) ProcessNamedSlot(
+	named ast.NamedLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessNone(
+	none ast.NoneLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(none, "None")
+}
+
+func (v *inspector_) PostprocessNone(
+	none ast.NoneLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(none, "None")
+}
+
+func (v *inspector_) ProcessNoneSlot(
+	none ast.NoneLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessOperand(
+	operand ast.OperandLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(operand, "Operand")
+}
+
+func (v *inspector_) PostprocessOperand(
+	operand ast.OperandLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(operand, "Operand")
+}
+
+func (v *inspector_) ProcessOperandSlot(
+	operand ast.OperandLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(operation, "Operation")
+}
+
+func (v *inspector_) PostprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(operation, "Operation")
+}
+
+func (v *inspector_) ProcessOperationSlot(
+	operation ast.OperationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessPackageDeclaration(
+	packageDeclaration ast.PackageDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(packageDeclaration, "PackageDeclaration")
+}
+
+func (v *inspector_) PostprocessPackageDeclaration(
+	packageDeclaration ast.PackageDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(packageDeclaration, "PackageDeclaration")
+}
+
+func (v *inspector_) ProcessPackageDeclarationSlot(
+	packageDeclaration ast.PackageDeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(packageHeader, "PackageHeader")
+}
+
+func (v *inspector_) PostprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(packageHeader, "PackageHeader")
+}
+
+func (v *inspector_) ProcessPackageHeaderSlot(
+	packageHeader ast.PackageHeaderLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessPackageImports(
+	packageImports ast.PackageImportsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(packageImports, "PackageImports")
+}
+
+func (v *inspector_) PostprocessPackageImports(
+	packageImports ast.PackageImportsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(packageImports, "PackageImports")
+}
+
+func (v *inspector_) ProcessPackageImportsSlot(
+	packageImports ast.PackageImportsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessParameter(
+	parameter ast.ParameterLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(parameter, "Parameter")
+}
+
+func (v *inspector_) PostprocessParameter(
+	parameter ast.ParameterLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(parameter, "Parameter")
+}
+
+func (v *inspector_) ProcessParameterSlot(
+	parameter ast.ParameterLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessParameterList(
+	parameterList ast.ParameterListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(parameterList, "ParameterList")
+}
+
+func (v *inspector_) PostprocessParameterList(
+	parameterList ast.ParameterListLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(parameterList, "ParameterList")
+}
+
+func (v *inspector_) ProcessParameterListSlot(
+	parameterList ast.ParameterListLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessPrimitiveDeclarations(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(primitiveDeclarations, "PrimitiveDeclarations")
+}
+
+func (v *inspector_) PostprocessPrimitiveDeclarations(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(primitiveDeclarations, "PrimitiveDeclarations")
+}
+
+func (v *inspector_) ProcessPrimitiveDeclarationsSlot(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(principalMethod, "PrincipalMethod")
+}
+
+func (v *inspector_) PostprocessPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(principalMethod, "PrincipalMethod")
+}
+
+func (v *inspector_) ProcessPrincipalMethodSlot(
+	principalMethod ast.PrincipalMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(principalSubsection, "PrincipalSubsection")
+}
+
+func (v *inspector_) PostprocessPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(principalSubsection, "PrincipalSubsection")
+}
+
+func (v *inspector_) ProcessPrincipalSubsectionSlot(
+	principalSubsection ast.PrincipalSubsectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessResult(
+	result ast.ResultLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(result, "Result")
+}
+
+func (v *inspector_) PostprocessResult(
+	result ast.ResultLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(result, "Result")
+}
+
+func (v *inspector_) ProcessResultSlot(
+	result ast.ResultLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(setterMethod, "SetterMethod")
+}
+
+func (v *inspector_) PostprocessSetterMethod(
+	setterMethod ast.SetterMethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(setterMethod, "SetterMethod")
+}
+
+func (v *inspector_) ProcessSetterMethodSlot(
+	setterMethod ast.SetterMethodLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(specification, "Specification")
+}
+
+func (v *inspector_) PostprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(specification, "Specification")
+}
+
+func (v *inspector_) ProcessSpecificationSlot(
+	specification ast.SpecificationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessStar(
+	star ast.StarLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(star, "Star")
+}
+
+func (v *inspector_) PostprocessStar(
+	star ast.StarLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(star, "Star")
+}
+
+func (v *inspector_) ProcessStarSlot(
+	star ast.StarLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessType(
+	type_ ast.TypeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(type_, "Type")
+}
+
+func (v *inspector_) PostprocessType(
+	type_ ast.TypeLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(type_, "Type")
+}
+
+func (v *inspector_) ProcessTypeSlot(
+	type_ ast.TypeLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(typeDeclaration, "TypeDeclaration")
+}
+
+func (v *inspector_) PostprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(typeDeclaration, "TypeDeclaration")
+}
+
+func (v *inspector_) ProcessTypeDeclarationSlot(
+	typeDeclaration ast.TypeDeclarationLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessTypeSection(
+	typeSection ast.TypeSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(typeSection, "TypeSection")
+}
+
+func (v *inspector_) PostprocessTypeSection(
+	typeSection ast.TypeSectionLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(typeSection, "TypeSection")
+}
+
+func (v *inspector_) ProcessTypeSectionSlot(
+	typeSection ast.TypeSectionLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessValue(
+	value ast.ValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(value, "Value")
+}
+
+func (v *inspector_) PostprocessValue(
+	value ast.ValueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(value, "Value")
+}
+
+func (v *inspector_) ProcessValueSlot(
+	value ast.ValueLike,
+	slot_ uint,
+) {
+}
+
+func (v *inspector_) PreprocessWrapper(
+	wrapper ast.WrapperLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.preprocess_(wrapper, "Wrapper")
+}
+
+func (v *inspector_) PostprocessWrapper(
+	wrapper ast.WrapperLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.postprocess_(wrapper, "Wrapper")
+}
+
+func (v *inspector_) ProcessWrapperSlot(
+	wrapper ast.WrapperLike,
+	slot_ uint,
+) {
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type inspector_ struct {
+	// Declare the instance attributes.
+	preprocess_  InspectionFunction
+	postprocess_ InspectionFunction
+}
+
+// Class Structure
+
+type inspectorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func inspectorClass() *inspectorClass_ {
+	return inspectorClassReference_
+}
+
+var inspectorClassReference_ = &inspectorClass_{
+	// Initialize the class constants.
+}
@@ -249,10 +249,30 @@ func (v *parser_) parseAdditionalValue() (
 		tokens.AppendValue(token)
 	}
 
+	// Attempt to parse a single Specification rule.
+	var specification ast.SpecificationLike
+	specification, token, ok = v.parseSpecification()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single Specification rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$AdditionalValue", token)
+		panic(message)
+	}
+
 	// Found a single AdditionalValue rule.
 	ok = true
 	v.remove(tokens)
-	additionalValue = ast.AdditionalValueClass().AdditionalValue(name)
+	additionalValue = ast.AdditionalValueClass().AdditionalValue(
+		name,
+		specification,
+	)
 	return
 }
 
@@ -498,30 +518,30 @@ func (v *parser_) parseAspectDeclaration() (
 		tokens.AppendValue(token)
 	}
 
-	// Attempt to parse multiple AspectMethod rules.
-	var aspectMethods = com.List[ast.AspectMethodLike]()
-aspectMethodsLoop:
+	// Attempt to parse multiple AspectMember rules.
+	var aspectMembers = com.List[ast.AspectMemberLike]()
+aspectMembersLoop:
 	for count_ := 0; count_ < mat.MaxInt; count_++ {
-		var aspectMethod ast.AspectMethodLike
-		aspectMethod, token, ok = v.parseAspectMethod()
+		var aspectMember ast.AspectMemberLike
+		aspectMember, token, ok = v.parseAspectMember()
 		if !ok {
 			switch {
 			case count_ >= 1:
-				break aspectMethodsLoop
+				break aspectMembersLoop
 			case uti.IsDefined(tokens):
-				// This is not multiple AspectMethod rules.
+				// This is not multiple AspectMember rules.
 				v.putBack(tokens)
 				return
 			default:
 				// Found a syntax error.
 				var message = v.formatError("$AspectDeclaration", token)
-				message += "1 or more AspectMethod rules are required."
+				message += "1 or more AspectMember rules are required."
 				panic(message)
 			}
 		}
 		// No additional put backs allowed at this point.
 		tokens = nil
-		aspectMethods.AppendValue(aspectMethod)
+		aspectMembers.AppendValue(aspectMember)
 	}
 
 	// Attempt to parse a single "}" literal.
@@ -549,7 +569,7 @@ aspectMethodsLoop:
 		declaration,
 		delimiter1,
 		delimiter2,
-		aspectMethods,
+		aspectMembers,
 		delimiter3,
 	)
 	return
@@ -586,6 +606,33 @@ func (v *parser_) parseAspectInterface() (
 	return
 }
 
+func (v *parser_) parseAspectMember() (
+	aspectMember ast.AspectMemberLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a single AspectMethod AspectMember.
+	var aspectMethod ast.AspectMethodLike
+	aspectMethod, token, ok = v.parseAspectMethod()
+	if ok {
+		// Found a single AspectMethod AspectMember.
+		aspectMember = ast.AspectMemberClass().AspectMember(aspectMethod)
+		return
+	}
+
+	// Attempt to parse a single AspectInterface AspectMember.
+	var aspectInterface ast.AspectInterfaceLike
+	aspectInterface, token, ok = v.parseAspectInterface()
+	if ok {
+		// Found a single AspectInterface AspectMember.
+		aspectMember = ast.AspectMemberClass().AspectMember(aspectInterface)
+		return
+	}
+
+	// This is not a single AspectMember rule.
+	return
+}
+
 func (v *parser_) parseAspectMethod() (
 	aspectMethod ast.AspectMethodLike,
 	token TokenLike,
@@ -739,6 +786,76 @@ aspectInterfacesLoop:
 	return
 }
 
+func (v *parser_) parseAssignment() (
+	assignment ast.AssignmentLike,
+	token TokenLike,
+	ok bool,
+) {
+	var tokens = com.List[TokenLike]()
+
+	// Attempt to parse a single Abstraction rule.
+	var abstraction ast.AbstractionLike
+	abstraction, token, ok = v.parseAbstraction()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single Abstraction rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$Assignment", token)
+		panic(message)
+	}
+
+	// Attempt to parse a single "=" literal.
+	var delimiter string
+	delimiter, token, ok = v.parseDelimiter("=")
+	if !ok {
+		if uti.IsDefined(tokens) {
+			// This is not a single Assignment rule.
+			v.putBack(tokens)
+			return
+		} else {
+			// Found a syntax error.
+			var message = v.formatError("$Assignment", token)
+			panic(message)
+		}
+	}
+	if uti.IsDefined(tokens) {
+		tokens.AppendValue(token)
+	}
+
+	// Attempt to parse a single Expression rule.
+	var expression ast.ExpressionLike
+	expression, token, ok = v.parseExpression()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single Expression rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$Assignment", token)
+		panic(message)
+	}
+
+	// Found a single Assignment rule.
+	ok = true
+	v.remove(tokens)
+	assignment = ast.AssignmentClass().Assignment(
+		abstraction,
+		delimiter,
+		expression,
+	)
+	return
+}
+
 func (v *parser_) parseAttributeMethod() (
 	attributeMethod ast.AttributeMethodLike,
 	token TokenLike,
@@ -1086,6 +1203,17 @@ func (v *parser_) parseConstantMethod() (
 ) {
 	var tokens = com.List[TokenLike]()
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, token, ok = v.parseToken(NoteToken)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		optionalNote = "" // Reset this to undefined.
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -1161,6 +1289,7 @@ func (v *parser_) parseConstantMethod() (
 	ok = true
 	v.remove(tokens)
 	constantMethod = ast.ConstantMethodClass().ConstantMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		delimiter2,
@@ -1387,6 +1516,17 @@ func (v *parser_) parseConstructorMethod() (
 ) {
 	var tokens = com.List[TokenLike]()
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, token, ok = v.parseToken(NoteToken)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		optionalNote = "" // Reset this to undefined.
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -1470,6 +1610,7 @@ func (v *parser_) parseConstructorMethod() (
 	ok = true
 	v.remove(tokens)
 	constructorMethod = ast.ConstructorMethodClass().ConstructorMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		optionalParameterList,
@@ -1770,6 +1911,48 @@ additionalValuesLoop:
 	return
 }
 
+func (v *parser_) parseExpression() (
+	expression ast.ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var tokens = com.List[TokenLike]()
+
+	// Attempt to parse a single Operand rule.
+	var operand ast.OperandLike
+	operand, token, ok = v.parseOperand()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single Operand rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$Expression", token)
+		panic(message)
+	}
+
+	// Attempt to parse an optional Operation rule.
+	var optionalOperation ast.OperationLike
+	optionalOperation, _, ok = v.parseOperation()
+	if ok {
+		// No additional put backs allowed at this point.
+		tokens = nil
+	}
+
+	// Found a single Expression rule.
+	ok = true
+	v.remove(tokens)
+	expression = ast.ExpressionClass().Expression(
+		operand,
+		optionalOperation,
+	)
+	return
+}
+
 func (v *parser_) parseFunctionMethod() (
 	functionMethod ast.FunctionMethodLike,
 	token TokenLike,
@@ -1777,6 +1960,17 @@ func (v *parser_) parseFunctionMethod() (
 ) {
 	var tokens = com.List[TokenLike]()
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, token, ok = v.parseToken(NoteToken)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		optionalNote = "" // Reset this to undefined.
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -1860,6 +2054,7 @@ func (v *parser_) parseFunctionMethod() (
 	ok = true
 	v.remove(tokens)
 	functionMethod = ast.FunctionMethodClass().FunctionMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		optionalParameterList,
@@ -2139,6 +2334,17 @@ func (v *parser_) parseGetterMethod() (
 ) {
 	var tokens = com.List[TokenLike]()
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, token, ok = v.parseToken(NoteToken)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		optionalNote = "" // Reset this to undefined.
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -2214,6 +2420,7 @@ func (v *parser_) parseGetterMethod() (
 	ok = true
 	v.remove(tokens)
 	getterMethod = ast.GetterMethodClass().GetterMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		delimiter2,
@@ -2287,12 +2494,12 @@ func (v *parser_) parseImportedPackage() (
 		tokens.AppendValue(token)
 	}
 
-	// Attempt to parse a single path token.
-	var path string
-	path, token, ok = v.parseToken(PathToken)
+	// Attempt to parse a single string token.
+	var string_ string
+	string_, token, ok = v.parseToken(StringToken)
 	if !ok {
 		if uti.IsDefined(tokens) {
-			// This is not a single path token.
+			// This is not a single string token.
 			v.putBack(tokens)
 			return
 		} else {
@@ -2310,7 +2517,7 @@ func (v *parser_) parseImportedPackage() (
 	v.remove(tokens)
 	importedPackage = ast.ImportedPackageClass().ImportedPackage(
 		name,
-		path,
+		string_,
 	)
 	return
 }
@@ -2734,6 +2941,17 @@ func (v *parser_) parseMethod() (
 ) {
 	var tokens = com.List[TokenLike]()
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, token, ok = v.parseToken(NoteToken)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		optionalNote = "" // Reset this to undefined.
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -2817,6 +3035,7 @@ func (v *parser_) parseMethod() (
 	ok = true
 	v.remove(tokens)
 	method = ast.MethodClass().Method(
+		optionalNote,
 		name,
 		delimiter1,
 		optionalParameterList,
@@ -3053,6 +3272,94 @@ func (v *parser_) parseNone() (
 	return
 }
 
+func (v *parser_) parseOperand() (
+	operand ast.OperandLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a single "iota" Operand.
+	var delimiter string
+	delimiter, token, ok = v.parseDelimiter("iota")
+	if ok {
+		// Found a single "iota" Operand.
+		operand = ast.OperandClass().Operand(delimiter)
+		return
+	}
+
+	// Attempt to parse a single number Operand.
+	var number string
+	number, token, ok = v.parseToken(NumberToken)
+	if ok {
+		// Found a single number Operand.
+		operand = ast.OperandClass().Operand(number)
+		return
+	}
+
+	// Attempt to parse a single string Operand.
+	var string_ string
+	string_, token, ok = v.parseToken(StringToken)
+	if ok {
+		// Found a single string Operand.
+		operand = ast.OperandClass().Operand(string_)
+		return
+	}
+
+	// This is not a single Operand rule.
+	return
+}
+
+func (v *parser_) parseOperation() (
+	operation ast.OperationLike,
+	token TokenLike,
+	ok bool,
+) {
+	var tokens = com.List[TokenLike]()
+
+	// Attempt to parse a single operator token.
+	var operator string
+	operator, token, ok = v.parseToken(OperatorToken)
+	if !ok {
+		if uti.IsDefined(tokens) {
+			// This is not a single operator token.
+			v.putBack(tokens)
+			return
+		} else {
+			// Found a syntax error.
+			var message = v.formatError("$Operation", token)
+			panic(message)
+		}
+	}
+	if uti.IsDefined(tokens) {
+		tokens.AppendValue(token)
+	}
+
+	// Attempt to parse a single Operand rule.
+	var operand ast.OperandLike
+	operand, token, ok = v.parseOperand()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single Operand rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$Operation", token)
+		panic(message)
+	}
+
+	// Found a single Operation rule.
+	ok = true
+	v.remove(tokens)
+	operation = ast.OperationClass().Operation(
+		operator,
+		operand,
+	)
+	return
+}
+
 func (v *parser_) parsePackageDeclaration() (
 	packageDeclaration ast.PackageDeclarationLike,
 	token TokenLike,
@@ -3572,6 +3879,17 @@ func (v *parser_) parseSetterMethod() (
 ) {
 	var tokens = com.List[TokenLike]()
 
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, token, ok = v.parseToken(NoteToken)
+	if ok {
+		if uti.IsDefined(tokens) {
+			tokens.AppendValue(token)
+		}
+	} else {
+		optionalNote = "" // Reset this to undefined.
+	}
+
 	// Attempt to parse a single name token.
 	var name string
 	name, token, ok = v.parseToken(NameToken)
@@ -3647,6 +3965,7 @@ func (v *parser_) parseSetterMethod() (
 	ok = true
 	v.remove(tokens)
 	setterMethod = ast.SetterMethodClass().SetterMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		parameter,
@@ -3655,6 +3974,33 @@ func (v *parser_) parseSetterMethod() (
 	return
 }
 
+func (v *parser_) parseSpecification() (
+	specification ast.SpecificationLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a single None Specification.
+	var none ast.NoneLike
+	none, token, ok = v.parseNone()
+	if ok {
+		// Found a single None Specification.
+		specification = ast.SpecificationClass().Specification(none)
+		return
+	}
+
+	// Attempt to parse a single Assignment Specification.
+	var assignment ast.AssignmentLike
+	assignment, token, ok = v.parseAssignment()
+	if ok {
+		// Found a single Assignment Specification.
+		specification = ast.SpecificationClass().Specification(assignment)
+		return
+	}
+
+	// This is not a single Specification rule.
+	return
+}
+
 func (v *parser_) parseStar() (
 	star ast.StarLike,
 	token TokenLike,
@@ -3878,8 +4224,8 @@ func (v *parser_) parseValue() (
 	}
 
 	// Attempt to parse a single "=" literal.
-	var delimiter1 string
-	delimiter1, token, ok = v.parseDelimiter("=")
+	var delimiter string
+	delimiter, token, ok = v.parseDelimiter("=")
 	if !ok {
 		if uti.IsDefined(tokens) {
 			// This is not a single Value rule.
@@ -3895,22 +4241,21 @@ func (v *parser_) parseValue() (
 		tokens.AppendValue(token)
 	}
 
-	// Attempt to parse a single "iota" literal.
-	var delimiter2 string
-	delimiter2, token, ok = v.parseDelimiter("iota")
-	if !ok {
-		if uti.IsDefined(tokens) {
-			// This is not a single Value rule.
-			v.putBack(tokens)
-			return
-		} else {
-			// Found a syntax error.
-			var message = v.formatError("$Value", token)
-			panic(message)
-		}
-	}
-	if uti.IsDefined(tokens) {
-		tokens.AppendValue(token)
+	// Attempt to parse a single Expression rule.
+	var expression ast.ExpressionLike
+	expression, token, ok = v.parseExpression()
+	switch {
+	case ok:
+		// No additional put backs allowed at this point.
+		tokens = nil
+	case uti.IsDefined(tokens):
+		// This is not a single Expression rule.
+		v.putBack(tokens)
+		return
+	default:
+		// Found a syntax error.
+		var message = v.formatError("$Value", token)
+		panic(message)
 	}
 
 	// Found a single Value rule.
@@ -3919,8 +4264,8 @@ func (v *parser_) parseValue() (
 	value = ast.ValueClass().Value(
 		name,
 		abstraction,
-		delimiter1,
-		delimiter2,
+		delimiter,
+		expression,
 	)
 	return
 }
@@ -4162,7 +4507,7 @@ var parserClassReference_ = &parserClass_{
 			"$PackageHeader":         `comment "package" name`,
 			"$PackageImports":        `"import" "(" ImportList? ")"`,
 			"$ImportList":            `ImportedPackage+`,
-			"$ImportedPackage":       `name path`,
+			"$ImportedPackage":       `name string`,
 			"$TypeSection":           `"// TYPE DECLARATIONS" TypeDeclaration*`,
 			"$TypeDeclaration":       `Declaration Abstraction Enumeration?`,
 			"$Declaration":           `comment "type" name Constraints?`,
@@ -4184,14 +4529,24 @@ var parserClassReference_ = &parserClass_{
 			"$Type": `
     Named
     Functional`,
-			"$Named":                 `prefix? name Arguments?`,
-			"$Functional":            `"func" "(" ParameterList? ")" Result?`,
-			"$Arguments":             `"[" Argument AdditionalArgument* "]"`,
-			"$Argument":              `Abstraction`,
-			"$AdditionalArgument":    `"," Argument`,
-			"$Enumeration":           `"const" "(" Value AdditionalValue* ")"`,
-			"$Value":                 `name Abstraction "=" "iota"`,
-			"$AdditionalValue":       `name`,
+			"$Named":              `prefix? name Arguments?`,
+			"$Functional":         `"func" "(" ParameterList? ")" Result?`,
+			"$Arguments":          `"[" Argument AdditionalArgument* "]"`,
+			"$Argument":           `Abstraction`,
+			"$AdditionalArgument": `"," Argument`,
+			"$Enumeration":        `"const" "(" Value AdditionalValue* ")"`,
+			"$Value":              `name Abstraction "=" Expression`,
+			"$AdditionalValue":    `name Specification`,
+			"$Specification": `
+    None  ! Repeats the type and expression of the previous value.
+    Assignment`,
+			"$Assignment": `Abstraction "=" Expression`,
+			"$Expression": `Operand Operation?`,
+			"$Operation":  `operator Operand`,
+			"$Operand": `
+    "iota"
+    number
+    string`,
 			"$FunctionalSection":     `"// FUNCTIONAL DECLARATIONS" FunctionalDeclaration*`,
 			"$FunctionalDeclaration": `Declaration Functional`,
 			"$ParameterList":         `Parameter+`,
@@ -4206,28 +4561,31 @@ var parserClassReference_ = &parserClass_{
 			"$ClassDeclaration":      `Declaration "interface" "{" ClassMethods "}"`,
 			"$ClassMethods":          `ConstructorSubsection ConstantSubsection? FunctionSubsection?`,
 			"$ConstructorSubsection": `"// Constructor Methods" ConstructorMethod+`,
-			"$ConstructorMethod":     `name "(" ParameterList? ")" Abstraction`,
+			"$ConstructorMethod":     `note? name "(" ParameterList? ")" Abstraction`,
 			"$ConstantSubsection":    `"// Constant Methods" ConstantMethod+`,
-			"$ConstantMethod":        `name "(" ")" Abstraction`,
+			"$ConstantMethod":        `note? name "(" ")" Abstraction`,
 			"$FunctionSubsection":    `"// Function Methods" FunctionMethod+`,
-			"$FunctionMethod":        `name "(" ParameterList? ")" Result`,
+			"$FunctionMethod":        `note? name "(" ParameterList? ")" Result`,
 			"$InstanceSection":       `"// INSTANCE DECLARATIONS" InstanceDeclaration+`,
 			"$InstanceDeclaration":   `Declaration "interface" "{" InstanceMethods "}"`,
 			"$InstanceMethods":       `PrincipalSubsection AttributeSubsection? AspectSubsection?`,
 			"$PrincipalSubsection":   `"// Principal Methods" PrincipalMethod+`,
 			"$PrincipalMethod":       `Method`,
-			"$Method":                `name "(" ParameterList? ")" Result`,
+			"$Method":                `note? name "(" ParameterList? ")" Result`,
 			"$AttributeSubsection":   `"// Attribute Methods" AttributeMethod+`,
 			"$AttributeMethod": `
     GetterMethod
     SetterMethod`,
-			"$GetterMethod":      `name "(" ")" Abstraction`,
-			"$SetterMethod":      `name "(" Parameter ")"`,
+			"$GetterMethod":      `note? name "(" ")" Abstraction`,
+			"$SetterMethod":      `note? name "(" Parameter ")"`,
 			"$AspectSubsection":  `"// Aspect Interfaces" AspectInterface+`,
 			"$AspectInterface":   `Abstraction`,
 			"$AspectSection":     `"// ASPECT DECLARATIONS" AspectDeclaration*`,
-			"$AspectDeclaration": `Declaration "interface" "{" AspectMethod+ "}"`,
-			"$AspectMethod":      `Method`,
+			"$AspectDeclaration": `Declaration "interface" "{" AspectMember+ "}"`,
+			"$AspectMember": `
+    AspectMethod
+    AspectInterface  ! An embedded aspect interface.`,
+			"$AspectMethod": `Method`,
 		},
 	),
 }
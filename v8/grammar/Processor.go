@@ -72,8 +72,18 @@ func (v *processor_) ProcessNewline(
 ) {
 }
 
-func (v *processor_) ProcessPath(
-	path string,
+func (v *processor_) ProcessNote(
+	note string,
+) {
+}
+
+func (v *processor_) ProcessNumber(
+	number string,
+) {
+}
+
+func (v *processor_) ProcessOperator(
+	operator string,
 ) {
 }
 
@@ -87,6 +97,11 @@ func (v *processor_) ProcessSpace(
 ) {
 }
 
+func (v *processor_) ProcessString(
+	string_ string,
+) {
+}
+
 func (v *processor_) PreprocessAbstraction(
 	abstraction ast.AbstractionLike,
 	index_ uint,
@@ -267,6 +282,26 @@ func (v *processor_) ProcessAspectInterfaceSlot(
 ) {
 }
 
+func (v *processor_) PreprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) PostprocessAspectMember(
+	aspectMember ast.AspectMemberLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) ProcessAspectMemberSlot(
+	aspectMember ast.AspectMemberLike,
+	slot_ uint,
+) {
+}
+
 func (v *processor_) PreprocessAspectMethod(
 	aspectMethod ast.AspectMethodLike,
 	index_ uint,
@@ -327,6 +362,26 @@ func (v *processor_) ProcessAspectSubsectionSlot(
 ) {
 }
 
+func (v *processor_) PreprocessAssignment(
+	assignment ast.AssignmentLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) PostprocessAssignment(
+	assignment ast.AssignmentLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) ProcessAssignmentSlot(
+	assignment ast.AssignmentLike,
+	slot_ uint,
+) {
+}
+
 func (v *processor_) PreprocessAttributeMethod(
 	attributeMethod ast.AttributeMethodLike,
 	index_ uint,
@@ -627,6 +682,26 @@ func (v *processor_) ProcessEnumerationSlot(
 ) {
 }
 
+func (v *processor_) PreprocessExpression(
+	expression ast.ExpressionLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) PostprocessExpression(
+	expression ast.ExpressionLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) ProcessExpressionSlot(
+	expression ast.ExpressionLike,
+	slot_ uint,
+) {
+}
+
 func (v *processor_) PreprocessFunctionMethod(
 	functionMethod ast.FunctionMethodLike,
 	index_ uint,
@@ -1007,6 +1082,46 @@ func (v *processor_) ProcessNoneSlot(
 ) {
 }
 
+func (v *processor_) PreprocessOperand(
+	operand ast.OperandLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) PostprocessOperand(
+	operand ast.OperandLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) ProcessOperandSlot(
+	operand ast.OperandLike,
+	slot_ uint,
+) {
+}
+
+func (v *processor_) PreprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) PostprocessOperation(
+	operation ast.OperationLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) ProcessOperationSlot(
+	operation ast.OperationLike,
+	slot_ uint,
+) {
+}
+
 func (v *processor_) PreprocessPackageDeclaration(
 	packageDeclaration ast.PackageDeclarationLike,
 	index_ uint,
@@ -1207,6 +1322,26 @@ func (v *processor_) ProcessSetterMethodSlot(
 ) {
 }
 
+func (v *processor_) PreprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) PostprocessSpecification(
+	specification ast.SpecificationLike,
+	index_ uint,
+	count_ uint,
+) {
+}
+
+func (v *processor_) ProcessSpecificationSlot(
+	specification ast.SpecificationLike,
+	slot_ uint,
+) {
+}
+
 func (v *processor_) PreprocessStar(
 	star ast.StarLike,
 	index_ uint,
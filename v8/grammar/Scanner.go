@@ -203,12 +203,15 @@ loop:
 		switch {
 		// Find the next token type.
 		case v.foundToken(CommentToken):
-		case v.foundToken(PathToken):
+		case v.foundToken(StringToken):
 		case v.foundToken(PrefixToken):
 		case v.foundToken(NameToken):
+		case v.foundToken(NumberToken): // Must precede any "-" operators.
+		case v.foundToken(OperatorToken):
 		case v.foundToken(SpaceToken):
 		case v.foundToken(NewlineToken):
 		case v.foundToken(DelimiterToken):
+		case v.foundToken(NoteToken): // Must follow the "// ..." delimiters.
 		default:
 			v.foundError()
 			break loop
@@ -253,9 +256,12 @@ var scannerClassReference_ = &scannerClass_{
 			DelimiterToken: "delimiter",
 			NameToken:      "name",
 			NewlineToken:   "newline",
-			PathToken:      "path",
+			NoteToken:      "note",
+			NumberToken:    "number",
+			OperatorToken:  "operator",
 			PrefixToken:    "prefix",
 			SpaceToken:     "space",
+			StringToken:    "string",
 		},
 	),
 	matchers_: com.CatalogFromMap[TokenType, *reg.Regexp](
@@ -265,9 +271,12 @@ var scannerClassReference_ = &scannerClass_{
 			DelimiterToken: reg.MustCompile("^" + delimiter_),
 			NameToken:      reg.MustCompile("^" + name_),
 			NewlineToken:   reg.MustCompile("^" + newline_),
-			PathToken:      reg.MustCompile("^" + path_),
+			NoteToken:      reg.MustCompile("^" + note_),
+			NumberToken:    reg.MustCompile("^" + number_),
+			OperatorToken:  reg.MustCompile("^" + operator_),
 			PrefixToken:    reg.MustCompile("^" + prefix_),
 			SpaceToken:     reg.MustCompile("^" + space_),
+			StringToken:    reg.MustCompile("^" + string_),
 		},
 	),
 }
@@ -297,7 +306,10 @@ const (
 	delimiter_    = "(?:type|package|map|iota|interface|import|func|const|chan|\\}|\\{|\\]|\\[|\\.\\.\\.|\\*|\\)|\\(|=|// TYPE DECLARATIONS|// Principal Methods|// INSTANCE DECLARATIONS|// Function Methods|// FUNCTIONAL DECLARATIONS|// Constructor Methods|// Constant Methods|// CLASS DECLARATIONS|// Attribute Methods|// Aspect Interfaces|// ASPECT DECLARATIONS|,)"
 	name_         = "(?:(?:" + character_ + ")(?:" + alphanumeric_ + ")*_?)"
 	newline_      = "(?:" + eol_ + ")"
-	path_         = "(?:\"[^" + control_ + "]*\")"
+	note_         = "(?://" + any_ + "*(?:" + eol_ + "[ \\t]*//" + any_ + "*)*)"
+	number_       = "(?:-?(?:" + digit_ + ")+(?:\\.(?:" + digit_ + ")+)?)"
+	operator_     = "(?:<<|\\+|-)"
 	prefix_       = "(?:(?:" + character_ + ")(?:" + alphanumeric_ + "){2}\\.)"
 	space_        = "(?:[ \\t]+)"
+	string_       = "(?:\"(?:[^\"\\\\" + control_ + "]|\\\\.)*\")"
 )
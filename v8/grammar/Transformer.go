@@ -0,0 +1,1128 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package grammar
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func TransformerClass() TransformerClassLike {
+	return transformerClass()
+}
+
+// Constructor Methods
+
+func (c *transformerClass_) Transformer(
+	transformation TransformationFunction,
+) TransformerLike {
+	if uti.IsUndefined(transformation) {
+		panic("The \"transformation\" attribute is required by this class.")
+	}
+	var instance = &transformer_{
+		// Initialize the instance attributes.
+		transformation_: transformation,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *transformer_) GetClass() TransformerClassLike {
+	return transformerClass()
+}
+
+func (v *transformer_) TransformModel(
+	model ast.ModelLike,
+) ast.ModelLike {
+	return v.transformModel(model)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *transformer_) transformAbstraction(
+	abstraction ast.AbstractionLike,
+) ast.AbstractionLike {
+	var optionalWrapper = abstraction.GetOptionalWrapper()
+	if uti.IsDefined(optionalWrapper) {
+		optionalWrapper = v.transformWrapper(optionalWrapper)
+	}
+	var type_ = v.transformType(abstraction.GetType())
+	abstraction = ast.AbstractionClass().Abstraction(
+		optionalWrapper,
+		type_,
+	)
+	return v.transformation_(abstraction, "Abstraction").(ast.AbstractionLike)
+}
+
+func (v *transformer_) transformAdditionalArgument(
+	additionalArgument ast.AdditionalArgumentLike,
+) ast.AdditionalArgumentLike {
+	var delimiter = additionalArgument.GetDelimiter()
+	var argument = v.transformArgument(additionalArgument.GetArgument())
+	additionalArgument = ast.AdditionalArgumentClass().AdditionalArgument(
+		delimiter,
+		argument,
+	)
+	return v.transformation_(additionalArgument, "AdditionalArgument").(ast.AdditionalArgumentLike)
+}
+
+func (v *transformer_) transformAdditionalConstraint(
+	additionalConstraint ast.AdditionalConstraintLike,
+) ast.AdditionalConstraintLike {
+	var delimiter = additionalConstraint.GetDelimiter()
+	var constraint = v.transformConstraint(additionalConstraint.GetConstraint())
+	additionalConstraint = ast.AdditionalConstraintClass().AdditionalConstraint(
+		delimiter,
+		constraint,
+	)
+	return v.transformation_(additionalConstraint, "AdditionalConstraint").(ast.AdditionalConstraintLike)
+}
+
+func (v *transformer_) transformAdditionalValue(
+	additionalValue ast.AdditionalValueLike,
+) ast.AdditionalValueLike {
+	var name = additionalValue.GetName()
+	var specification = v.transformSpecification(additionalValue.GetSpecification())
+	additionalValue = ast.AdditionalValueClass().AdditionalValue(
+		name,
+		specification,
+	)
+	return v.transformation_(additionalValue, "AdditionalValue").(ast.AdditionalValueLike)
+}
+
+func (v *transformer_) transformArgument(
+	argument ast.ArgumentLike,
+) ast.ArgumentLike {
+	var abstraction = v.transformAbstraction(argument.GetAbstraction())
+	argument = ast.ArgumentClass().Argument(abstraction)
+	return v.transformation_(argument, "Argument").(ast.ArgumentLike)
+}
+
+func (v *transformer_) transformArguments(
+	arguments ast.ArgumentsLike,
+) ast.ArgumentsLike {
+	var delimiter1 = arguments.GetDelimiter1()
+	var argument = v.transformArgument(arguments.GetArgument())
+	var additionalArguments = com.List[ast.AdditionalArgumentLike]()
+	var additionalArgumentsIterator = arguments.GetAdditionalArguments().GetIterator()
+	for additionalArgumentsIterator.HasNext() {
+		var rule = additionalArgumentsIterator.GetNext()
+		additionalArguments.AppendValue(v.transformAdditionalArgument(rule))
+	}
+	var delimiter2 = arguments.GetDelimiter2()
+	arguments = ast.ArgumentsClass().Arguments(
+		delimiter1,
+		argument,
+		additionalArguments,
+		delimiter2,
+	)
+	return v.transformation_(arguments, "Arguments").(ast.ArgumentsLike)
+}
+
+func (v *transformer_) transformArray(
+	array ast.ArrayLike,
+) ast.ArrayLike {
+	var delimiter1 = array.GetDelimiter1()
+	var delimiter2 = array.GetDelimiter2()
+	array = ast.ArrayClass().Array(
+		delimiter1,
+		delimiter2,
+	)
+	return v.transformation_(array, "Array").(ast.ArrayLike)
+}
+
+func (v *transformer_) transformAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+) ast.AspectDeclarationLike {
+	var declaration = v.transformDeclaration(aspectDeclaration.GetDeclaration())
+	var delimiter1 = aspectDeclaration.GetDelimiter1()
+	var delimiter2 = aspectDeclaration.GetDelimiter2()
+	var aspectMembers = com.List[ast.AspectMemberLike]()
+	var aspectMembersIterator = aspectDeclaration.GetAspectMembers().GetIterator()
+	for aspectMembersIterator.HasNext() {
+		var rule = aspectMembersIterator.GetNext()
+		aspectMembers.AppendValue(v.transformAspectMember(rule))
+	}
+	var delimiter3 = aspectDeclaration.GetDelimiter3()
+	aspectDeclaration = ast.AspectDeclarationClass().AspectDeclaration(
+		declaration,
+		delimiter1,
+		delimiter2,
+		aspectMembers,
+		delimiter3,
+	)
+	return v.transformation_(aspectDeclaration, "AspectDeclaration").(ast.AspectDeclarationLike)
+}
+
+func (v *transformer_) transformAspectInterface(
+	aspectInterface ast.AspectInterfaceLike,
+) ast.AspectInterfaceLike {
+	var abstraction = v.transformAbstraction(aspectInterface.GetAbstraction())
+	aspectInterface = ast.AspectInterfaceClass().AspectInterface(abstraction)
+	return v.transformation_(aspectInterface, "AspectInterface").(ast.AspectInterfaceLike)
+}
+
+func (v *transformer_) transformAspectMember(
+	aspectMember ast.AspectMemberLike,
+) ast.AspectMemberLike {
+	var any_ = aspectMember.GetAny()
+	switch actual := any_.(type) {
+	case ast.AspectMethodLike:
+		any_ = v.transformAspectMethod(actual)
+	case ast.AspectInterfaceLike:
+		any_ = v.transformAspectInterface(actual)
+	}
+	aspectMember = ast.AspectMemberClass().AspectMember(any_)
+	return v.transformation_(aspectMember, "AspectMember").(ast.AspectMemberLike)
+}
+
+func (v *transformer_) transformAspectMethod(
+	aspectMethod ast.AspectMethodLike,
+) ast.AspectMethodLike {
+	var method = v.transformMethod(aspectMethod.GetMethod())
+	aspectMethod = ast.AspectMethodClass().AspectMethod(method)
+	return v.transformation_(aspectMethod, "AspectMethod").(ast.AspectMethodLike)
+}
+
+func (v *transformer_) transformAspectSection(
+	aspectSection ast.AspectSectionLike,
+) ast.AspectSectionLike {
+	var delimiter = aspectSection.GetDelimiter()
+	var aspectDeclarations = com.List[ast.AspectDeclarationLike]()
+	var aspectDeclarationsIterator = aspectSection.GetAspectDeclarations().GetIterator()
+	for aspectDeclarationsIterator.HasNext() {
+		var rule = aspectDeclarationsIterator.GetNext()
+		aspectDeclarations.AppendValue(v.transformAspectDeclaration(rule))
+	}
+	aspectSection = ast.AspectSectionClass().AspectSection(
+		delimiter,
+		aspectDeclarations,
+	)
+	return v.transformation_(aspectSection, "AspectSection").(ast.AspectSectionLike)
+}
+
+func (v *transformer_) transformAspectSubsection(
+	aspectSubsection ast.AspectSubsectionLike,
+) ast.AspectSubsectionLike {
+	var delimiter = aspectSubsection.GetDelimiter()
+	var aspectInterfaces = com.List[ast.AspectInterfaceLike]()
+	var aspectInterfacesIterator = aspectSubsection.GetAspectInterfaces().GetIterator()
+	for aspectInterfacesIterator.HasNext() {
+		var rule = aspectInterfacesIterator.GetNext()
+		aspectInterfaces.AppendValue(v.transformAspectInterface(rule))
+	}
+	aspectSubsection = ast.AspectSubsectionClass().AspectSubsection(
+		delimiter,
+		aspectInterfaces,
+	)
+	return v.transformation_(aspectSubsection, "AspectSubsection").(ast.AspectSubsectionLike)
+}
+
+func (v *transformer_) transformAssignment(
+	assignment ast.AssignmentLike,
+) ast.AssignmentLike {
+	var abstraction = v.transformAbstraction(assignment.GetAbstraction())
+	var delimiter = assignment.GetDelimiter()
+	var expression = v.transformExpression(assignment.GetExpression())
+	assignment = ast.AssignmentClass().Assignment(
+		abstraction,
+		delimiter,
+		expression,
+	)
+	return v.transformation_(assignment, "Assignment").(ast.AssignmentLike)
+}
+
+func (v *transformer_) transformAttributeMethod(
+	attributeMethod ast.AttributeMethodLike,
+) ast.AttributeMethodLike {
+	var any_ = attributeMethod.GetAny()
+	switch actual := any_.(type) {
+	case ast.GetterMethodLike:
+		any_ = v.transformGetterMethod(actual)
+	case ast.SetterMethodLike:
+		any_ = v.transformSetterMethod(actual)
+	}
+	attributeMethod = ast.AttributeMethodClass().AttributeMethod(any_)
+	return v.transformation_(attributeMethod, "AttributeMethod").(ast.AttributeMethodLike)
+}
+
+func (v *transformer_) transformAttributeSubsection(
+	attributeSubsection ast.AttributeSubsectionLike,
+) ast.AttributeSubsectionLike {
+	var delimiter = attributeSubsection.GetDelimiter()
+	var attributeMethods = com.List[ast.AttributeMethodLike]()
+	var attributeMethodsIterator = attributeSubsection.GetAttributeMethods().GetIterator()
+	for attributeMethodsIterator.HasNext() {
+		var rule = attributeMethodsIterator.GetNext()
+		attributeMethods.AppendValue(v.transformAttributeMethod(rule))
+	}
+	attributeSubsection = ast.AttributeSubsectionClass().AttributeSubsection(
+		delimiter,
+		attributeMethods,
+	)
+	return v.transformation_(attributeSubsection, "AttributeSubsection").(ast.AttributeSubsectionLike)
+}
+
+func (v *transformer_) transformChannel(
+	channel ast.ChannelLike,
+) ast.ChannelLike {
+	var delimiter = channel.GetDelimiter()
+	channel = ast.ChannelClass().Channel(delimiter)
+	return v.transformation_(channel, "Channel").(ast.ChannelLike)
+}
+
+func (v *transformer_) transformClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+) ast.ClassDeclarationLike {
+	var declaration = v.transformDeclaration(classDeclaration.GetDeclaration())
+	var delimiter1 = classDeclaration.GetDelimiter1()
+	var delimiter2 = classDeclaration.GetDelimiter2()
+	var classMethods = v.transformClassMethods(classDeclaration.GetClassMethods())
+	var delimiter3 = classDeclaration.GetDelimiter3()
+	classDeclaration = ast.ClassDeclarationClass().ClassDeclaration(
+		declaration,
+		delimiter1,
+		delimiter2,
+		classMethods,
+		delimiter3,
+	)
+	return v.transformation_(classDeclaration, "ClassDeclaration").(ast.ClassDeclarationLike)
+}
+
+func (v *transformer_) transformClassMethods(
+	classMethods ast.ClassMethodsLike,
+) ast.ClassMethodsLike {
+	var constructorSubsection = v.transformConstructorSubsection(classMethods.GetConstructorSubsection())
+	var optionalConstantSubsection = classMethods.GetOptionalConstantSubsection()
+	if uti.IsDefined(optionalConstantSubsection) {
+		optionalConstantSubsection = v.transformConstantSubsection(optionalConstantSubsection)
+	}
+	var optionalFunctionSubsection = classMethods.GetOptionalFunctionSubsection()
+	if uti.IsDefined(optionalFunctionSubsection) {
+		optionalFunctionSubsection = v.transformFunctionSubsection(optionalFunctionSubsection)
+	}
+	classMethods = ast.ClassMethodsClass().ClassMethods(
+		constructorSubsection,
+		optionalConstantSubsection,
+		optionalFunctionSubsection,
+	)
+	return v.transformation_(classMethods, "ClassMethods").(ast.ClassMethodsLike)
+}
+
+func (v *transformer_) transformClassSection(
+	classSection ast.ClassSectionLike,
+) ast.ClassSectionLike {
+	var delimiter = classSection.GetDelimiter()
+	var classDeclarations = com.List[ast.ClassDeclarationLike]()
+	var classDeclarationsIterator = classSection.GetClassDeclarations().GetIterator()
+	for classDeclarationsIterator.HasNext() {
+		var rule = classDeclarationsIterator.GetNext()
+		classDeclarations.AppendValue(v.transformClassDeclaration(rule))
+	}
+	classSection = ast.ClassSectionClass().ClassSection(
+		delimiter,
+		classDeclarations,
+	)
+	return v.transformation_(classSection, "ClassSection").(ast.ClassSectionLike)
+}
+
+func (v *transformer_) transformConstantMethod(
+	constantMethod ast.ConstantMethodLike,
+) ast.ConstantMethodLike {
+	var optionalNote = constantMethod.GetOptionalNote()
+	var name = constantMethod.GetName()
+	var delimiter1 = constantMethod.GetDelimiter1()
+	var delimiter2 = constantMethod.GetDelimiter2()
+	var abstraction = v.transformAbstraction(constantMethod.GetAbstraction())
+	constantMethod = ast.ConstantMethodClass().ConstantMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		delimiter2,
+		abstraction,
+	)
+	return v.transformation_(constantMethod, "ConstantMethod").(ast.ConstantMethodLike)
+}
+
+func (v *transformer_) transformConstantSubsection(
+	constantSubsection ast.ConstantSubsectionLike,
+) ast.ConstantSubsectionLike {
+	var delimiter = constantSubsection.GetDelimiter()
+	var constantMethods = com.List[ast.ConstantMethodLike]()
+	var constantMethodsIterator = constantSubsection.GetConstantMethods().GetIterator()
+	for constantMethodsIterator.HasNext() {
+		var rule = constantMethodsIterator.GetNext()
+		constantMethods.AppendValue(v.transformConstantMethod(rule))
+	}
+	constantSubsection = ast.ConstantSubsectionClass().ConstantSubsection(
+		delimiter,
+		constantMethods,
+	)
+	return v.transformation_(constantSubsection, "ConstantSubsection").(ast.ConstantSubsectionLike)
+}
+
+func (v *transformer_) transformConstraint(
+	constraint ast.ConstraintLike,
+) ast.ConstraintLike {
+	var name = constraint.GetName()
+	var abstraction = v.transformAbstraction(constraint.GetAbstraction())
+	constraint = ast.ConstraintClass().Constraint(
+		name,
+		abstraction,
+	)
+	return v.transformation_(constraint, "Constraint").(ast.ConstraintLike)
+}
+
+func (v *transformer_) transformConstraints(
+	constraints ast.ConstraintsLike,
+) ast.ConstraintsLike {
+	var delimiter1 = constraints.GetDelimiter1()
+	var constraint = v.transformConstraint(constraints.GetConstraint())
+	var additionalConstraints = com.List[ast.AdditionalConstraintLike]()
+	var additionalConstraintsIterator = constraints.GetAdditionalConstraints().GetIterator()
+	for additionalConstraintsIterator.HasNext() {
+		var rule = additionalConstraintsIterator.GetNext()
+		additionalConstraints.AppendValue(v.transformAdditionalConstraint(rule))
+	}
+	var delimiter2 = constraints.GetDelimiter2()
+	constraints = ast.ConstraintsClass().Constraints(
+		delimiter1,
+		constraint,
+		additionalConstraints,
+		delimiter2,
+	)
+	return v.transformation_(constraints, "Constraints").(ast.ConstraintsLike)
+}
+
+func (v *transformer_) transformConstructorMethod(
+	constructorMethod ast.ConstructorMethodLike,
+) ast.ConstructorMethodLike {
+	var optionalNote = constructorMethod.GetOptionalNote()
+	var name = constructorMethod.GetName()
+	var delimiter1 = constructorMethod.GetDelimiter1()
+	var optionalParameterList = constructorMethod.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		optionalParameterList = v.transformParameterList(optionalParameterList)
+	}
+	var delimiter2 = constructorMethod.GetDelimiter2()
+	var abstraction = v.transformAbstraction(constructorMethod.GetAbstraction())
+	constructorMethod = ast.ConstructorMethodClass().ConstructorMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		optionalParameterList,
+		delimiter2,
+		abstraction,
+	)
+	return v.transformation_(constructorMethod, "ConstructorMethod").(ast.ConstructorMethodLike)
+}
+
+func (v *transformer_) transformConstructorSubsection(
+	constructorSubsection ast.ConstructorSubsectionLike,
+) ast.ConstructorSubsectionLike {
+	var delimiter = constructorSubsection.GetDelimiter()
+	var constructorMethods = com.List[ast.ConstructorMethodLike]()
+	var constructorMethodsIterator = constructorSubsection.GetConstructorMethods().GetIterator()
+	for constructorMethodsIterator.HasNext() {
+		var rule = constructorMethodsIterator.GetNext()
+		constructorMethods.AppendValue(v.transformConstructorMethod(rule))
+	}
+	constructorSubsection = ast.ConstructorSubsectionClass().ConstructorSubsection(
+		delimiter,
+		constructorMethods,
+	)
+	return v.transformation_(constructorSubsection, "ConstructorSubsection").(ast.ConstructorSubsectionLike)
+}
+
+func (v *transformer_) transformDeclaration(
+	declaration ast.DeclarationLike,
+) ast.DeclarationLike {
+	var comment = declaration.GetComment()
+	var delimiter = declaration.GetDelimiter()
+	var name = declaration.GetName()
+	var optionalConstraints = declaration.GetOptionalConstraints()
+	if uti.IsDefined(optionalConstraints) {
+		optionalConstraints = v.transformConstraints(optionalConstraints)
+	}
+	declaration = ast.DeclarationClass().Declaration(
+		comment,
+		delimiter,
+		name,
+		optionalConstraints,
+	)
+	return v.transformation_(declaration, "Declaration").(ast.DeclarationLike)
+}
+
+func (v *transformer_) transformDots(
+	dots ast.DotsLike,
+) ast.DotsLike {
+	var delimiter = dots.GetDelimiter()
+	dots = ast.DotsClass().Dots(delimiter)
+	return v.transformation_(dots, "Dots").(ast.DotsLike)
+}
+
+func (v *transformer_) transformEnumeration(
+	enumeration ast.EnumerationLike,
+) ast.EnumerationLike {
+	var delimiter1 = enumeration.GetDelimiter1()
+	var delimiter2 = enumeration.GetDelimiter2()
+	var value = v.transformValue(enumeration.GetValue())
+	var additionalValues = com.List[ast.AdditionalValueLike]()
+	var additionalValuesIterator = enumeration.GetAdditionalValues().GetIterator()
+	for additionalValuesIterator.HasNext() {
+		var rule = additionalValuesIterator.GetNext()
+		additionalValues.AppendValue(v.transformAdditionalValue(rule))
+	}
+	var delimiter3 = enumeration.GetDelimiter3()
+	enumeration = ast.EnumerationClass().Enumeration(
+		delimiter1,
+		delimiter2,
+		value,
+		additionalValues,
+		delimiter3,
+	)
+	return v.transformation_(enumeration, "Enumeration").(ast.EnumerationLike)
+}
+
+func (v *transformer_) transformExpression(
+	expression ast.ExpressionLike,
+) ast.ExpressionLike {
+	var operand = v.transformOperand(expression.GetOperand())
+	var optionalOperation = expression.GetOptionalOperation()
+	if uti.IsDefined(optionalOperation) {
+		optionalOperation = v.transformOperation(optionalOperation)
+	}
+	expression = ast.ExpressionClass().Expression(
+		operand,
+		optionalOperation,
+	)
+	return v.transformation_(expression, "Expression").(ast.ExpressionLike)
+}
+
+func (v *transformer_) transformFunctionMethod(
+	functionMethod ast.FunctionMethodLike,
+) ast.FunctionMethodLike {
+	var optionalNote = functionMethod.GetOptionalNote()
+	var name = functionMethod.GetName()
+	var delimiter1 = functionMethod.GetDelimiter1()
+	var optionalParameterList = functionMethod.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		optionalParameterList = v.transformParameterList(optionalParameterList)
+	}
+	var delimiter2 = functionMethod.GetDelimiter2()
+	var result = v.transformResult(functionMethod.GetResult())
+	functionMethod = ast.FunctionMethodClass().FunctionMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		optionalParameterList,
+		delimiter2,
+		result,
+	)
+	return v.transformation_(functionMethod, "FunctionMethod").(ast.FunctionMethodLike)
+}
+
+func (v *transformer_) transformFunctionSubsection(
+	functionSubsection ast.FunctionSubsectionLike,
+) ast.FunctionSubsectionLike {
+	var delimiter = functionSubsection.GetDelimiter()
+	var functionMethods = com.List[ast.FunctionMethodLike]()
+	var functionMethodsIterator = functionSubsection.GetFunctionMethods().GetIterator()
+	for functionMethodsIterator.HasNext() {
+		var rule = functionMethodsIterator.GetNext()
+		functionMethods.AppendValue(v.transformFunctionMethod(rule))
+	}
+	functionSubsection = ast.FunctionSubsectionClass().FunctionSubsection(
+		delimiter,
+		functionMethods,
+	)
+	return v.transformation_(functionSubsection, "FunctionSubsection").(ast.FunctionSubsectionLike)
+}
+
+func (v *transformer_) transformFunctional(
+	functional ast.FunctionalLike,
+) ast.FunctionalLike {
+	var delimiter1 = functional.GetDelimiter1()
+	var delimiter2 = functional.GetDelimiter2()
+	var optionalParameterList = functional.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		optionalParameterList = v.transformParameterList(optionalParameterList)
+	}
+	var delimiter3 = functional.GetDelimiter3()
+	var optionalResult = functional.GetOptionalResult()
+	if uti.IsDefined(optionalResult) {
+		optionalResult = v.transformResult(optionalResult)
+	}
+	functional = ast.FunctionalClass().Functional(
+		delimiter1,
+		delimiter2,
+		optionalParameterList,
+		delimiter3,
+		optionalResult,
+	)
+	return v.transformation_(functional, "Functional").(ast.FunctionalLike)
+}
+
+func (v *transformer_) transformFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+) ast.FunctionalDeclarationLike {
+	var declaration = v.transformDeclaration(functionalDeclaration.GetDeclaration())
+	var functional = v.transformFunctional(functionalDeclaration.GetFunctional())
+	functionalDeclaration = ast.FunctionalDeclarationClass().FunctionalDeclaration(
+		declaration,
+		functional,
+	)
+	return v.transformation_(functionalDeclaration, "FunctionalDeclaration").(ast.FunctionalDeclarationLike)
+}
+
+func (v *transformer_) transformFunctionalSection(
+	functionalSection ast.FunctionalSectionLike,
+) ast.FunctionalSectionLike {
+	var delimiter = functionalSection.GetDelimiter()
+	var functionalDeclarations = com.List[ast.FunctionalDeclarationLike]()
+	var functionalDeclarationsIterator = functionalSection.GetFunctionalDeclarations().GetIterator()
+	for functionalDeclarationsIterator.HasNext() {
+		var rule = functionalDeclarationsIterator.GetNext()
+		functionalDeclarations.AppendValue(v.transformFunctionalDeclaration(rule))
+	}
+	functionalSection = ast.FunctionalSectionClass().FunctionalSection(
+		delimiter,
+		functionalDeclarations,
+	)
+	return v.transformation_(functionalSection, "FunctionalSection").(ast.FunctionalSectionLike)
+}
+
+func (v *transformer_) transformGetterMethod(
+	getterMethod ast.GetterMethodLike,
+) ast.GetterMethodLike {
+	var optionalNote = getterMethod.GetOptionalNote()
+	var name = getterMethod.GetName()
+	var delimiter1 = getterMethod.GetDelimiter1()
+	var delimiter2 = getterMethod.GetDelimiter2()
+	var abstraction = v.transformAbstraction(getterMethod.GetAbstraction())
+	getterMethod = ast.GetterMethodClass().GetterMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		delimiter2,
+		abstraction,
+	)
+	return v.transformation_(getterMethod, "GetterMethod").(ast.GetterMethodLike)
+}
+
+func (v *transformer_) transformImportList(
+	importList ast.ImportListLike,
+) ast.ImportListLike {
+	var importedPackages = com.List[ast.ImportedPackageLike]()
+	var importedPackagesIterator = importList.GetImportedPackages().GetIterator()
+	for importedPackagesIterator.HasNext() {
+		var rule = importedPackagesIterator.GetNext()
+		importedPackages.AppendValue(v.transformImportedPackage(rule))
+	}
+	importList = ast.ImportListClass().ImportList(importedPackages)
+	return v.transformation_(importList, "ImportList").(ast.ImportListLike)
+}
+
+func (v *transformer_) transformImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+) ast.ImportedPackageLike {
+	var name = importedPackage.GetName()
+	var string_ = importedPackage.GetString()
+	importedPackage = ast.ImportedPackageClass().ImportedPackage(
+		name,
+		string_,
+	)
+	return v.transformation_(importedPackage, "ImportedPackage").(ast.ImportedPackageLike)
+}
+
+func (v *transformer_) transformInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+) ast.InstanceDeclarationLike {
+	var declaration = v.transformDeclaration(instanceDeclaration.GetDeclaration())
+	var delimiter1 = instanceDeclaration.GetDelimiter1()
+	var delimiter2 = instanceDeclaration.GetDelimiter2()
+	var instanceMethods = v.transformInstanceMethods(instanceDeclaration.GetInstanceMethods())
+	var delimiter3 = instanceDeclaration.GetDelimiter3()
+	instanceDeclaration = ast.InstanceDeclarationClass().InstanceDeclaration(
+		declaration,
+		delimiter1,
+		delimiter2,
+		instanceMethods,
+		delimiter3,
+	)
+	return v.transformation_(instanceDeclaration, "InstanceDeclaration").(ast.InstanceDeclarationLike)
+}
+
+func (v *transformer_) transformInstanceMethods(
+	instanceMethods ast.InstanceMethodsLike,
+) ast.InstanceMethodsLike {
+	var principalSubsection = v.transformPrincipalSubsection(instanceMethods.GetPrincipalSubsection())
+	var optionalAttributeSubsection = instanceMethods.GetOptionalAttributeSubsection()
+	if uti.IsDefined(optionalAttributeSubsection) {
+		optionalAttributeSubsection = v.transformAttributeSubsection(optionalAttributeSubsection)
+	}
+	var optionalAspectSubsection = instanceMethods.GetOptionalAspectSubsection()
+	if uti.IsDefined(optionalAspectSubsection) {
+		optionalAspectSubsection = v.transformAspectSubsection(optionalAspectSubsection)
+	}
+	instanceMethods = ast.InstanceMethodsClass().InstanceMethods(
+		principalSubsection,
+		optionalAttributeSubsection,
+		optionalAspectSubsection,
+	)
+	return v.transformation_(instanceMethods, "InstanceMethods").(ast.InstanceMethodsLike)
+}
+
+func (v *transformer_) transformInstanceSection(
+	instanceSection ast.InstanceSectionLike,
+) ast.InstanceSectionLike {
+	var delimiter = instanceSection.GetDelimiter()
+	var instanceDeclarations = com.List[ast.InstanceDeclarationLike]()
+	var instanceDeclarationsIterator = instanceSection.GetInstanceDeclarations().GetIterator()
+	for instanceDeclarationsIterator.HasNext() {
+		var rule = instanceDeclarationsIterator.GetNext()
+		instanceDeclarations.AppendValue(v.transformInstanceDeclaration(rule))
+	}
+	instanceSection = ast.InstanceSectionClass().InstanceSection(
+		delimiter,
+		instanceDeclarations,
+	)
+	return v.transformation_(instanceSection, "InstanceSection").(ast.InstanceSectionLike)
+}
+
+func (v *transformer_) transformInterfaceDeclarations(
+	interfaceDeclarations ast.InterfaceDeclarationsLike,
+) ast.InterfaceDeclarationsLike {
+	var classSection = v.transformClassSection(interfaceDeclarations.GetClassSection())
+	var instanceSection = v.transformInstanceSection(interfaceDeclarations.GetInstanceSection())
+	var aspectSection = v.transformAspectSection(interfaceDeclarations.GetAspectSection())
+	interfaceDeclarations = ast.InterfaceDeclarationsClass().InterfaceDeclarations(
+		classSection,
+		instanceSection,
+		aspectSection,
+	)
+	return v.transformation_(interfaceDeclarations, "InterfaceDeclarations").(ast.InterfaceDeclarationsLike)
+}
+
+func (v *transformer_) transformLegalNotice(
+	legalNotice ast.LegalNoticeLike,
+) ast.LegalNoticeLike {
+	var comment = legalNotice.GetComment()
+	legalNotice = ast.LegalNoticeClass().LegalNotice(comment)
+	return v.transformation_(legalNotice, "LegalNotice").(ast.LegalNoticeLike)
+}
+
+func (v *transformer_) transformMap(
+	map_ ast.MapLike,
+) ast.MapLike {
+	var delimiter1 = map_.GetDelimiter1()
+	var delimiter2 = map_.GetDelimiter2()
+	var name = map_.GetName()
+	var delimiter3 = map_.GetDelimiter3()
+	map_ = ast.MapClass().Map(
+		delimiter1,
+		delimiter2,
+		name,
+		delimiter3,
+	)
+	return v.transformation_(map_, "Map").(ast.MapLike)
+}
+
+func (v *transformer_) transformMethod(
+	method ast.MethodLike,
+) ast.MethodLike {
+	var optionalNote = method.GetOptionalNote()
+	var name = method.GetName()
+	var delimiter1 = method.GetDelimiter1()
+	var optionalParameterList = method.GetOptionalParameterList()
+	if uti.IsDefined(optionalParameterList) {
+		optionalParameterList = v.transformParameterList(optionalParameterList)
+	}
+	var delimiter2 = method.GetDelimiter2()
+	var result = v.transformResult(method.GetResult())
+	method = ast.MethodClass().Method(
+		optionalNote,
+		name,
+		delimiter1,
+		optionalParameterList,
+		delimiter2,
+		result,
+	)
+	return v.transformation_(method, "Method").(ast.MethodLike)
+}
+
+func (v *transformer_) transformModel(
+	model ast.ModelLike,
+) ast.ModelLike {
+	var packageDeclaration = v.transformPackageDeclaration(model.GetPackageDeclaration())
+	var primitiveDeclarations = v.transformPrimitiveDeclarations(model.GetPrimitiveDeclarations())
+	var interfaceDeclarations = v.transformInterfaceDeclarations(model.GetInterfaceDeclarations())
+	model = ast.ModelClass().Model(
+		packageDeclaration,
+		primitiveDeclarations,
+		interfaceDeclarations,
+	)
+	return v.transformation_(model, "Model").(ast.ModelLike)
+}
+
+func (v *transformer_) transformMultivalue(
+	multivalue ast.MultivalueLike,
+) ast.MultivalueLike {
+	var delimiter1 = multivalue.GetDelimiter1()
+	var parameterList = v.transformParameterList(multivalue.GetParameterList())
+	var delimiter2 = multivalue.GetDelimiter2()
+	multivalue = ast.MultivalueClass().Multivalue(
+		delimiter1,
+		parameterList,
+		delimiter2,
+	)
+	return v.transformation_(multivalue, "Multivalue").(ast.MultivalueLike)
+}
+
+func (v *transformer_) transformNamed(
+	named ast.NamedLike,
+) ast.NamedLike {
+	var optionalPrefix = named.GetOptionalPrefix()
+	var name = named.GetName()
+	var optionalArguments = named.GetOptionalArguments()
+	if uti.IsDefined(optionalArguments) {
+		optionalArguments = v.transformArguments(optionalArguments)
+	}
+	named = ast.NamedClass().Named(
+		optionalPrefix,
+		name,
+		optionalArguments,
+	)
+	return v.transformation_(named, "Named").(ast.NamedLike)
+}
+
+func (v *transformer_) transformNone(
+	none ast.NoneLike,
+) ast.NoneLike {
+	var newline = none.GetNewline()
+	none = ast.NoneClass().None(newline)
+	return v.transformation_(none, "None").(ast.NoneLike)
+}
+
+func (v *transformer_) transformOperand(
+	operand ast.OperandLike,
+) ast.OperandLike {
+	var any_ = operand.GetAny()
+	operand = ast.OperandClass().Operand(any_)
+	return v.transformation_(operand, "Operand").(ast.OperandLike)
+}
+
+func (v *transformer_) transformOperation(
+	operation ast.OperationLike,
+) ast.OperationLike {
+	var operator = operation.GetOperator()
+	var operand = v.transformOperand(operation.GetOperand())
+	operation = ast.OperationClass().Operation(
+		operator,
+		operand,
+	)
+	return v.transformation_(operation, "Operation").(ast.OperationLike)
+}
+
+func (v *transformer_) transformPackageDeclaration(
+	packageDeclaration ast.PackageDeclarationLike,
+) ast.PackageDeclarationLike {
+	var legalNotice = v.transformLegalNotice(packageDeclaration.GetLegalNotice())
+	var packageHeader = v.transformPackageHeader(packageDeclaration.GetPackageHeader())
+	var packageImports = v.transformPackageImports(packageDeclaration.GetPackageImports())
+	packageDeclaration = ast.PackageDeclarationClass().PackageDeclaration(
+		legalNotice,
+		packageHeader,
+		packageImports,
+	)
+	return v.transformation_(packageDeclaration, "PackageDeclaration").(ast.PackageDeclarationLike)
+}
+
+func (v *transformer_) transformPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+) ast.PackageHeaderLike {
+	var comment = packageHeader.GetComment()
+	var delimiter = packageHeader.GetDelimiter()
+	var name = packageHeader.GetName()
+	packageHeader = ast.PackageHeaderClass().PackageHeader(
+		comment,
+		delimiter,
+		name,
+	)
+	return v.transformation_(packageHeader, "PackageHeader").(ast.PackageHeaderLike)
+}
+
+func (v *transformer_) transformPackageImports(
+	packageImports ast.PackageImportsLike,
+) ast.PackageImportsLike {
+	var delimiter1 = packageImports.GetDelimiter1()
+	var delimiter2 = packageImports.GetDelimiter2()
+	var optionalImportList = packageImports.GetOptionalImportList()
+	if uti.IsDefined(optionalImportList) {
+		optionalImportList = v.transformImportList(optionalImportList)
+	}
+	var delimiter3 = packageImports.GetDelimiter3()
+	packageImports = ast.PackageImportsClass().PackageImports(
+		delimiter1,
+		delimiter2,
+		optionalImportList,
+		delimiter3,
+	)
+	return v.transformation_(packageImports, "PackageImports").(ast.PackageImportsLike)
+}
+
+func (v *transformer_) transformParameter(
+	parameter ast.ParameterLike,
+) ast.ParameterLike {
+	var name = parameter.GetName()
+	var abstraction = v.transformAbstraction(parameter.GetAbstraction())
+	var delimiter = parameter.GetDelimiter()
+	parameter = ast.ParameterClass().Parameter(
+		name,
+		abstraction,
+		delimiter,
+	)
+	return v.transformation_(parameter, "Parameter").(ast.ParameterLike)
+}
+
+func (v *transformer_) transformParameterList(
+	parameterList ast.ParameterListLike,
+) ast.ParameterListLike {
+	var parameters = com.List[ast.ParameterLike]()
+	var parametersIterator = parameterList.GetParameters().GetIterator()
+	for parametersIterator.HasNext() {
+		var rule = parametersIterator.GetNext()
+		parameters.AppendValue(v.transformParameter(rule))
+	}
+	parameterList = ast.ParameterListClass().ParameterList(parameters)
+	return v.transformation_(parameterList, "ParameterList").(ast.ParameterListLike)
+}
+
+func (v *transformer_) transformPrimitiveDeclarations(
+	primitiveDeclarations ast.PrimitiveDeclarationsLike,
+) ast.PrimitiveDeclarationsLike {
+	var typeSection = v.transformTypeSection(primitiveDeclarations.GetTypeSection())
+	var functionalSection = v.transformFunctionalSection(primitiveDeclarations.GetFunctionalSection())
+	primitiveDeclarations = ast.PrimitiveDeclarationsClass().PrimitiveDeclarations(
+		typeSection,
+		functionalSection,
+	)
+	return v.transformation_(primitiveDeclarations, "PrimitiveDeclarations").(ast.PrimitiveDeclarationsLike)
+}
+
+func (v *transformer_) transformPrincipalMethod(
+	principalMethod ast.PrincipalMethodLike,
+) ast.PrincipalMethodLike {
+	var method = v.transformMethod(principalMethod.GetMethod())
+	principalMethod = ast.PrincipalMethodClass().PrincipalMethod(method)
+	return v.transformation_(principalMethod, "PrincipalMethod").(ast.PrincipalMethodLike)
+}
+
+func (v *transformer_) transformPrincipalSubsection(
+	principalSubsection ast.PrincipalSubsectionLike,
+) ast.PrincipalSubsectionLike {
+	var delimiter = principalSubsection.GetDelimiter()
+	var principalMethods = com.List[ast.PrincipalMethodLike]()
+	var principalMethodsIterator = principalSubsection.GetPrincipalMethods().GetIterator()
+	for principalMethodsIterator.HasNext() {
+		var rule = principalMethodsIterator.GetNext()
+		principalMethods.AppendValue(v.transformPrincipalMethod(rule))
+	}
+	principalSubsection = ast.PrincipalSubsectionClass().PrincipalSubsection(
+		delimiter,
+		principalMethods,
+	)
+	return v.transformation_(principalSubsection, "PrincipalSubsection").(ast.PrincipalSubsectionLike)
+}
+
+func (v *transformer_) transformResult(
+	result ast.ResultLike,
+) ast.ResultLike {
+	var any_ = result.GetAny()
+	switch actual := any_.(type) {
+	case ast.NoneLike:
+		any_ = v.transformNone(actual)
+	case ast.AbstractionLike:
+		any_ = v.transformAbstraction(actual)
+	case ast.MultivalueLike:
+		any_ = v.transformMultivalue(actual)
+	}
+	result = ast.ResultClass().Result(any_)
+	return v.transformation_(result, "Result").(ast.ResultLike)
+}
+
+func (v *transformer_) transformSetterMethod(
+	setterMethod ast.SetterMethodLike,
+) ast.SetterMethodLike {
+	var optionalNote = setterMethod.GetOptionalNote()
+	var name = setterMethod.GetName()
+	var delimiter1 = setterMethod.GetDelimiter1()
+	var parameter = v.transformParameter(setterMethod.GetParameter())
+	var delimiter2 = setterMethod.GetDelimiter2()
+	setterMethod = ast.SetterMethodClass().SetterMethod(
+		optionalNote,
+		name,
+		delimiter1,
+		parameter,
+		delimiter2,
+	)
+	return v.transformation_(setterMethod, "SetterMethod").(ast.SetterMethodLike)
+}
+
+func (v *transformer_) transformSpecification(
+	specification ast.SpecificationLike,
+) ast.SpecificationLike {
+	var any_ = specification.GetAny()
+	switch actual := any_.(type) {
+	case ast.NoneLike:
+		any_ = v.transformNone(actual)
+	case ast.AssignmentLike:
+		any_ = v.transformAssignment(actual)
+	}
+	specification = ast.SpecificationClass().Specification(any_)
+	return v.transformation_(specification, "Specification").(ast.SpecificationLike)
+}
+
+func (v *transformer_) transformStar(
+	star ast.StarLike,
+) ast.StarLike {
+	var delimiter = star.GetDelimiter()
+	star = ast.StarClass().Star(delimiter)
+	return v.transformation_(star, "Star").(ast.StarLike)
+}
+
+func (v *transformer_) transformType(
+	type_ ast.TypeLike,
+) ast.TypeLike {
+	var any_ = type_.GetAny()
+	switch actual := any_.(type) {
+	case ast.NamedLike:
+		any_ = v.transformNamed(actual)
+	case ast.FunctionalLike:
+		any_ = v.transformFunctional(actual)
+	}
+	type_ = ast.TypeClass().Type(any_)
+	return v.transformation_(type_, "Type").(ast.TypeLike)
+}
+
+func (v *transformer_) transformTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+) ast.TypeDeclarationLike {
+	var declaration = v.transformDeclaration(typeDeclaration.GetDeclaration())
+	var abstraction = v.transformAbstraction(typeDeclaration.GetAbstraction())
+	var optionalEnumeration = typeDeclaration.GetOptionalEnumeration()
+	if uti.IsDefined(optionalEnumeration) {
+		optionalEnumeration = v.transformEnumeration(optionalEnumeration)
+	}
+	typeDeclaration = ast.TypeDeclarationClass().TypeDeclaration(
+		declaration,
+		abstraction,
+		optionalEnumeration,
+	)
+	return v.transformation_(typeDeclaration, "TypeDeclaration").(ast.TypeDeclarationLike)
+}
+
+func (v *transformer_) transformTypeSection(
+	typeSection ast.TypeSectionLike,
+) ast.TypeSectionLike {
+	var delimiter = typeSection.GetDelimiter()
+	var typeDeclarations = com.List[ast.TypeDeclarationLike]()
+	var typeDeclarationsIterator = typeSection.GetTypeDeclarations().GetIterator()
+	for typeDeclarationsIterator.HasNext() {
+		var rule = typeDeclarationsIterator.GetNext()
+		typeDeclarations.AppendValue(v.transformTypeDeclaration(rule))
+	}
+	typeSection = ast.TypeSectionClass().TypeSection(
+		delimiter,
+		typeDeclarations,
+	)
+	return v.transformation_(typeSection, "TypeSection").(ast.TypeSectionLike)
+}
+
+func (v *transformer_) transformValue(
+	value ast.ValueLike,
+) ast.ValueLike {
+	var name = value.GetName()
+	var abstraction = v.transformAbstraction(value.GetAbstraction())
+	var delimiter = value.GetDelimiter()
+	var expression = v.transformExpression(value.GetExpression())
+	value = ast.ValueClass().Value(
+		name,
+		abstraction,
+		delimiter,
+		expression,
+	)
+	return v.transformation_(value, "Value").(ast.ValueLike)
+}
+
+func (v *transformer_) transformWrapper(
+	wrapper ast.WrapperLike,
+) ast.WrapperLike {
+	var any_ = wrapper.GetAny()
+	switch actual := any_.(type) {
+	case ast.DotsLike:
+		any_ = v.transformDots(actual)
+	case ast.StarLike:
+		any_ = v.transformStar(actual)
+	case ast.ArrayLike:
+		any_ = v.transformArray(actual)
+	case ast.ChannelLike:
+		any_ = v.transformChannel(actual)
+	case ast.MapLike:
+		any_ = v.transformMap(actual)
+	}
+	wrapper = ast.WrapperClass().Wrapper(any_)
+	return v.transformation_(wrapper, "Wrapper").(ast.WrapperLike)
+}
+
+// Instance Structure
+
+type transformer_ struct {
+	// Declare the instance attributes.
+	transformation_ TransformationFunction
+}
+
+// Class Structure
+
+type transformerClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func transformerClass() *transformerClass_ {
+	return transformerClassReference_
+}
+
+var transformerClassReference_ = &transformerClass_{
+	// Initialize the class constants.
+}
@@ -25,8 +25,8 @@ import (
 	fmt "fmt"
 	ast "github.com/craterdog/go-class-model/v8/ast"
 	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
 	sts "strings"
-	utf "unicode/utf8"
 )
 
 // CLASS INTERFACE
@@ -83,10 +83,22 @@ func (v *validator_) ProcessNewline(
 	v.validateToken(newline, NewlineToken)
 }
 
-func (v *validator_) ProcessPath(
-	path string,
+func (v *validator_) ProcessNote(
+	note string,
 ) {
-	v.validateToken(path, PathToken)
+	v.validateToken(note, NoteToken)
+}
+
+func (v *validator_) ProcessNumber(
+	number string,
+) {
+	v.validateToken(number, NumberToken)
+}
+
+func (v *validator_) ProcessOperator(
+	operator string,
+) {
+	v.validateToken(operator, OperatorToken)
 }
 
 func (v *validator_) ProcessPrefix(
@@ -95,6 +107,31 @@ func (v *validator_) ProcessPrefix(
 	v.validateToken(prefix, PrefixToken)
 }
 
+func (v *validator_) ProcessString(
+	string_ string,
+) {
+	v.validateToken(string_, StringToken)
+}
+
+func (v *validator_) PreprocessAbstraction(
+	abstraction ast.AbstractionLike,
+	index_ uint,
+	count_ uint,
+) {
+	var wrapper = abstraction.GetOptionalWrapper()
+	if uti.IsUndefined(wrapper) {
+		return
+	}
+	var _, isDots = wrapper.GetAny().(ast.DotsLike)
+	if isDots && abstraction != v.variadic_ {
+		var message = fmt.Sprintf(
+			"The \"...\" wrapper may only be used on the last parameter of a method or function: %s",
+			v.getFormatter(nil).FormatAbstraction(abstraction),
+		)
+		panic(message)
+	}
+}
+
 func (v *validator_) PreprocessAspectSection(
 	aspectSection ast.AspectSectionLike,
 	index_ uint,
@@ -119,6 +156,7 @@ func (v *validator_) PreprocessAspectSection(
 			}
 		},
 	)
+	v.validateEmbeddings(aspectDeclarations)
 }
 
 func (v *validator_) PreprocessClassSection(
@@ -191,6 +229,11 @@ func (v *validator_) PreprocessFunctionMethod(
 	count_ uint,
 ) {
 	var result = functionMethod.GetResult()
+	v.validateNames(
+		functionMethod.GetName(),
+		functionMethod.GetOptionalParameterList(),
+		result,
+	)
 	switch result.GetAny().(type) {
 	case ast.NoneLike:
 		var functionName = functionMethod.GetName()
@@ -202,19 +245,25 @@ func (v *validator_) PreprocessFunctionMethod(
 	}
 }
 
-func (v *validator_) PreprocessImportedPackage(
-	importedPackage ast.ImportedPackageLike,
+func (v *validator_) PreprocessFunctional(
+	functional ast.FunctionalLike,
 	index_ uint,
 	count_ uint,
 ) {
-	var packageName = importedPackage.GetName()
-	if utf.RuneCountInString(packageName) != 3 {
-		var message = fmt.Sprintf(
-			"An imported package name must be exactly three characters long: %s",
-			packageName,
-		)
-		panic(message)
-	}
+	v.validateNames(
+		"func",
+		functional.GetOptionalParameterList(),
+		functional.GetOptionalResult(),
+	)
+	v.variadics_ = append(v.variadics_, true)
+}
+
+func (v *validator_) PostprocessFunctional(
+	functional ast.FunctionalLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.variadics_ = v.variadics_[:len(v.variadics_)-1]
 }
 
 func (v *validator_) PreprocessInstanceSection(
@@ -249,10 +298,416 @@ func (v *validator_) PreprocessInstanceSection(
 	)
 }
 
+func (v *validator_) PreprocessMethod(
+	method ast.MethodLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.validateNames(
+		method.GetName(),
+		method.GetOptionalParameterList(),
+		method.GetResult(),
+	)
+}
+
+func (v *validator_) PreprocessMultivalue(
+	multivalue ast.MultivalueLike,
+	index_ uint,
+	count_ uint,
+) {
+	// Result values can never be variadic.
+	v.variadics_ = append(v.variadics_, false)
+}
+
+func (v *validator_) PostprocessMultivalue(
+	multivalue ast.MultivalueLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.variadics_ = v.variadics_[:len(v.variadics_)-1]
+}
+
+func (v *validator_) PreprocessParameter(
+	parameter ast.ParameterLike,
+	index_ uint,
+	count_ uint,
+) {
+	// Only the last parameter in a parameter list may be variadic.
+	v.variadic_ = nil
+	var depth = len(v.variadics_)
+	if (depth == 0 || v.variadics_[depth-1]) && index_ == count_ {
+		v.variadic_ = parameter.GetAbstraction()
+	}
+}
+
+func (v *validator_) PreprocessTypeDeclaration(
+	typeDeclaration ast.TypeDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var enumeration = typeDeclaration.GetOptionalEnumeration()
+	if uti.IsUndefined(enumeration) {
+		return
+	}
+	var typeName = typeDeclaration.GetDeclaration().GetName()
+	var intrinsic = v.getIntrinsic(typeDeclaration.GetAbstraction())
+	var value = enumeration.GetValue()
+	v.validateConstant(
+		value.GetName(),
+		value.GetAbstraction(),
+		value.GetExpression(),
+		typeName,
+		intrinsic,
+	)
+	var additionalValues = enumeration.GetAdditionalValues().GetIterator()
+	for additionalValues.HasNext() {
+		var additionalValue = additionalValues.GetNext()
+		var specification = additionalValue.GetSpecification()
+		switch actual := specification.GetAny().(type) {
+		case ast.AssignmentLike:
+			v.validateConstant(
+				additionalValue.GetName(),
+				actual.GetAbstraction(),
+				actual.GetExpression(),
+				typeName,
+				intrinsic,
+			)
+		}
+	}
+}
+
 // PROTECTED INTERFACE
 
 // Private Methods
 
+func (v *validator_) collectMethods(
+	aspectDeclaration ast.AspectDeclarationLike,
+	substitutions map[string]string,
+	aspects map[string]ast.AspectDeclarationLike,
+	embeddings []string,
+	methods map[string]string,
+) {
+	// An aspect may not embed itself either directly or indirectly.
+	var aspectName = aspectDeclaration.GetDeclaration().GetName()
+	for _, embedding := range embeddings {
+		if embedding == aspectName {
+			var message = fmt.Sprintf(
+				"The aspect %s has an embedding cycle: %s",
+				embeddings[0],
+				sts.Join(append(embeddings, aspectName), " -> "),
+			)
+			panic(message)
+		}
+	}
+	embeddings = append(embeddings, aspectName)
+
+	// Each method name must map to a single signature.
+	var aspectMembers = aspectDeclaration.GetAspectMembers().GetIterator()
+	for aspectMembers.HasNext() {
+		var aspectMember = aspectMembers.GetNext()
+		switch actual := aspectMember.GetAny().(type) {
+		case ast.AspectMethodLike:
+			var method = actual.GetMethod()
+			var methodName = method.GetName()
+			var signature = v.formatMethod(method, substitutions)
+			var existing, ok = methods[methodName]
+			if ok && existing != signature {
+				var message = fmt.Sprintf(
+					"The aspect %s has conflicting signatures for method %s: %s%s and %s%s",
+					embeddings[0],
+					methodName,
+					methodName,
+					existing,
+					methodName,
+					signature,
+				)
+				panic(message)
+			}
+			methods[methodName] = signature
+		case ast.AspectInterfaceLike:
+			var abstraction = actual.GetAbstraction()
+			var named, ok = abstraction.GetType().GetAny().(ast.NamedLike)
+			if !ok || uti.IsDefined(abstraction.GetOptionalWrapper()) {
+				var message = fmt.Sprintf(
+					"The aspect %s may only embed named interfaces: %s",
+					aspectName,
+					v.getFormatter(substitutions).FormatAbstraction(abstraction),
+				)
+				panic(message)
+			}
+			if uti.IsDefined(named.GetOptionalPrefix()) {
+				// Aspects from other packages cannot be resolved here.
+				continue
+			}
+			var embedded, found = aspects[named.GetName()]
+			if !found {
+				// Only aspects declared in this package can be resolved.
+				continue
+			}
+			v.collectMethods(
+				embedded,
+				v.substituteArguments(embedded, named, substitutions),
+				aspects,
+				embeddings,
+				methods,
+			)
+		}
+	}
+}
+
+func (v *validator_) formatMethod(
+	method ast.MethodLike,
+	substitutions map[string]string,
+) string {
+	var formatter = v.getFormatter(substitutions)
+	return formatter.FormatParameterList(method.GetOptionalParameterList()) +
+		formatter.FormatResult(method.GetResult())
+}
+
+func (v *validator_) getFormatter(
+	substitutions map[string]string,
+) FormatterLike {
+	// Parameter names are not part of a method signature.
+	var rendering = func(fragment string, name string) string {
+		switch name {
+		case "Named":
+			return v.substituteName(fragment, substitutions)
+		case "Parameter":
+			return ""
+		default:
+			return fragment
+		}
+	}
+	return formatterClass().FormatterWithRendering(rendering)
+}
+
+func (v *validator_) getIntrinsic(
+	abstraction ast.AbstractionLike,
+) string {
+	// Only unwrapped intrinsic types support literal constant values.
+	if uti.IsDefined(abstraction.GetOptionalWrapper()) {
+		return ""
+	}
+	var named, ok = abstraction.GetType().GetAny().(ast.NamedLike)
+	if !ok || uti.IsDefined(named.GetOptionalPrefix()) {
+		return ""
+	}
+	var name = named.GetName()
+	switch name {
+	case "string", "bool", "byte", "rune", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+		return name
+	default:
+		return ""
+	}
+}
+
+func (v *validator_) isCompatible(
+	kind string,
+	intrinsic string,
+) bool {
+	switch intrinsic {
+	case "":
+		// The underlying type is not known so any literal value is allowed.
+		return true
+	case "string":
+		return kind == "string"
+	case "float32", "float64":
+		return kind != "string"
+	case "byte", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+		return kind == "number" || kind == "iota"
+	default:
+		return kind == "number" || kind == "negative" || kind == "iota"
+	}
+}
+
+func (v *validator_) substituteArguments(
+	aspectDeclaration ast.AspectDeclarationLike,
+	named ast.NamedLike,
+	substitutions map[string]string,
+) map[string]string {
+	// Map the type parameters of the embedded aspect onto its type arguments.
+	var result = make(map[string]string)
+	var constraints = aspectDeclaration.GetDeclaration().GetOptionalConstraints()
+	var arguments = named.GetOptionalArguments()
+	if uti.IsUndefined(constraints) || uti.IsUndefined(arguments) {
+		return result
+	}
+	var names = []string{constraints.GetConstraint().GetName()}
+	var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+	for additionalConstraints.HasNext() {
+		names = append(names, additionalConstraints.GetNext().GetConstraint().GetName())
+	}
+	var formatter = v.getFormatter(substitutions)
+	var values = []string{
+		formatter.FormatAbstraction(arguments.GetArgument().GetAbstraction()),
+	}
+	var additionalArguments = arguments.GetAdditionalArguments().GetIterator()
+	for additionalArguments.HasNext() {
+		var argument = additionalArguments.GetNext().GetArgument()
+		values = append(
+			values,
+			formatter.FormatAbstraction(argument.GetAbstraction()),
+		)
+	}
+	if len(names) != len(values) {
+		var message = fmt.Sprintf(
+			"The embedded aspect %s requires %d type arguments but %d were given.",
+			named.GetName(),
+			len(names),
+			len(values),
+		)
+		panic(message)
+	}
+	for index, name := range names {
+		result[name] = values[index]
+	}
+	return result
+}
+
+func (v *validator_) substituteName(
+	name string,
+	substitutions map[string]string,
+) string {
+	var value, ok = substitutions[name]
+	if ok {
+		return value
+	}
+	return name
+}
+
+func (v *validator_) validateConstant(
+	constantName string,
+	abstraction ast.AbstractionLike,
+	expression ast.ExpressionLike,
+	typeName string,
+	intrinsic string,
+) {
+	// The type of the constant must be the type being declared.
+	var named, ok = abstraction.GetType().GetAny().(ast.NamedLike)
+	if !ok ||
+		uti.IsDefined(abstraction.GetOptionalWrapper()) ||
+		uti.IsDefined(named.GetOptionalPrefix()) ||
+		uti.IsDefined(named.GetOptionalArguments()) ||
+		named.GetName() != typeName {
+		var message = fmt.Sprintf(
+			"The type of constant %s must be its declared type: %s",
+			constantName,
+			typeName,
+		)
+		panic(message)
+	}
+
+	// There are no boolean literals so a boolean constant has no value.
+	if intrinsic == "bool" {
+		var message = fmt.Sprintf(
+			"The constant %s cannot have the boolean type %s since there are no boolean literals.",
+			constantName,
+			typeName,
+		)
+		panic(message)
+	}
+
+	// The operands of the constant must match its intrinsic type.
+	var operands = []ast.OperandLike{expression.GetOperand()}
+	var operation = expression.GetOptionalOperation()
+	if uti.IsDefined(operation) {
+		if intrinsic == "string" && operation.GetOperator() != "+" {
+			var message = fmt.Sprintf(
+				"The only operator allowed on the string constant %s is: +",
+				constantName,
+			)
+			panic(message)
+		}
+		operands = append(operands, operation.GetOperand())
+	}
+	var scannerClass = ScannerClass()
+	for _, operand := range operands {
+		var literal = operand.GetAny().(string)
+		var kind string
+		switch {
+		case scannerClass.MatchesType(literal, StringToken):
+			kind = "string"
+		case scannerClass.MatchesType(literal, NumberToken):
+			kind = "number"
+			switch {
+			case sts.Contains(literal, "."):
+				kind = "float"
+			case sts.HasPrefix(literal, "-"):
+				kind = "negative"
+			}
+		default:
+			kind = "iota"
+		}
+		if !v.isCompatible(kind, intrinsic) {
+			var message = fmt.Sprintf(
+				"The value %s of constant %s is not compatible with its type: %s",
+				literal,
+				constantName,
+				typeName,
+			)
+			panic(message)
+		}
+	}
+}
+
+func (v *validator_) validateEmbeddings(
+	aspectDeclarations com.Sequential[ast.AspectDeclarationLike],
+) {
+	var aspects = make(map[string]ast.AspectDeclarationLike)
+	var iterator = aspectDeclarations.GetIterator()
+	for iterator.HasNext() {
+		var aspectDeclaration = iterator.GetNext()
+		aspects[aspectDeclaration.GetDeclaration().GetName()] = aspectDeclaration
+	}
+	iterator.ToStart()
+	for iterator.HasNext() {
+		var aspectDeclaration = iterator.GetNext()
+		v.collectMethods(
+			aspectDeclaration,
+			make(map[string]string),
+			aspects,
+			nil,
+			make(map[string]string),
+		)
+	}
+}
+
+func (v *validator_) validateNames(
+	methodName string,
+	parameterList ast.ParameterListLike,
+	result ast.ResultLike,
+) {
+	var names = make(map[string]bool)
+	if uti.IsDefined(parameterList) {
+		var parameters = parameterList.GetParameters().GetIterator()
+		for parameters.HasNext() {
+			names[parameters.GetNext().GetName()] = true
+		}
+	}
+	if uti.IsUndefined(result) {
+		return
+	}
+	var multivalue, ok = result.GetAny().(ast.MultivalueLike)
+	if !ok {
+		return
+	}
+	var values = multivalue.GetParameterList().GetParameters().GetIterator()
+	for values.HasNext() {
+		var name = values.GetNext().GetName()
+		if names[name] {
+			var message = fmt.Sprintf(
+				"The result name %s is already used by a parameter or result of: %s",
+				name,
+				methodName,
+			)
+			panic(message)
+		}
+		names[name] = true
+	}
+}
+
 func (v *validator_) validateToken(
 	tokenValue string,
 	tokenType TokenType,
@@ -272,6 +727,8 @@ func (v *validator_) validateToken(
 
 type validator_ struct {
 	// Declare the instance attributes.
+	variadic_  ast.AbstractionLike
+	variadics_ []bool
 
 	// Declare the inherited aspects.
 	Methodical
@@ -23,6 +23,7 @@ package grammar
 
 import (
 	ast "github.com/craterdog/go-class-model/v8/ast"
+	com "github.com/craterdog/go-essential-composites/v8"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 )
 
@@ -60,6 +61,10 @@ func (v *visitor_) GetClass() VisitorClassLike {
 func (v *visitor_) VisitModel(
 	model ast.ModelLike,
 ) {
+	var processor = v.processor_
+	v.ancestors_ = nil
+	v.skipping_ = false
+	v.stopped_ = false
 	v.processor_.PreprocessModel(
 		model,
 		0,
@@ -71,15 +76,40 @@ func (v *visitor_) VisitModel(
 		0,
 		0,
 	)
+	v.processor_ = processor
+}
+
+func (v *visitor_) GetAncestors() com.Sequential[any] {
+	return com.ListFromArray(v.ancestors_)
+}
+
+func (v *visitor_) SkipChildren() {
+	v.skipping_ = true
+}
+
+func (v *visitor_) StopVisiting() {
+	// The rest of the walk is abandoned without calling the processor again.
+	v.stopped_ = true
+	v.processor_ = processorClass().Processor()
 }
 
 // PROTECTED INTERFACE
 
 // Private Methods
 
+func (v *visitor_) isSkipping() bool {
+	var skipping = v.skipping_ || v.stopped_
+	v.skipping_ = false
+	return skipping
+}
+
 func (v *visitor_) visitAbstraction(
 	abstraction ast.AbstractionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, abstraction)
 	var optionalWrapper = abstraction.GetOptionalWrapper()
 	if uti.IsDefined(optionalWrapper) {
 		v.processor_.PreprocessWrapper(
@@ -112,11 +142,16 @@ func (v *visitor_) visitAbstraction(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAdditionalArgument(
 	additionalArgument ast.AdditionalArgumentLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, additionalArgument)
 	var delimiter = additionalArgument.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -137,11 +172,16 @@ func (v *visitor_) visitAdditionalArgument(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAdditionalConstraint(
 	additionalConstraint ast.AdditionalConstraintLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, additionalConstraint)
 	var delimiter = additionalConstraint.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -162,18 +202,46 @@ func (v *visitor_) visitAdditionalConstraint(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAdditionalValue(
 	additionalValue ast.AdditionalValueLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, additionalValue)
 	var name = additionalValue.GetName()
 	v.processor_.ProcessName(name)
+	// Visit slot 1 between terms.
+	v.processor_.ProcessAdditionalValueSlot(
+		additionalValue,
+		1,
+	)
+
+	var specification = additionalValue.GetSpecification()
+	v.processor_.PreprocessSpecification(
+		specification,
+		0,
+		0,
+	)
+	v.visitSpecification(specification)
+	v.processor_.PostprocessSpecification(
+		specification,
+		0,
+		0,
+	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitArgument(
 	argument ast.ArgumentLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, argument)
 	var abstraction = argument.GetAbstraction()
 	v.processor_.PreprocessAbstraction(
 		abstraction,
@@ -186,11 +254,16 @@ func (v *visitor_) visitArgument(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitArguments(
 	arguments ast.ArgumentsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, arguments)
 	var delimiter1 = arguments.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -243,11 +316,16 @@ func (v *visitor_) visitArguments(
 
 	var delimiter2 = arguments.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitArray(
 	array ast.ArrayLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, array)
 	var delimiter1 = array.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -258,11 +336,16 @@ func (v *visitor_) visitArray(
 
 	var delimiter2 = array.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAspectDeclaration(
 	aspectDeclaration ast.AspectDeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, aspectDeclaration)
 	var declaration = aspectDeclaration.GetDeclaration()
 	v.processor_.PreprocessDeclaration(
 		declaration,
@@ -297,22 +380,22 @@ func (v *visitor_) visitAspectDeclaration(
 		3,
 	)
 
-	var aspectMethodsIndex uint
-	var aspectMethods = aspectDeclaration.GetAspectMethods().GetIterator()
-	var aspectMethodsCount = uint(aspectMethods.GetSize())
-	for aspectMethods.HasNext() {
-		aspectMethodsIndex++
-		var rule = aspectMethods.GetNext()
-		v.processor_.PreprocessAspectMethod(
+	var aspectMembersIndex uint
+	var aspectMembers = aspectDeclaration.GetAspectMembers().GetIterator()
+	var aspectMembersCount = uint(aspectMembers.GetSize())
+	for aspectMembers.HasNext() {
+		aspectMembersIndex++
+		var rule = aspectMembers.GetNext()
+		v.processor_.PreprocessAspectMember(
 			rule,
-			aspectMethodsIndex,
-			aspectMethodsCount,
+			aspectMembersIndex,
+			aspectMembersCount,
 		)
-		v.visitAspectMethod(rule)
-		v.processor_.PostprocessAspectMethod(
+		v.visitAspectMember(rule)
+		v.processor_.PostprocessAspectMember(
 			rule,
-			aspectMethodsIndex,
-			aspectMethodsCount,
+			aspectMembersIndex,
+			aspectMembersCount,
 		)
 	}
 	// Visit slot 4 between terms.
@@ -323,11 +406,16 @@ func (v *visitor_) visitAspectDeclaration(
 
 	var delimiter3 = aspectDeclaration.GetDelimiter3()
 	v.processor_.ProcessDelimiter(delimiter3)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAspectInterface(
 	aspectInterface ast.AspectInterfaceLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, aspectInterface)
 	var abstraction = aspectInterface.GetAbstraction()
 	v.processor_.PreprocessAbstraction(
 		abstraction,
@@ -340,11 +428,54 @@ func (v *visitor_) visitAspectInterface(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+
+func (v *visitor_) visitAspectMember(
+	aspectMember ast.AspectMemberLike,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, aspectMember)
+
+	// Visit the possible aspectMember rule types.
+	switch actual := aspectMember.GetAny().(type) {
+	case ast.AspectMethodLike:
+		v.processor_.PreprocessAspectMethod(
+			actual,
+			0,
+			0,
+		)
+		v.visitAspectMethod(actual)
+		v.processor_.PostprocessAspectMethod(
+			actual,
+			0,
+			0,
+		)
+	case ast.AspectInterfaceLike:
+		v.processor_.PreprocessAspectInterface(
+			actual,
+			0,
+			0,
+		)
+		v.visitAspectInterface(actual)
+		v.processor_.PostprocessAspectInterface(
+			actual,
+			0,
+			0,
+		)
+	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAspectMethod(
 	aspectMethod ast.AspectMethodLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, aspectMethod)
 	var method = aspectMethod.GetMethod()
 	v.processor_.PreprocessMethod(
 		method,
@@ -357,11 +488,16 @@ func (v *visitor_) visitAspectMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAspectSection(
 	aspectSection ast.AspectSectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, aspectSection)
 	var delimiter = aspectSection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -388,11 +524,16 @@ func (v *visitor_) visitAspectSection(
 			aspectDeclarationsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAspectSubsection(
 	aspectSubsection ast.AspectSubsectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, aspectSubsection)
 	var delimiter = aspectSubsection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -419,11 +560,65 @@ func (v *visitor_) visitAspectSubsection(
 			aspectInterfacesCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+
+func (v *visitor_) visitAssignment(
+	assignment ast.AssignmentLike,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, assignment)
+	var abstraction = assignment.GetAbstraction()
+	v.processor_.PreprocessAbstraction(
+		abstraction,
+		0,
+		0,
+	)
+	v.visitAbstraction(abstraction)
+	v.processor_.PostprocessAbstraction(
+		abstraction,
+		0,
+		0,
+	)
+	// Visit slot 1 between terms.
+	v.processor_.ProcessAssignmentSlot(
+		assignment,
+		1,
+	)
+
+	var delimiter = assignment.GetDelimiter()
+	v.processor_.ProcessDelimiter(delimiter)
+	// Visit slot 2 between terms.
+	v.processor_.ProcessAssignmentSlot(
+		assignment,
+		2,
+	)
+
+	var expression = assignment.GetExpression()
+	v.processor_.PreprocessExpression(
+		expression,
+		0,
+		0,
+	)
+	v.visitExpression(expression)
+	v.processor_.PostprocessExpression(
+		expression,
+		0,
+		0,
+	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAttributeMethod(
 	attributeMethod ast.AttributeMethodLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, attributeMethod)
+
 	// Visit the possible attributeMethod rule types.
 	switch actual := attributeMethod.GetAny().(type) {
 	case ast.GetterMethodLike:
@@ -451,11 +646,16 @@ func (v *visitor_) visitAttributeMethod(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitAttributeSubsection(
 	attributeSubsection ast.AttributeSubsectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, attributeSubsection)
 	var delimiter = attributeSubsection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -482,18 +682,28 @@ func (v *visitor_) visitAttributeSubsection(
 			attributeMethodsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitChannel(
 	channel ast.ChannelLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, channel)
 	var delimiter = channel.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitClassDeclaration(
 	classDeclaration ast.ClassDeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, classDeclaration)
 	var declaration = classDeclaration.GetDeclaration()
 	v.processor_.PreprocessDeclaration(
 		declaration,
@@ -548,11 +758,16 @@ func (v *visitor_) visitClassDeclaration(
 
 	var delimiter3 = classDeclaration.GetDelimiter3()
 	v.processor_.ProcessDelimiter(delimiter3)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitClassMethods(
 	classMethods ast.ClassMethodsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, classMethods)
 	var constructorSubsection = classMethods.GetConstructorSubsection()
 	v.processor_.PreprocessConstructorSubsection(
 		constructorSubsection,
@@ -605,11 +820,16 @@ func (v *visitor_) visitClassMethods(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitClassSection(
 	classSection ast.ClassSectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, classSection)
 	var delimiter = classSection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -636,35 +856,50 @@ func (v *visitor_) visitClassSection(
 			classDeclarationsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitConstantMethod(
 	constantMethod ast.ConstantMethodLike,
 ) {
-	var name = constantMethod.GetName()
-	v.processor_.ProcessName(name)
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, constantMethod)
+	var optionalNote = constantMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
 	// Visit slot 1 between terms.
 	v.processor_.ProcessConstantMethodSlot(
 		constantMethod,
 		1,
 	)
 
-	var delimiter1 = constantMethod.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var name = constantMethod.GetName()
+	v.processor_.ProcessName(name)
 	// Visit slot 2 between terms.
 	v.processor_.ProcessConstantMethodSlot(
 		constantMethod,
 		2,
 	)
 
-	var delimiter2 = constantMethod.GetDelimiter2()
-	v.processor_.ProcessDelimiter(delimiter2)
+	var delimiter1 = constantMethod.GetDelimiter1()
+	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 3 between terms.
 	v.processor_.ProcessConstantMethodSlot(
 		constantMethod,
 		3,
 	)
 
+	var delimiter2 = constantMethod.GetDelimiter2()
+	v.processor_.ProcessDelimiter(delimiter2)
+	// Visit slot 4 between terms.
+	v.processor_.ProcessConstantMethodSlot(
+		constantMethod,
+		4,
+	)
+
 	var abstraction = constantMethod.GetAbstraction()
 	v.processor_.PreprocessAbstraction(
 		abstraction,
@@ -677,11 +912,16 @@ func (v *visitor_) visitConstantMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitConstantSubsection(
 	constantSubsection ast.ConstantSubsectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, constantSubsection)
 	var delimiter = constantSubsection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -708,11 +948,16 @@ func (v *visitor_) visitConstantSubsection(
 			constantMethodsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitConstraint(
 	constraint ast.ConstraintLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, constraint)
 	var name = constraint.GetName()
 	v.processor_.ProcessName(name)
 	// Visit slot 1 between terms.
@@ -733,11 +978,16 @@ func (v *visitor_) visitConstraint(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitConstraints(
 	constraints ast.ConstraintsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, constraints)
 	var delimiter1 = constraints.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -790,27 +1040,42 @@ func (v *visitor_) visitConstraints(
 
 	var delimiter2 = constraints.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitConstructorMethod(
 	constructorMethod ast.ConstructorMethodLike,
 ) {
-	var name = constructorMethod.GetName()
-	v.processor_.ProcessName(name)
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, constructorMethod)
+	var optionalNote = constructorMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
 	// Visit slot 1 between terms.
 	v.processor_.ProcessConstructorMethodSlot(
 		constructorMethod,
 		1,
 	)
 
-	var delimiter1 = constructorMethod.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var name = constructorMethod.GetName()
+	v.processor_.ProcessName(name)
 	// Visit slot 2 between terms.
 	v.processor_.ProcessConstructorMethodSlot(
 		constructorMethod,
 		2,
 	)
 
+	var delimiter1 = constructorMethod.GetDelimiter1()
+	v.processor_.ProcessDelimiter(delimiter1)
+	// Visit slot 3 between terms.
+	v.processor_.ProcessConstructorMethodSlot(
+		constructorMethod,
+		3,
+	)
+
 	var optionalParameterList = constructorMethod.GetOptionalParameterList()
 	if uti.IsDefined(optionalParameterList) {
 		v.processor_.PreprocessParameterList(
@@ -825,18 +1090,18 @@ func (v *visitor_) visitConstructorMethod(
 			0,
 		)
 	}
-	// Visit slot 3 between terms.
+	// Visit slot 4 between terms.
 	v.processor_.ProcessConstructorMethodSlot(
 		constructorMethod,
-		3,
+		4,
 	)
 
 	var delimiter2 = constructorMethod.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
-	// Visit slot 4 between terms.
+	// Visit slot 5 between terms.
 	v.processor_.ProcessConstructorMethodSlot(
 		constructorMethod,
-		4,
+		5,
 	)
 
 	var abstraction = constructorMethod.GetAbstraction()
@@ -851,11 +1116,16 @@ func (v *visitor_) visitConstructorMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitConstructorSubsection(
 	constructorSubsection ast.ConstructorSubsectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, constructorSubsection)
 	var delimiter = constructorSubsection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -882,11 +1152,16 @@ func (v *visitor_) visitConstructorSubsection(
 			constructorMethodsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitDeclaration(
 	declaration ast.DeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, declaration)
 	var comment = declaration.GetComment()
 	v.processor_.ProcessComment(comment)
 	// Visit slot 1 between terms.
@@ -925,18 +1200,28 @@ func (v *visitor_) visitDeclaration(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitDots(
 	dots ast.DotsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, dots)
 	var delimiter = dots.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitEnumeration(
 	enumeration ast.EnumerationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, enumeration)
 	var delimiter1 = enumeration.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -997,27 +1282,84 @@ func (v *visitor_) visitEnumeration(
 
 	var delimiter3 = enumeration.GetDelimiter3()
 	v.processor_.ProcessDelimiter(delimiter3)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+
+func (v *visitor_) visitExpression(
+	expression ast.ExpressionLike,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, expression)
+	var operand = expression.GetOperand()
+	v.processor_.PreprocessOperand(
+		operand,
+		0,
+		0,
+	)
+	v.visitOperand(operand)
+	v.processor_.PostprocessOperand(
+		operand,
+		0,
+		0,
+	)
+	// Visit slot 1 between terms.
+	v.processor_.ProcessExpressionSlot(
+		expression,
+		1,
+	)
+
+	var optionalOperation = expression.GetOptionalOperation()
+	if uti.IsDefined(optionalOperation) {
+		v.processor_.PreprocessOperation(
+			optionalOperation,
+			0,
+			0,
+		)
+		v.visitOperation(optionalOperation)
+		v.processor_.PostprocessOperation(
+			optionalOperation,
+			0,
+			0,
+		)
+	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitFunctionMethod(
 	functionMethod ast.FunctionMethodLike,
 ) {
-	var name = functionMethod.GetName()
-	v.processor_.ProcessName(name)
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, functionMethod)
+	var optionalNote = functionMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
 	// Visit slot 1 between terms.
 	v.processor_.ProcessFunctionMethodSlot(
 		functionMethod,
 		1,
 	)
 
-	var delimiter1 = functionMethod.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var name = functionMethod.GetName()
+	v.processor_.ProcessName(name)
 	// Visit slot 2 between terms.
 	v.processor_.ProcessFunctionMethodSlot(
 		functionMethod,
 		2,
 	)
 
+	var delimiter1 = functionMethod.GetDelimiter1()
+	v.processor_.ProcessDelimiter(delimiter1)
+	// Visit slot 3 between terms.
+	v.processor_.ProcessFunctionMethodSlot(
+		functionMethod,
+		3,
+	)
+
 	var optionalParameterList = functionMethod.GetOptionalParameterList()
 	if uti.IsDefined(optionalParameterList) {
 		v.processor_.PreprocessParameterList(
@@ -1032,18 +1374,18 @@ func (v *visitor_) visitFunctionMethod(
 			0,
 		)
 	}
-	// Visit slot 3 between terms.
+	// Visit slot 4 between terms.
 	v.processor_.ProcessFunctionMethodSlot(
 		functionMethod,
-		3,
+		4,
 	)
 
 	var delimiter2 = functionMethod.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
-	// Visit slot 4 between terms.
+	// Visit slot 5 between terms.
 	v.processor_.ProcessFunctionMethodSlot(
 		functionMethod,
-		4,
+		5,
 	)
 
 	var result = functionMethod.GetResult()
@@ -1058,11 +1400,16 @@ func (v *visitor_) visitFunctionMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitFunctionSubsection(
 	functionSubsection ast.FunctionSubsectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, functionSubsection)
 	var delimiter = functionSubsection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -1089,11 +1436,16 @@ func (v *visitor_) visitFunctionSubsection(
 			functionMethodsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitFunctional(
 	functional ast.FunctionalLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, functional)
 	var delimiter1 = functional.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -1152,11 +1504,16 @@ func (v *visitor_) visitFunctional(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitFunctionalDeclaration(
 	functionalDeclaration ast.FunctionalDeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, functionalDeclaration)
 	var declaration = functionalDeclaration.GetDeclaration()
 	v.processor_.PreprocessDeclaration(
 		declaration,
@@ -1187,11 +1544,16 @@ func (v *visitor_) visitFunctionalDeclaration(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitFunctionalSection(
 	functionalSection ast.FunctionalSectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, functionalSection)
 	var delimiter = functionalSection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -1218,35 +1580,50 @@ func (v *visitor_) visitFunctionalSection(
 			functionalDeclarationsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitGetterMethod(
 	getterMethod ast.GetterMethodLike,
 ) {
-	var name = getterMethod.GetName()
-	v.processor_.ProcessName(name)
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, getterMethod)
+	var optionalNote = getterMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
 	// Visit slot 1 between terms.
 	v.processor_.ProcessGetterMethodSlot(
 		getterMethod,
 		1,
 	)
 
-	var delimiter1 = getterMethod.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var name = getterMethod.GetName()
+	v.processor_.ProcessName(name)
 	// Visit slot 2 between terms.
 	v.processor_.ProcessGetterMethodSlot(
 		getterMethod,
 		2,
 	)
 
-	var delimiter2 = getterMethod.GetDelimiter2()
-	v.processor_.ProcessDelimiter(delimiter2)
+	var delimiter1 = getterMethod.GetDelimiter1()
+	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 3 between terms.
 	v.processor_.ProcessGetterMethodSlot(
 		getterMethod,
 		3,
 	)
 
+	var delimiter2 = getterMethod.GetDelimiter2()
+	v.processor_.ProcessDelimiter(delimiter2)
+	// Visit slot 4 between terms.
+	v.processor_.ProcessGetterMethodSlot(
+		getterMethod,
+		4,
+	)
+
 	var abstraction = getterMethod.GetAbstraction()
 	v.processor_.PreprocessAbstraction(
 		abstraction,
@@ -1259,11 +1636,16 @@ func (v *visitor_) visitGetterMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitImportList(
 	importList ast.ImportListLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, importList)
 	var importedPackagesIndex uint
 	var importedPackages = importList.GetImportedPackages().GetIterator()
 	var importedPackagesCount = uint(importedPackages.GetSize())
@@ -1282,11 +1664,16 @@ func (v *visitor_) visitImportList(
 			importedPackagesCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitImportedPackage(
 	importedPackage ast.ImportedPackageLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, importedPackage)
 	var name = importedPackage.GetName()
 	v.processor_.ProcessName(name)
 	// Visit slot 1 between terms.
@@ -1295,13 +1682,18 @@ func (v *visitor_) visitImportedPackage(
 		1,
 	)
 
-	var path = importedPackage.GetPath()
-	v.processor_.ProcessPath(path)
+	var string_ = importedPackage.GetString()
+	v.processor_.ProcessString(string_)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitInstanceDeclaration(
 	instanceDeclaration ast.InstanceDeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, instanceDeclaration)
 	var declaration = instanceDeclaration.GetDeclaration()
 	v.processor_.PreprocessDeclaration(
 		declaration,
@@ -1356,11 +1748,16 @@ func (v *visitor_) visitInstanceDeclaration(
 
 	var delimiter3 = instanceDeclaration.GetDelimiter3()
 	v.processor_.ProcessDelimiter(delimiter3)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitInstanceMethods(
 	instanceMethods ast.InstanceMethodsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, instanceMethods)
 	var principalSubsection = instanceMethods.GetPrincipalSubsection()
 	v.processor_.PreprocessPrincipalSubsection(
 		principalSubsection,
@@ -1413,11 +1810,16 @@ func (v *visitor_) visitInstanceMethods(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitInstanceSection(
 	instanceSection ast.InstanceSectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, instanceSection)
 	var delimiter = instanceSection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -1444,11 +1846,16 @@ func (v *visitor_) visitInstanceSection(
 			instanceDeclarationsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitInterfaceDeclarations(
 	interfaceDeclarations ast.InterfaceDeclarationsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, interfaceDeclarations)
 	var classSection = interfaceDeclarations.GetClassSection()
 	v.processor_.PreprocessClassSection(
 		classSection,
@@ -1497,18 +1904,28 @@ func (v *visitor_) visitInterfaceDeclarations(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitLegalNotice(
 	legalNotice ast.LegalNoticeLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, legalNotice)
 	var comment = legalNotice.GetComment()
 	v.processor_.ProcessComment(comment)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitMap(
 	map_ ast.MapLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, map_)
 	var delimiter1 = map_.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -1535,27 +1952,42 @@ func (v *visitor_) visitMap(
 
 	var delimiter3 = map_.GetDelimiter3()
 	v.processor_.ProcessDelimiter(delimiter3)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitMethod(
 	method ast.MethodLike,
 ) {
-	var name = method.GetName()
-	v.processor_.ProcessName(name)
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, method)
+	var optionalNote = method.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
 	// Visit slot 1 between terms.
 	v.processor_.ProcessMethodSlot(
 		method,
 		1,
 	)
 
-	var delimiter1 = method.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var name = method.GetName()
+	v.processor_.ProcessName(name)
 	// Visit slot 2 between terms.
 	v.processor_.ProcessMethodSlot(
 		method,
 		2,
 	)
 
+	var delimiter1 = method.GetDelimiter1()
+	v.processor_.ProcessDelimiter(delimiter1)
+	// Visit slot 3 between terms.
+	v.processor_.ProcessMethodSlot(
+		method,
+		3,
+	)
+
 	var optionalParameterList = method.GetOptionalParameterList()
 	if uti.IsDefined(optionalParameterList) {
 		v.processor_.PreprocessParameterList(
@@ -1570,18 +2002,18 @@ func (v *visitor_) visitMethod(
 			0,
 		)
 	}
-	// Visit slot 3 between terms.
+	// Visit slot 4 between terms.
 	v.processor_.ProcessMethodSlot(
 		method,
-		3,
+		4,
 	)
 
 	var delimiter2 = method.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
-	// Visit slot 4 between terms.
+	// Visit slot 5 between terms.
 	v.processor_.ProcessMethodSlot(
 		method,
-		4,
+		5,
 	)
 
 	var result = method.GetResult()
@@ -1596,11 +2028,16 @@ func (v *visitor_) visitMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitModel(
 	model ast.ModelLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, model)
 	var packageDeclaration = model.GetPackageDeclaration()
 	v.processor_.PreprocessPackageDeclaration(
 		packageDeclaration,
@@ -1649,11 +2086,16 @@ func (v *visitor_) visitModel(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitMultivalue(
 	multivalue ast.MultivalueLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, multivalue)
 	var delimiter1 = multivalue.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -1682,11 +2124,16 @@ func (v *visitor_) visitMultivalue(
 
 	var delimiter2 = multivalue.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitNamed(
 	named ast.NamedLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, named)
 	var optionalPrefix = named.GetOptionalPrefix()
 	if uti.IsDefined(optionalPrefix) {
 		v.processor_.ProcessPrefix(optionalPrefix)
@@ -1719,18 +2166,82 @@ func (v *visitor_) visitNamed(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitNone(
 	none ast.NoneLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, none)
 	var newline = none.GetNewline()
 	v.processor_.ProcessNewline(newline)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+
+func (v *visitor_) visitOperand(
+	operand ast.OperandLike,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, operand)
+
+	// Visit the possible operand literal values.
+	switch actual := operand.GetAny().(type) {
+	case string:
+		var scannerClass = ScannerClass()
+		switch {
+		case scannerClass.MatchesType(actual, NumberToken):
+			v.processor_.ProcessNumber(actual)
+		case scannerClass.MatchesType(actual, StringToken):
+			v.processor_.ProcessString(actual)
+		default:
+			v.processor_.ProcessDelimiter(actual)
+		}
+	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+
+func (v *visitor_) visitOperation(
+	operation ast.OperationLike,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, operation)
+	var operator = operation.GetOperator()
+	v.processor_.ProcessOperator(operator)
+	// Visit slot 1 between terms.
+	v.processor_.ProcessOperationSlot(
+		operation,
+		1,
+	)
+
+	var operand = operation.GetOperand()
+	v.processor_.PreprocessOperand(
+		operand,
+		0,
+		0,
+	)
+	v.visitOperand(operand)
+	v.processor_.PostprocessOperand(
+		operand,
+		0,
+		0,
+	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitPackageDeclaration(
 	packageDeclaration ast.PackageDeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, packageDeclaration)
 	var legalNotice = packageDeclaration.GetLegalNotice()
 	v.processor_.PreprocessLegalNotice(
 		legalNotice,
@@ -1779,11 +2290,16 @@ func (v *visitor_) visitPackageDeclaration(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitPackageHeader(
 	packageHeader ast.PackageHeaderLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, packageHeader)
 	var comment = packageHeader.GetComment()
 	v.processor_.ProcessComment(comment)
 	// Visit slot 1 between terms.
@@ -1802,11 +2318,16 @@ func (v *visitor_) visitPackageHeader(
 
 	var name = packageHeader.GetName()
 	v.processor_.ProcessName(name)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitPackageImports(
 	packageImports ast.PackageImportsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, packageImports)
 	var delimiter1 = packageImports.GetDelimiter1()
 	v.processor_.ProcessDelimiter(delimiter1)
 	// Visit slot 1 between terms.
@@ -1845,11 +2366,16 @@ func (v *visitor_) visitPackageImports(
 
 	var delimiter3 = packageImports.GetDelimiter3()
 	v.processor_.ProcessDelimiter(delimiter3)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitParameter(
 	parameter ast.ParameterLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, parameter)
 	var name = parameter.GetName()
 	v.processor_.ProcessName(name)
 	// Visit slot 1 between terms.
@@ -1878,11 +2404,16 @@ func (v *visitor_) visitParameter(
 
 	var delimiter = parameter.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitParameterList(
 	parameterList ast.ParameterListLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, parameterList)
 	var parametersIndex uint
 	var parameters = parameterList.GetParameters().GetIterator()
 	var parametersCount = uint(parameters.GetSize())
@@ -1901,11 +2432,16 @@ func (v *visitor_) visitParameterList(
 			parametersCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitPrimitiveDeclarations(
 	primitiveDeclarations ast.PrimitiveDeclarationsLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, primitiveDeclarations)
 	var typeSection = primitiveDeclarations.GetTypeSection()
 	v.processor_.PreprocessTypeSection(
 		typeSection,
@@ -1936,11 +2472,16 @@ func (v *visitor_) visitPrimitiveDeclarations(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitPrincipalMethod(
 	principalMethod ast.PrincipalMethodLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, principalMethod)
 	var method = principalMethod.GetMethod()
 	v.processor_.PreprocessMethod(
 		method,
@@ -1953,11 +2494,16 @@ func (v *visitor_) visitPrincipalMethod(
 		0,
 		0,
 	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitPrincipalSubsection(
 	principalSubsection ast.PrincipalSubsectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, principalSubsection)
 	var delimiter = principalSubsection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -1984,11 +2530,17 @@ func (v *visitor_) visitPrincipalSubsection(
 			principalMethodsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitResult(
 	result ast.ResultLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, result)
+
 	// Visit the possible result rule types.
 	switch actual := result.GetAny().(type) {
 	case ast.NoneLike:
@@ -2028,27 +2580,42 @@ func (v *visitor_) visitResult(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitSetterMethod(
 	setterMethod ast.SetterMethodLike,
 ) {
-	var name = setterMethod.GetName()
-	v.processor_.ProcessName(name)
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, setterMethod)
+	var optionalNote = setterMethod.GetOptionalNote()
+	if uti.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
 	// Visit slot 1 between terms.
 	v.processor_.ProcessSetterMethodSlot(
 		setterMethod,
 		1,
 	)
 
-	var delimiter1 = setterMethod.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var name = setterMethod.GetName()
+	v.processor_.ProcessName(name)
 	// Visit slot 2 between terms.
 	v.processor_.ProcessSetterMethodSlot(
 		setterMethod,
 		2,
 	)
 
+	var delimiter1 = setterMethod.GetDelimiter1()
+	v.processor_.ProcessDelimiter(delimiter1)
+	// Visit slot 3 between terms.
+	v.processor_.ProcessSetterMethodSlot(
+		setterMethod,
+		3,
+	)
+
 	var parameter = setterMethod.GetParameter()
 	v.processor_.PreprocessParameter(
 		parameter,
@@ -2061,26 +2628,75 @@ func (v *visitor_) visitSetterMethod(
 		0,
 		0,
 	)
-	// Visit slot 3 between terms.
+	// Visit slot 4 between terms.
 	v.processor_.ProcessSetterMethodSlot(
 		setterMethod,
-		3,
+		4,
 	)
 
 	var delimiter2 = setterMethod.GetDelimiter2()
 	v.processor_.ProcessDelimiter(delimiter2)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
+}
+
+func (v *visitor_) visitSpecification(
+	specification ast.SpecificationLike,
+) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, specification)
+
+	// Visit the possible specification rule types.
+	switch actual := specification.GetAny().(type) {
+	case ast.NoneLike:
+		v.processor_.PreprocessNone(
+			actual,
+			0,
+			0,
+		)
+		v.visitNone(actual)
+		v.processor_.PostprocessNone(
+			actual,
+			0,
+			0,
+		)
+	case ast.AssignmentLike:
+		v.processor_.PreprocessAssignment(
+			actual,
+			0,
+			0,
+		)
+		v.visitAssignment(actual)
+		v.processor_.PostprocessAssignment(
+			actual,
+			0,
+			0,
+		)
+	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitStar(
 	star ast.StarLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, star)
 	var delimiter = star.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitType(
 	type_ ast.TypeLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, type_)
+
 	// Visit the possible type rule types.
 	switch actual := type_.GetAny().(type) {
 	case ast.NamedLike:
@@ -2108,11 +2724,16 @@ func (v *visitor_) visitType(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitTypeDeclaration(
 	typeDeclaration ast.TypeDeclarationLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, typeDeclaration)
 	var declaration = typeDeclaration.GetDeclaration()
 	v.processor_.PreprocessDeclaration(
 		declaration,
@@ -2163,11 +2784,16 @@ func (v *visitor_) visitTypeDeclaration(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitTypeSection(
 	typeSection ast.TypeSectionLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, typeSection)
 	var delimiter = typeSection.GetDelimiter()
 	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 1 between terms.
@@ -2194,11 +2820,16 @@ func (v *visitor_) visitTypeSection(
 			typeDeclarationsCount,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitValue(
 	value ast.ValueLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, value)
 	var name = value.GetName()
 	v.processor_.ProcessName(name)
 	// Visit slot 1 between terms.
@@ -2225,21 +2856,37 @@ func (v *visitor_) visitValue(
 		2,
 	)
 
-	var delimiter1 = value.GetDelimiter1()
-	v.processor_.ProcessDelimiter(delimiter1)
+	var delimiter = value.GetDelimiter()
+	v.processor_.ProcessDelimiter(delimiter)
 	// Visit slot 3 between terms.
 	v.processor_.ProcessValueSlot(
 		value,
 		3,
 	)
 
-	var delimiter2 = value.GetDelimiter2()
-	v.processor_.ProcessDelimiter(delimiter2)
+	var expression = value.GetExpression()
+	v.processor_.PreprocessExpression(
+		expression,
+		0,
+		0,
+	)
+	v.visitExpression(expression)
+	v.processor_.PostprocessExpression(
+		expression,
+		0,
+		0,
+	)
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 func (v *visitor_) visitWrapper(
 	wrapper ast.WrapperLike,
 ) {
+	if v.isSkipping() {
+		return
+	}
+	v.ancestors_ = append(v.ancestors_, wrapper)
+
 	// Visit the possible wrapper rule types.
 	switch actual := wrapper.GetAny().(type) {
 	case ast.DotsLike:
@@ -2303,6 +2950,7 @@ func (v *visitor_) visitWrapper(
 			0,
 		)
 	}
+	v.ancestors_ = v.ancestors_[:len(v.ancestors_)-1]
 }
 
 // Instance Structure
@@ -2310,6 +2958,9 @@ func (v *visitor_) visitWrapper(
 type visitor_ struct {
 	// Declare the instance attributes.
 	processor_ Methodical
+	ancestors_ []any
+	skipping_  bool
+	stopped_   bool
 }
 
 // Class Structure
@@ -25,8 +25,15 @@ abstract syntax tree (AST) for this module:
   - Parser is used to process the token stream and generate the AST.
   - Validator is used to validate the semantics associated with an AST.
   - Formatter is used to format an AST back into a canonical version of its source.
+  - Encoder is used to encode an AST as a lossless JSON document.
+  - Decoder is used to decode a JSON document back into the AST that it encodes.
   - Visitor walks the AST and calls processor methods for each node in the tree.
   - Processor provides empty processor methods to be inherited by the processors.
+  - Inspector forwards each processor method to a pair of inspection functions.
+  - CompositeProcessor forwards each processor method to a list of processors.
+  - Transformer rebuilds an AST, replacing each rule with a transformed rule.
+  - ConcreteToken captures a parsed token together with its surrounding trivia.
+  - ConcreteSyntax is used to edit an AST without losing the layout of its source.
 
 For detailed documentation on this package refer to the wiki:
   - https://github.com/craterdog/go-class-model/wiki
@@ -61,15 +68,112 @@ const (
 	DelimiterToken
 	NameToken
 	NewlineToken
-	PathToken
+	NoteToken
+	NumberToken
+	OperatorToken
 	PrefixToken
 	SpaceToken
+	StringToken
 )
 
 // FUNCTIONAL DECLARATIONS
 
+/*
+InspectionFunction is a functional type that defines the signature for any
+function that inspects a node in an abstract syntax tree.  The node is either
+an AST rule or a token string, and its name is the name of the matching rule
+(e.g. "SetterMethod") or token type (e.g. "name").
+*/
+type InspectionFunction func(
+	node any,
+	name string,
+)
+
+/*
+RenderingFunction is a functional type that defines the signature for any
+function that renders a fragment of an inline type signature.  The fragment is
+either a type name (including its prefix, if any), a parameter name or a
+delimiter, and its name is the name of the matching rule ("Named" or
+"Parameter") or token type ("delimiter").  A parameter name that is rendered as
+an empty string is omitted from the signature.
+*/
+type RenderingFunction func(
+	fragment string,
+	name string,
+) string
+
+/*
+TransformationFunction is a functional type that defines the signature for any
+function that transforms a rule in an abstract syntax tree.  The rule has
+already been rebuilt from its transformed children, and its name is the name of
+the matching rule (e.g. "Named").  The function returns the rule that replaces
+it, which must implement the same rule interface.
+*/
+type TransformationFunction func(
+	rule any,
+	name string,
+) any
+
 // CLASS DECLARATIONS
 
+/*
+CompositeProcessorClassLike is a class interface that declares the complete set
+of class constructors, constants and functions that must be supported by each
+concrete composite-processor-like class.
+*/
+type CompositeProcessorClassLike interface {
+	// Constructor Methods
+	CompositeProcessor(
+		processors com.Sequential[Methodical],
+	) CompositeProcessorLike
+}
+
+/*
+ConcreteSyntaxClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete concrete-syntax-like class.
+*/
+type ConcreteSyntaxClassLike interface {
+	// Constructor Methods
+	ConcreteSyntax(
+		source string,
+	) ConcreteSyntaxLike
+}
+
+/*
+ConcreteTokenClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete concrete-token-like class.
+*/
+type ConcreteTokenClassLike interface {
+	// Constructor Methods
+	ConcreteToken(
+		leading string,
+		token TokenLike,
+		trailing string,
+	) ConcreteTokenLike
+}
+
+/*
+DecoderClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete decoder-like class.
+*/
+type DecoderClassLike interface {
+	// Constructor Methods
+	Decoder() DecoderLike
+}
+
+/*
+EncoderClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete encoder-like class.
+*/
+type EncoderClassLike interface {
+	// Constructor Methods
+	Encoder() EncoderLike
+}
+
 /*
 FormatterClassLike is a class interface that declares the complete set of
 class constructors, constants and functions that must be supported by each
@@ -78,6 +182,22 @@ concrete formatter-like class.
 type FormatterClassLike interface {
 	// Constructor Methods
 	Formatter() FormatterLike
+	FormatterWithRendering(
+		rendering RenderingFunction,
+	) FormatterLike
+}
+
+/*
+InspectorClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete inspector-like class.
+*/
+type InspectorClassLike interface {
+	// Constructor Methods
+	Inspector(
+		preprocess InspectionFunction,
+		postprocess InspectionFunction,
+	) InspectorLike
 }
 
 /*
@@ -146,6 +266,18 @@ type TokenClassLike interface {
 	) TokenLike
 }
 
+/*
+TransformerClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete transformer-like class.
+*/
+type TransformerClassLike interface {
+	// Constructor Methods
+	Transformer(
+		transformation TransformationFunction,
+	) TransformerLike
+}
+
 /*
 ValidatorClassLike is a class interface that declares the complete set of
 class constructors, constants and functions that must be supported by each
@@ -170,10 +302,105 @@ type VisitorClassLike interface {
 
 // INSTANCE DECLARATIONS
 
+/*
+CompositeProcessorLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete composite-processor-like class.  Each processor method
+is forwarded to each of the child processors in the order in which they appear
+in the list, allowing many processors to share a single traversal of the AST.
+A child processor that panics is removed from the remainder of the traversal
+and its failure message is recorded under its (one-based) index in the list,
+while the remaining child processors continue unaffected.
+*/
+type CompositeProcessorLike interface {
+	// Principal Methods
+	GetClass() CompositeProcessorClassLike
+
+	// Attribute Methods
+	GetProcessors() com.Sequential[Methodical]
+	GetFailures() com.CatalogLike[uint, string]
+
+	// Aspect Interfaces
+	Methodical
+}
+
+/*
+ConcreteSyntaxLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete concrete-syntax-like class.  It retains every token of the source
+code, including the spaces and newlines that the parser ignores, alongside the
+AST that was parsed from it.  An edited version of the AST is formatted back
+into source code by aligning its canonically formatted tokens with the original
+tokens.  The original trivia is reused between each pair of unchanged tokens and
+the canonical trivia is used around each edited token, leaving the layout of the
+rest of the source code unchanged.
+*/
+type ConcreteSyntaxLike interface {
+	// Principal Methods
+	GetClass() ConcreteSyntaxClassLike
+	FormatModel(
+		model ast.ModelLike,
+	) string
+
+	// Attribute Methods
+	GetSource() string
+	GetModel() ast.ModelLike
+	GetTokens() com.Sequential[ConcreteTokenLike]
+}
+
+/*
+ConcreteTokenLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete concrete-token-like class.  The trailing trivia of a token
+includes any spaces that follow it on the same line and the newline that ends
+that line.  All other trivia belongs to the leading trivia of the next token.
+*/
+type ConcreteTokenLike interface {
+	// Principal Methods
+	GetClass() ConcreteTokenClassLike
+
+	// Attribute Methods
+	GetLeading() string
+	GetToken() TokenLike
+	GetTrailing() string
+}
+
+/*
+DecoderLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete decoder-like class.  The JSON document must conform to
+the "model.schema.json" schema.
+*/
+type DecoderLike interface {
+	// Principal Methods
+	GetClass() DecoderClassLike
+	DecodeModel(
+		source string,
+	) ast.ModelLike
+}
+
+/*
+EncoderLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete encoder-like class.  Each node in the AST is encoded as a
+JSON object containing its "kind" and its attributes.
+*/
+type EncoderLike interface {
+	// Principal Methods
+	GetClass() EncoderClassLike
+	EncodeModel(
+		model ast.ModelLike,
+	) string
+}
+
 /*
 FormatterLike is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
-instance of a concrete formatter-like class.
+instance of a concrete formatter-like class.  Besides whole models, a formatter
+renders abstractions, parameter lists and results as inline type signatures
+(e.g. "(name string, values ...int) (result bool)"), passing each fragment to
+its rendering function, if any.  A formatted result includes its leading space
+and is empty for a result of None.
 */
 type FormatterLike interface {
 	// Principal Methods
@@ -181,6 +408,31 @@ type FormatterLike interface {
 	FormatModel(
 		model ast.ModelLike,
 	) string
+	FormatAbstraction(
+		abstraction ast.AbstractionLike,
+	) string
+	FormatParameterList(
+		parameterList ast.ParameterListLike,
+	) string
+	FormatResult(
+		result ast.ResultLike,
+	) string
+
+	// Aspect Interfaces
+	Methodical
+}
+
+/*
+InspectorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete inspector-like class.  Each rule that is visited is
+passed to the preprocess function before its children are visited and to the
+postprocess function afterwards.  Each token is passed to both functions in
+turn.
+*/
+type InspectorLike interface {
+	// Principal Methods
+	GetClass() InspectorClassLike
 
 	// Aspect Interfaces
 	Methodical
@@ -238,6 +490,21 @@ type TokenLike interface {
 	GetValue() string
 }
 
+/*
+TransformerLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete transformer-like class.  Each rule in the AST is rebuilt
+from its transformed children and passed to the transformation function, which
+returns the rule to be used in its place.  The original AST is left unchanged.
+*/
+type TransformerLike interface {
+	// Principal Methods
+	GetClass() TransformerClassLike
+	TransformModel(
+		model ast.ModelLike,
+	) ast.ModelLike
+}
+
 /*
 ValidatorLike is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
@@ -258,6 +525,18 @@ type ValidatorLike interface {
 VisitorLike is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
 instance of a concrete visitor-like class.
+
+A processor that holds a reference to its visitor may control the traversal
+from within its preprocess methods.  Calling SkipChildren() skips the children
+of the node being preprocessed, although its postprocess method is still
+called.  Calling StopVisiting() abandons the rest of the traversal, and no
+further processor methods are called.  Each call to VisitModel() starts a new
+traversal.
+
+A processor may also call GetAncestors() to determine the context of the node
+being processed.  It returns the rules enclosing that node, starting with the
+model itself and ending with the parent of the node.  For example, a processor
+can tell whether a parameter belongs to a constructor or a setter method.
 */
 type VisitorLike interface {
 	// Principal Methods
@@ -265,6 +544,9 @@ type VisitorLike interface {
 	VisitModel(
 		model ast.ModelLike,
 	)
+	GetAncestors() com.Sequential[any]
+	SkipChildren()
+	StopVisiting()
 }
 
 // ASPECT DECLARATIONS
@@ -286,8 +568,14 @@ type Methodical interface {
 	ProcessNewline(
 		newline string,
 	)
-	ProcessPath(
-		path string,
+	ProcessNote(
+		note string,
+	)
+	ProcessNumber(
+		number string,
+	)
+	ProcessOperator(
+		operator string,
 	)
 	ProcessPrefix(
 		prefix string,
@@ -295,6 +583,9 @@ type Methodical interface {
 	ProcessSpace(
 		space string,
 	)
+	ProcessString(
+		string_ string,
+	)
 	PreprocessAbstraction(
 		abstraction ast.AbstractionLike,
 		index_ uint,
@@ -421,6 +712,20 @@ type Methodical interface {
 		aspectInterface ast.AspectInterfaceLike,
 		slot_ uint,
 	)
+	PreprocessAspectMember(
+		aspectMember ast.AspectMemberLike,
+		index_ uint,
+		count_ uint,
+	)
+	PostprocessAspectMember(
+		aspectMember ast.AspectMemberLike,
+		index_ uint,
+		count_ uint,
+	)
+	ProcessAspectMemberSlot(
+		aspectMember ast.AspectMemberLike,
+		slot_ uint,
+	)
 	PreprocessAspectMethod(
 		aspectMethod ast.AspectMethodLike,
 		index_ uint,
@@ -463,6 +768,20 @@ type Methodical interface {
 		aspectSubsection ast.AspectSubsectionLike,
 		slot_ uint,
 	)
+	PreprocessAssignment(
+		assignment ast.AssignmentLike,
+		index_ uint,
+		count_ uint,
+	)
+	PostprocessAssignment(
+		assignment ast.AssignmentLike,
+		index_ uint,
+		count_ uint,
+	)
+	ProcessAssignmentSlot(
+		assignment ast.AssignmentLike,
+		slot_ uint,
+	)
 	PreprocessAttributeMethod(
 		attributeMethod ast.AttributeMethodLike,
 		index_ uint,
@@ -673,6 +992,20 @@ type Methodical interface {
 		enumeration ast.EnumerationLike,
 		slot_ uint,
 	)
+	PreprocessExpression(
+		expression ast.ExpressionLike,
+		index_ uint,
+		count_ uint,
+	)
+	PostprocessExpression(
+		expression ast.ExpressionLike,
+		index_ uint,
+		count_ uint,
+	)
+	ProcessExpressionSlot(
+		expression ast.ExpressionLike,
+		slot_ uint,
+	)
 	PreprocessFunctionMethod(
 		functionMethod ast.FunctionMethodLike,
 		index_ uint,
@@ -939,6 +1272,34 @@ type Methodical interface {
 		none ast.NoneLike,
 		slot_ uint,
 	)
+	PreprocessOperand(
+		operand ast.OperandLike,
+		index_ uint,
+		count_ uint,
+	)
+	PostprocessOperand(
+		operand ast.OperandLike,
+		index_ uint,
+		count_ uint,
+	)
+	ProcessOperandSlot(
+		operand ast.OperandLike,
+		slot_ uint,
+	)
+	PreprocessOperation(
+		operation ast.OperationLike,
+		index_ uint,
+		count_ uint,
+	)
+	PostprocessOperation(
+		operation ast.OperationLike,
+		index_ uint,
+		count_ uint,
+	)
+	ProcessOperationSlot(
+		operation ast.OperationLike,
+		slot_ uint,
+	)
 	PreprocessPackageDeclaration(
 		packageDeclaration ast.PackageDeclarationLike,
 		index_ uint,
@@ -1079,6 +1440,20 @@ type Methodical interface {
 		setterMethod ast.SetterMethodLike,
 		slot_ uint,
 	)
+	PreprocessSpecification(
+		specification ast.SpecificationLike,
+		index_ uint,
+		count_ uint,
+	)
+	PostprocessSpecification(
+		specification ast.SpecificationLike,
+		index_ uint,
+		count_ uint,
+	)
+	ProcessSpecificationSlot(
+		specification ast.SpecificationLike,
+		slot_ uint,
+	)
 	PreprocessStar(
 		star ast.StarLike,
 		index_ uint,
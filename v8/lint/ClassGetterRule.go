@@ -0,0 +1,250 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ClassGetterRuleClass() ClassGetterRuleClassLike {
+	return classGetterRuleClass()
+}
+
+// Constructor Methods
+
+func (c *classGetterRuleClass_) ClassGetterRule() ClassGetterRuleLike {
+	var instance = &classGetterRule_{
+		// Initialize the instance attributes.
+		options_:     com.Catalog[string, string](),
+		diagnostics_: com.List[DiagnosticLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *classGetterRule_) GetClass() ClassGetterRuleClassLike {
+	return classGetterRuleClass()
+}
+
+// Lintable Methods
+
+func (v *classGetterRule_) GetIdentifier() string {
+	return classGetterRuleClass().identifier_
+}
+
+func (v *classGetterRule_) GetDefaultSeverity() Severity {
+	return classGetterRuleClass().severity_
+}
+
+func (v *classGetterRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *classGetterRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *classGetterRule_) PreprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var declaration = instanceDeclaration.GetDeclaration()
+	var name = declaration.GetName()
+	if !sts.HasSuffix(name, "Like") || v.hasGetter(instanceDeclaration) {
+		return
+	}
+	var message = fmt.Sprintf(
+		"An instance declaration must declare a GetClass() principal method: %s",
+		name,
+	)
+	v.diagnostics_.AppendValue(
+		DiagnosticClass().Diagnostic(
+			v.GetIdentifier(),
+			v.GetDefaultSeverity(),
+			declaration.GetComment(),
+			message,
+			func(model ast.ModelLike) ast.ModelLike {
+				return v.insertGetter(model, name)
+			},
+		),
+	)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *classGetterRule_) hasGetter(
+	instanceDeclaration ast.InstanceDeclarationLike,
+) bool {
+	var instanceMethods = instanceDeclaration.GetInstanceMethods()
+	var principalSubsection = instanceMethods.GetPrincipalSubsection()
+	var principalMethods = principalSubsection.GetPrincipalMethods().GetIterator()
+	for principalMethods.HasNext() {
+		if principalMethods.GetNext().GetMethod().GetName() == "GetClass" {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *classGetterRule_) insertGetter(
+	model ast.ModelLike,
+	name string,
+) ast.ModelLike {
+	// The getter is inserted as the first principal method of the declaration.
+	var transformation = func(node any, rule string) any {
+		var instanceDeclaration, ok = node.(ast.InstanceDeclarationLike)
+		if !ok || v.hasGetter(instanceDeclaration) {
+			return node
+		}
+		var declaration = instanceDeclaration.GetDeclaration()
+		if declaration.GetName() != name {
+			return node
+		}
+		var principalMethods = com.List[ast.PrincipalMethodLike]()
+		principalMethods.AppendValue(
+			ast.PrincipalMethodClass().PrincipalMethod(v.makeGetter(declaration)),
+		)
+		var instanceMethods = instanceDeclaration.GetInstanceMethods()
+		var principalSubsection = instanceMethods.GetPrincipalSubsection()
+		principalMethods.AppendValues(principalSubsection.GetPrincipalMethods())
+		return ast.InstanceDeclarationClass().InstanceDeclaration(
+			declaration,
+			instanceDeclaration.GetDelimiter1(),
+			instanceDeclaration.GetDelimiter2(),
+			ast.InstanceMethodsClass().InstanceMethods(
+				ast.PrincipalSubsectionClass().PrincipalSubsection(
+					principalSubsection.GetDelimiter(),
+					principalMethods,
+				),
+				instanceMethods.GetOptionalAttributeSubsection(),
+				instanceMethods.GetOptionalAspectSubsection(),
+			),
+			instanceDeclaration.GetDelimiter3(),
+		)
+	}
+	var transformer = gra.TransformerClass().Transformer(transformation)
+	return transformer.TransformModel(model)
+}
+
+func (v *classGetterRule_) makeAbstraction(
+	name string,
+	arguments ast.ArgumentsLike,
+) ast.AbstractionLike {
+	return ast.AbstractionClass().Abstraction(
+		nil,
+		ast.TypeClass().Type(
+			ast.NamedClass().Named("", name, arguments),
+		),
+	)
+}
+
+func (v *classGetterRule_) makeGetter(
+	declaration ast.DeclarationLike,
+) ast.MethodLike {
+	// A generic class is returned with the type parameters of its instances.
+	var arguments ast.ArgumentsLike
+	var constraints = declaration.GetOptionalConstraints()
+	if uti.IsDefined(constraints) {
+		var argument = ast.ArgumentClass().Argument(
+			v.makeAbstraction(constraints.GetConstraint().GetName(), nil),
+		)
+		var additionalArguments = com.List[ast.AdditionalArgumentLike]()
+		var additionalConstraints = constraints.GetAdditionalConstraints().GetIterator()
+		for additionalConstraints.HasNext() {
+			var constraint = additionalConstraints.GetNext().GetConstraint()
+			additionalArguments.AppendValue(
+				ast.AdditionalArgumentClass().AdditionalArgument(
+					",",
+					ast.ArgumentClass().Argument(
+						v.makeAbstraction(constraint.GetName(), nil),
+					),
+				),
+			)
+		}
+		arguments = ast.ArgumentsClass().Arguments(
+			"[",
+			argument,
+			additionalArguments,
+			"]",
+		)
+	}
+	var className = sts.TrimSuffix(declaration.GetName(), "Like") + "ClassLike"
+	return ast.MethodClass().Method(
+		"",
+		"GetClass",
+		"(",
+		nil,
+		")",
+		ast.ResultClass().Result(v.makeAbstraction(className, arguments)),
+	)
+}
+
+// Instance Structure
+
+type classGetterRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type classGetterRuleClass_ struct {
+	// Declare the class constants.
+	identifier_ string
+	severity_   Severity
+}
+
+// Class Reference
+
+func classGetterRuleClass() *classGetterRuleClass_ {
+	return classGetterRuleClassReference_
+}
+
+var classGetterRuleClassReference_ = &classGetterRuleClass_{
+	// Initialize the class constants.
+	identifier_: "class-getter",
+	severity_:   WarningSeverity,
+}
@@ -0,0 +1,184 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	yam "gopkg.in/yaml.v3"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ConfigurationClass() ConfigurationClassLike {
+	return configurationClass()
+}
+
+// Constructor Methods
+
+func (c *configurationClass_) Configuration() ConfigurationLike {
+	var instance = &configuration_{
+		// Initialize the instance attributes.
+		severities_: com.Catalog[string, Severity](),
+		options_:    com.Catalog[string, com.CatalogLike[string, string]](),
+	}
+	return instance
+}
+
+func (c *configurationClass_) ConfigurationFromSource(
+	source string,
+) ConfigurationLike {
+	if uti.IsUndefined(source) {
+		panic("The \"source\" attribute is required by this class.")
+	}
+	var document struct {
+		Rules map[string]struct {
+			Severity string            `yaml:"severity"`
+			Options  map[string]string `yaml:"options"`
+		} `yaml:"rules"`
+	}
+	var err = yam.Unmarshal([]byte(source), &document)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The lint configuration is not valid YAML: %v",
+			err,
+		)
+		panic(message)
+	}
+	var instance = c.Configuration()
+	for identifier, rule := range document.Rules {
+		if len(rule.Severity) > 0 {
+			instance.SetSeverity(identifier, c.parseSeverity(rule.Severity))
+		}
+		for name, value := range rule.Options {
+			instance.SetOption(identifier, name, value)
+		}
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *configuration_) GetClass() ConfigurationClassLike {
+	return configurationClass()
+}
+
+func (v *configuration_) GetIdentifiers() com.Sequential[string] {
+	var identifiers = com.Set[string]()
+	identifiers.AddValues(v.severities_.GetKeys())
+	identifiers.AddValues(v.options_.GetKeys())
+	return identifiers
+}
+
+func (v *configuration_) GetSeverity(
+	identifier string,
+) (
+	severity Severity,
+	found bool,
+) {
+	severity, found = v.severities_.AsMap()[identifier]
+	return
+}
+
+func (v *configuration_) SetSeverity(
+	identifier string,
+	severity Severity,
+) {
+	v.severities_.SetValue(identifier, severity)
+}
+
+func (v *configuration_) GetOptions(
+	identifier string,
+) com.CatalogLike[string, string] {
+	var options = v.options_.GetValue(identifier)
+	if uti.IsUndefined(options) {
+		options = com.Catalog[string, string]()
+	}
+	return options
+}
+
+func (v *configuration_) SetOption(
+	identifier string,
+	name string,
+	value string,
+) {
+	var options = v.options_.GetValue(identifier)
+	if uti.IsUndefined(options) {
+		options = com.Catalog[string, string]()
+		v.options_.SetValue(identifier, options)
+	}
+	options.SetValue(name, value)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (c *configurationClass_) parseSeverity(
+	name string,
+) Severity {
+	var severity, found = c.severities_[name]
+	if !found {
+		var message = fmt.Sprintf(
+			"The lint configuration contains an unknown severity: %s",
+			name,
+		)
+		panic(message)
+	}
+	return severity
+}
+
+// Instance Structure
+
+type configuration_ struct {
+	// Declare the instance attributes.
+	severities_ com.CatalogLike[string, Severity]
+	options_    com.CatalogLike[string, com.CatalogLike[string, string]]
+}
+
+// Class Structure
+
+type configurationClass_ struct {
+	// Declare the class constants.
+	severities_ map[string]Severity
+}
+
+// Class Reference
+
+func configurationClass() *configurationClass_ {
+	return configurationClassReference_
+}
+
+var configurationClassReference_ = &configurationClass_{
+	// Initialize the class constants.
+	severities_: map[string]Severity{
+		"off":     OffSeverity,
+		"info":    InfoSeverity,
+		"warning": WarningSeverity,
+		"error":   ErrorSeverity,
+	},
+}
@@ -0,0 +1,140 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DeclarationCommentRuleClass() DeclarationCommentRuleClassLike {
+	return declarationCommentRuleClass()
+}
+
+// Constructor Methods
+
+func (c *declarationCommentRuleClass_) DeclarationCommentRule() DeclarationCommentRuleLike {
+	var instance = &declarationCommentRule_{
+		// Initialize the instance attributes.
+		options_:     com.Catalog[string, string](),
+		diagnostics_: com.List[DiagnosticLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *declarationCommentRule_) GetClass() DeclarationCommentRuleClassLike {
+	return declarationCommentRuleClass()
+}
+
+// Lintable Methods
+
+func (v *declarationCommentRule_) GetIdentifier() string {
+	return declarationCommentRuleClass().identifier_
+}
+
+func (v *declarationCommentRule_) GetDefaultSeverity() Severity {
+	return declarationCommentRuleClass().severity_
+}
+
+func (v *declarationCommentRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *declarationCommentRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *declarationCommentRule_) PreprocessDeclaration(
+	declaration ast.DeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	var name = declaration.GetName()
+	var comment = declaration.GetComment()
+	var text = sts.TrimSpace(sts.TrimPrefix(comment, "/*"))
+	if !sts.HasPrefix(text, name+" ") && !sts.HasPrefix(text, name+"[") {
+		var message = fmt.Sprintf(
+			"The comment for a declaration must start with the declared name: %s",
+			name,
+		)
+		v.diagnostics_.AppendValue(
+			DiagnosticClass().Diagnostic(
+				v.GetIdentifier(),
+				v.GetDefaultSeverity(),
+				comment,
+				message,
+				nil,
+			),
+		)
+	}
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type declarationCommentRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type declarationCommentRuleClass_ struct {
+	// Declare the class constants.
+	identifier_ string
+	severity_   Severity
+}
+
+// Class Reference
+
+func declarationCommentRuleClass() *declarationCommentRuleClass_ {
+	return declarationCommentRuleClassReference_
+}
+
+var declarationCommentRuleClassReference_ = &declarationCommentRuleClass_{
+	// Initialize the class constants.
+	identifier_: "declaration-comment",
+	severity_:   WarningSeverity,
+}
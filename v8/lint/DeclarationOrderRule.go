@@ -0,0 +1,291 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DeclarationOrderRuleClass() DeclarationOrderRuleClassLike {
+	return declarationOrderRuleClass()
+}
+
+// Constructor Methods
+
+func (c *declarationOrderRuleClass_) DeclarationOrderRule() DeclarationOrderRuleLike {
+	var instance = &declarationOrderRule_{
+		// Initialize the instance attributes.
+		options_:     com.Catalog[string, string](),
+		diagnostics_: com.List[DiagnosticLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *declarationOrderRule_) GetClass() DeclarationOrderRuleClassLike {
+	return declarationOrderRuleClass()
+}
+
+// Lintable Methods
+
+func (v *declarationOrderRule_) GetIdentifier() string {
+	return declarationOrderRuleClass().identifier_
+}
+
+func (v *declarationOrderRule_) GetDefaultSeverity() Severity {
+	return declarationOrderRuleClass().severity_
+}
+
+func (v *declarationOrderRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *declarationOrderRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *declarationOrderRule_) PreprocessAspectDeclaration(
+	aspectDeclaration ast.AspectDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.checkOrder(aspectDeclaration.GetDeclaration(), "", index_)
+}
+
+func (v *declarationOrderRule_) PreprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.checkOrder(classDeclaration.GetDeclaration(), "ClassLike", index_)
+}
+
+func (v *declarationOrderRule_) PreprocessFunctionalDeclaration(
+	functionalDeclaration ast.FunctionalDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.checkOrder(functionalDeclaration.GetDeclaration(), "Function", index_)
+}
+
+func (v *declarationOrderRule_) PreprocessInstanceDeclaration(
+	instanceDeclaration ast.InstanceDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.checkOrder(instanceDeclaration.GetDeclaration(), "Like", index_)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *declarationOrderRule_) checkOrder(
+	declaration ast.DeclarationLike,
+	suffix string,
+	index uint,
+) {
+	var name = sts.TrimSuffix(declaration.GetName(), suffix)
+	if index == 1 {
+		// This is the first declaration in a new section.
+		v.previous_ = name
+		return
+	}
+	if name >= v.previous_ {
+		v.previous_ = name
+		return
+	}
+	var message = fmt.Sprintf(
+		"A declaration is not in alphabetical order within its section: %s",
+		declaration.GetName(),
+	)
+	v.diagnostics_.AppendValue(
+		DiagnosticClass().Diagnostic(
+			v.GetIdentifier(),
+			v.GetDefaultSeverity(),
+			declaration.GetComment(),
+			message,
+			v.sortModel,
+		),
+	)
+}
+
+func (v *declarationOrderRule_) rankDeclarations(
+	first ast.DeclarationLike,
+	second ast.DeclarationLike,
+	suffix string,
+) com.Rank {
+	var firstName = sts.TrimSuffix(first.GetName(), suffix)
+	var secondName = sts.TrimSuffix(second.GetName(), suffix)
+	switch {
+	case firstName < secondName:
+		return com.LesserRank
+	case firstName > secondName:
+		return com.GreaterRank
+	default:
+		return com.EqualRank
+	}
+}
+
+func (v *declarationOrderRule_) sortModel(
+	model ast.ModelLike,
+) ast.ModelLike {
+	// Sort copies of the declaration lists so that the model is not modified.
+	var primitiveDeclarations = model.GetPrimitiveDeclarations()
+	var functionalSection = primitiveDeclarations.GetFunctionalSection()
+	var functionalDeclarations = com.ListFromSequence(
+		functionalSection.GetFunctionalDeclarations(),
+	)
+	functionalDeclarations.SortValuesWithRanker(
+		func(
+			first ast.FunctionalDeclarationLike,
+			second ast.FunctionalDeclarationLike,
+		) com.Rank {
+			return v.rankDeclarations(
+				first.GetDeclaration(),
+				second.GetDeclaration(),
+				"Function",
+			)
+		},
+	)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var classSection = interfaceDeclarations.GetClassSection()
+	var classDeclarations = com.ListFromSequence(
+		classSection.GetClassDeclarations(),
+	)
+	classDeclarations.SortValuesWithRanker(
+		func(
+			first ast.ClassDeclarationLike,
+			second ast.ClassDeclarationLike,
+		) com.Rank {
+			return v.rankDeclarations(
+				first.GetDeclaration(),
+				second.GetDeclaration(),
+				"ClassLike",
+			)
+		},
+	)
+	var instanceSection = interfaceDeclarations.GetInstanceSection()
+	var instanceDeclarations = com.ListFromSequence(
+		instanceSection.GetInstanceDeclarations(),
+	)
+	instanceDeclarations.SortValuesWithRanker(
+		func(
+			first ast.InstanceDeclarationLike,
+			second ast.InstanceDeclarationLike,
+		) com.Rank {
+			return v.rankDeclarations(
+				first.GetDeclaration(),
+				second.GetDeclaration(),
+				"Like",
+			)
+		},
+	)
+	var aspectSection = interfaceDeclarations.GetAspectSection()
+	var aspectDeclarations = com.ListFromSequence(
+		aspectSection.GetAspectDeclarations(),
+	)
+	aspectDeclarations.SortValuesWithRanker(
+		func(
+			first ast.AspectDeclarationLike,
+			second ast.AspectDeclarationLike,
+		) com.Rank {
+			return v.rankDeclarations(
+				first.GetDeclaration(),
+				second.GetDeclaration(),
+				"",
+			)
+		},
+	)
+	return ast.ModelClass().Model(
+		model.GetPackageDeclaration(),
+		ast.PrimitiveDeclarationsClass().PrimitiveDeclarations(
+			primitiveDeclarations.GetTypeSection(),
+			ast.FunctionalSectionClass().FunctionalSection(
+				functionalSection.GetDelimiter(),
+				functionalDeclarations,
+			),
+		),
+		ast.InterfaceDeclarationsClass().InterfaceDeclarations(
+			ast.ClassSectionClass().ClassSection(
+				classSection.GetDelimiter(),
+				classDeclarations,
+			),
+			ast.InstanceSectionClass().InstanceSection(
+				instanceSection.GetDelimiter(),
+				instanceDeclarations,
+			),
+			ast.AspectSectionClass().AspectSection(
+				aspectSection.GetDelimiter(),
+				aspectDeclarations,
+			),
+		),
+	)
+}
+
+// Instance Structure
+
+type declarationOrderRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+	previous_    string
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type declarationOrderRuleClass_ struct {
+	// Declare the class constants.
+	identifier_ string
+	severity_   Severity
+}
+
+// Class Reference
+
+func declarationOrderRuleClass() *declarationOrderRuleClass_ {
+	return declarationOrderRuleClassReference_
+}
+
+var declarationOrderRuleClassReference_ = &declarationOrderRuleClass_{
+	// Initialize the class constants.
+	identifier_: "declaration-order",
+	severity_:   WarningSeverity,
+}
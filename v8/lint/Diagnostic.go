@@ -0,0 +1,162 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DiagnosticClass() DiagnosticClassLike {
+	return diagnosticClass()
+}
+
+// Constructor Methods
+
+func (c *diagnosticClass_) Diagnostic(
+	identifier string,
+	severity Severity,
+	comment string,
+	message string,
+	optionalEdit EditFunction,
+) DiagnosticLike {
+	if uti.IsUndefined(identifier) {
+		panic("The \"identifier\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(message) {
+		panic("The \"message\" attribute is required by this class.")
+	}
+	var instance = &diagnostic_{
+		// Initialize the instance attributes.
+		identifier_:   identifier,
+		severity_:     severity,
+		comment_:      comment,
+		message_:      message,
+		optionalEdit_: optionalEdit,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *diagnostic_) GetClass() DiagnosticClassLike {
+	return diagnosticClass()
+}
+
+func (v *diagnostic_) AsString() string {
+	return fmt.Sprintf(
+		"%s[%s]: %s",
+		diagnosticClass().severities_[v.severity_],
+		v.identifier_,
+		v.message_,
+	)
+}
+
+func (v *diagnostic_) IsSuppressed() bool {
+	var lines = sts.Split(v.comment_, "\n")
+	for _, line := range lines {
+		var _, identifiers, found = sts.Cut(line, diagnosticClass().marker_)
+		if !found {
+			continue
+		}
+		var fields = sts.Fields(identifiers)
+		if len(fields) == 0 {
+			// The marker suppresses all lint rules.
+			return true
+		}
+		for _, field := range fields {
+			if field == v.identifier_ {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Attribute Methods
+
+func (v *diagnostic_) GetIdentifier() string {
+	return v.identifier_
+}
+
+func (v *diagnostic_) GetSeverity() Severity {
+	return v.severity_
+}
+
+func (v *diagnostic_) GetComment() string {
+	return v.comment_
+}
+
+func (v *diagnostic_) GetMessage() string {
+	return v.message_
+}
+
+func (v *diagnostic_) GetOptionalEdit() EditFunction {
+	return v.optionalEdit_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type diagnostic_ struct {
+	// Declare the instance attributes.
+	identifier_   string
+	severity_     Severity
+	comment_      string
+	message_      string
+	optionalEdit_ EditFunction
+}
+
+// Class Structure
+
+type diagnosticClass_ struct {
+	// Declare the class constants.
+	marker_     string
+	severities_ map[Severity]string
+}
+
+// Class Reference
+
+func diagnosticClass() *diagnosticClass_ {
+	return diagnosticClassReference_
+}
+
+var diagnosticClassReference_ = &diagnosticClass_{
+	// Initialize the class constants.
+	marker_: "gcmn:ignore",
+	severities_: map[Severity]string{
+		OffSeverity:     "off",
+		InfoSeverity:    "info",
+		WarningSeverity: "warning",
+		ErrorSeverity:   "error",
+	},
+}
@@ -0,0 +1,217 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	reg "regexp"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func FunctionCommentRuleClass() FunctionCommentRuleClassLike {
+	return functionCommentRuleClass()
+}
+
+// Constructor Methods
+
+func (c *functionCommentRuleClass_) FunctionCommentRule() FunctionCommentRuleLike {
+	var instance = &functionCommentRule_{
+		// Initialize the instance attributes.
+		options_:     com.CatalogFromMap[string, string](c.options_),
+		diagnostics_: com.List[DiagnosticLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *functionCommentRule_) GetClass() FunctionCommentRuleClassLike {
+	return functionCommentRuleClass()
+}
+
+// Lintable Methods
+
+func (v *functionCommentRule_) GetIdentifier() string {
+	return functionCommentRuleClass().identifier_
+}
+
+func (v *functionCommentRule_) GetDefaultSeverity() Severity {
+	return functionCommentRuleClass().severity_
+}
+
+func (v *functionCommentRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *functionCommentRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *functionCommentRule_) PreprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	// The options have been configured by the time the model is visited.
+	v.marker_ = v.compileMarker()
+}
+
+func (v *functionCommentRule_) PreprocessClassDeclaration(
+	classDeclaration ast.ClassDeclarationLike,
+	index_ uint,
+	count_ uint,
+) {
+	// Collect the names of the declared class functions.
+	var declared = com.List[string]()
+	var classMethods = classDeclaration.GetClassMethods()
+	var functionSubsection = classMethods.GetOptionalFunctionSubsection()
+	if uti.IsDefined(functionSubsection) {
+		var functionMethods = functionSubsection.GetFunctionMethods().GetIterator()
+		for functionMethods.HasNext() {
+			declared.AppendValue(functionMethods.GetNext().GetName())
+		}
+	}
+
+	// Collect the names of the documented class functions.
+	var documented = com.List[string]()
+	var comment = classDeclaration.GetDeclaration().GetComment()
+	var marker = v.marker_.FindStringIndex(comment)
+	if marker != nil {
+		var section = comment[marker[1]:]
+		var matches = functionCommentRuleClass().matcher_.FindAllStringSubmatch(section, -1)
+		for _, match := range matches {
+			documented.AppendValue(match[1])
+		}
+	}
+
+	// Compare the two lists of names.
+	var names = documented.GetIterator()
+	for names.HasNext() {
+		var name = names.GetNext()
+		if !declared.ContainsValue(name) {
+			v.reportViolation(
+				comment,
+				"The comment for a class documents a function that it does not declare: %s",
+				name,
+			)
+		}
+	}
+	names = declared.GetIterator()
+	for names.HasNext() {
+		var name = names.GetNext()
+		if !documented.ContainsValue(name) {
+			v.reportViolation(
+				comment,
+				"The comment for a class does not document a function that it declares: %s",
+				name,
+			)
+		}
+	}
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *functionCommentRule_) compileMarker() *reg.Regexp {
+	var option = v.options_.GetValue("marker")
+	if uti.IsDefined(option) {
+		var marker, err = reg.Compile(option)
+		if err == nil {
+			return marker
+		}
+	}
+	var message = fmt.Sprintf(
+		"The \"marker\" option for the %s rule must be a regular expression: %s",
+		v.GetIdentifier(),
+		option,
+	)
+	panic(message)
+}
+
+func (v *functionCommentRule_) reportViolation(
+	comment string,
+	format string,
+	name string,
+) {
+	v.diagnostics_.AppendValue(
+		DiagnosticClass().Diagnostic(
+			v.GetIdentifier(),
+			v.GetDefaultSeverity(),
+			comment,
+			fmt.Sprintf(format, name),
+			nil,
+		),
+	)
+}
+
+// Instance Structure
+
+type functionCommentRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+	marker_      *reg.Regexp
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type functionCommentRuleClass_ struct {
+	// Declare the class constants.
+	identifier_ string
+	severity_   Severity
+	options_    map[string]string
+	matcher_    *reg.Regexp
+}
+
+// Class Reference
+
+func functionCommentRuleClass() *functionCommentRuleClass_ {
+	return functionCommentRuleClassReference_
+}
+
+var functionCommentRuleClassReference_ = &functionCommentRuleClass_{
+	// Initialize the class constants.
+	identifier_: "function-comment",
+	severity_:   WarningSeverity,
+	options_: map[string]string{
+		"marker": `The following (?:class )?functions are supported:`,
+	},
+	matcher_: reg.MustCompile(`(?m)^([A-Za-z][A-Za-z0-9]*)\(\)`),
+}
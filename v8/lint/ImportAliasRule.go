@@ -0,0 +1,240 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	reg "regexp"
+	sts "strings"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ImportAliasRuleClass() ImportAliasRuleClassLike {
+	return importAliasRuleClass()
+}
+
+// Constructor Methods
+
+func (c *importAliasRuleClass_) ImportAliasRule() ImportAliasRuleLike {
+	var imports = ImportCollectorClass().ImportCollector()
+	var instance = &importAliasRule_{
+		// Initialize the instance attributes.
+		options_:     com.Catalog[string, string](),
+		diagnostics_: com.List[DiagnosticLike](),
+		imports_:     imports,
+
+		// Initialize the inherited aspects.
+		Methodical: imports,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *importAliasRule_) GetClass() ImportAliasRuleClassLike {
+	return importAliasRuleClass()
+}
+
+// Lintable Methods
+
+func (v *importAliasRule_) GetIdentifier() string {
+	return importAliasRuleClass().identifier_
+}
+
+func (v *importAliasRule_) GetDefaultSeverity() Severity {
+	return importAliasRuleClass().severity_
+}
+
+func (v *importAliasRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *importAliasRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *importAliasRule_) PostprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	// The diagnostics are reported once every prefix that refers to an imported
+	// package name is known.  The length matches the prefixes allowed by the
+	// grammar.
+	var length = importAliasRuleClass().length_
+	var importedPackages = v.imports_.GetImportedPackages().GetIterator()
+	for importedPackages.HasNext() {
+		var importedPackage = importedPackages.GetNext()
+		var packageName = importedPackage.GetName()
+		if utf.RuneCountInString(packageName) == length {
+			continue
+		}
+		var message = fmt.Sprintf(
+			"An imported package name must be exactly %d characters long: %s",
+			length,
+			packageName,
+		)
+		var edit EditFunction
+		var suggestion = v.suggestName(importedPackage.GetString(), length)
+		if v.isRenameable(packageName, suggestion) {
+			edit = func(model ast.ModelLike) ast.ModelLike {
+				return v.renameModel(model, packageName, suggestion)
+			}
+		}
+		v.diagnostics_.AppendValue(
+			DiagnosticClass().Diagnostic(
+				v.GetIdentifier(),
+				v.GetDefaultSeverity(),
+				v.imports_.GetComment(),
+				message,
+				edit,
+			),
+		)
+	}
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *importAliasRule_) isRenameable(
+	oldName string,
+	newName string,
+) bool {
+	if uti.IsUndefined(newName) {
+		return false
+	}
+	var importedPackages = v.imports_.GetImportedPackages().GetIterator()
+	for importedPackages.HasNext() {
+		if importedPackages.GetNext().GetName() == newName {
+			return false
+		}
+	}
+	// A referenced package name must remain a valid prefix once it is renamed.
+	return !v.imports_.IsReferenced(oldName) ||
+		gra.ScannerClass().MatchesType(newName+".", gra.PrefixToken)
+}
+
+func (v *importAliasRule_) renameModel(
+	model ast.ModelLike,
+	oldName string,
+	newName string,
+) ast.ModelLike {
+	// Rename the imported package and each prefix that refers to it.
+	var transformation = func(node any, name string) any {
+		switch actual := node.(type) {
+		case ast.ImportedPackageLike:
+			if actual.GetName() == oldName {
+				return ast.ImportedPackageClass().ImportedPackage(
+					newName,
+					actual.GetString(),
+				)
+			}
+		case ast.NamedLike:
+			if actual.GetOptionalPrefix() == oldName+"." {
+				return ast.NamedClass().Named(
+					newName+".",
+					actual.GetName(),
+					actual.GetOptionalArguments(),
+				)
+			}
+		}
+		return node
+	}
+	var transformer = gra.TransformerClass().Transformer(transformation)
+	return transformer.TransformModel(model)
+}
+
+func (v *importAliasRule_) suggestName(
+	path string,
+	length int,
+) string {
+	// Suggest the leading characters of the last word in the import path,
+	// ignoring any major version suffix (e.g. "/v8").
+	var segments = sts.Split(sts.Trim(path, "\""), "/")
+	for index := len(segments) - 1; index >= 0; index-- {
+		var segment = sts.ToLower(segments[index])
+		if importAliasRuleClass().version_.MatchString(segment) {
+			continue
+		}
+		var words = importAliasRuleClass().word_.FindAllString(segment, -1)
+		if len(words) == 0 {
+			continue
+		}
+		var word = []rune(words[len(words)-1])
+		if len(word) < length {
+			return ""
+		}
+		return string(word[:length])
+	}
+	return ""
+}
+
+// Instance Structure
+
+type importAliasRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+	imports_     ImportCollectorLike
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type importAliasRuleClass_ struct {
+	// Declare the class constants.
+	identifier_ string
+	severity_   Severity
+	length_     int
+	version_    *reg.Regexp
+	word_       *reg.Regexp
+}
+
+// Class Reference
+
+func importAliasRuleClass() *importAliasRuleClass_ {
+	return importAliasRuleClassReference_
+}
+
+var importAliasRuleClassReference_ = &importAliasRuleClass_{
+	// Initialize the class constants.
+	identifier_: "import-alias",
+	severity_:   ErrorSeverity,
+	length_:     3,
+	version_:    reg.MustCompile(`^v[0-9]+$`),
+	word_:       reg.MustCompile(`[a-z]+`),
+}
@@ -0,0 +1,132 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ImportCollectorClass() ImportCollectorClassLike {
+	return importCollectorClass()
+}
+
+// Constructor Methods
+
+func (c *importCollectorClass_) ImportCollector() ImportCollectorLike {
+	var instance = &importCollector_{
+		// Initialize the instance attributes.
+		importedPackages_: com.List[ast.ImportedPackageLike](),
+		prefixes_:         map[string]bool{},
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *importCollector_) GetClass() ImportCollectorClassLike {
+	return importCollectorClass()
+}
+
+func (v *importCollector_) IsReferenced(
+	packageName string,
+) bool {
+	return v.prefixes_[packageName]
+}
+
+// Attribute Methods
+
+func (v *importCollector_) GetComment() string {
+	return v.comment_
+}
+
+func (v *importCollector_) GetImportedPackages() com.Sequential[ast.ImportedPackageLike] {
+	return v.importedPackages_
+}
+
+// Methodical Methods
+
+func (v *importCollector_) PreprocessImportedPackage(
+	importedPackage ast.ImportedPackageLike,
+	index_ uint,
+	count_ uint,
+) {
+	v.importedPackages_.AppendValue(importedPackage)
+}
+
+func (v *importCollector_) PreprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	// Imported packages are governed by the package header comment.
+	v.comment_ = packageHeader.GetComment()
+}
+
+func (v *importCollector_) ProcessPrefix(
+	prefix string,
+) {
+	v.prefixes_[sts.TrimSuffix(prefix, ".")] = true
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type importCollector_ struct {
+	// Declare the instance attributes.
+	comment_          string
+	importedPackages_ com.ListLike[ast.ImportedPackageLike]
+	prefixes_         map[string]bool
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type importCollectorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func importCollectorClass() *importCollectorClass_ {
+	return importCollectorClassReference_
+}
+
+var importCollectorClassReference_ = &importCollectorClass_{
+	// Initialize the class constants.
+}
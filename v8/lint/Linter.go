@@ -0,0 +1,214 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func LinterClass() LinterClassLike {
+	return linterClass()
+}
+
+// Constructor Methods
+
+func (c *linterClass_) Linter(
+	registry RegistryLike,
+	configuration ConfigurationLike,
+) LinterLike {
+	if uti.IsUndefined(registry) {
+		panic("The \"registry\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(configuration) {
+		panic("The \"configuration\" attribute is required by this class.")
+	}
+	var identifiers = configuration.GetIdentifiers().GetIterator()
+	for identifiers.HasNext() {
+		var identifier = identifiers.GetNext()
+		if !registry.ContainsRule(identifier) {
+			var message = fmt.Sprintf(
+				"The lint configuration refers to an unknown rule: %s",
+				identifier,
+			)
+			panic(message)
+		}
+	}
+	var instance = &linter_{
+		// Initialize the instance attributes.
+		registry_:      registry,
+		configuration_: configuration,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *linter_) GetClass() LinterClassLike {
+	return linterClass()
+}
+
+func (v *linter_) LintModel(
+	model ast.ModelLike,
+) com.Sequential[DiagnosticLike] {
+	// Configure each enabled rule.
+	var rules = com.List[Lintable]()
+	var severities = com.List[Severity]()
+	var identifiers = v.registry_.GetIdentifiers().GetIterator()
+	for identifiers.HasNext() {
+		var identifier = identifiers.GetNext()
+		var rule = v.registry_.CreateRule(identifier)
+		var severity, found = v.configuration_.GetSeverity(identifier)
+		if !found {
+			severity = rule.GetDefaultSeverity()
+		}
+		if severity == OffSeverity {
+			continue
+		}
+		var options = rule.GetOptions()
+		var overrides = v.configuration_.GetOptions(identifier).GetIterator()
+		for overrides.HasNext() {
+			var option = overrides.GetNext()
+			options.SetValue(option.GetKey(), option.GetValue())
+		}
+		rules.AppendValue(rule)
+		severities.AppendValue(severity)
+	}
+
+	// Apply all of the enabled rules in a single walk of the model.
+	var processors = com.List[gra.Methodical]()
+	var iterator = rules.GetIterator()
+	for iterator.HasNext() {
+		processors.AppendValue(iterator.GetNext())
+	}
+	var composite = gra.CompositeProcessorClass().CompositeProcessor(processors)
+	gra.VisitorClass().Visitor(composite).VisitModel(model)
+	var failures = composite.GetFailures().GetIterator()
+	if failures.HasNext() {
+		var failure = failures.GetNext()
+		var message = fmt.Sprintf(
+			"The %q lint rule failed: %s",
+			rules.GetValue(int(failure.GetKey())).GetIdentifier(),
+			failure.GetValue(),
+		)
+		panic(message)
+	}
+
+	// Collect the unsuppressed diagnostics from each rule.
+	var diagnostics = com.List[DiagnosticLike]()
+	iterator.ToStart()
+	for iterator.HasNext() {
+		var rule = iterator.GetNext()
+		var severity = severities.GetValue(int(iterator.GetSlot()))
+		var ruleDiagnostics = rule.GetDiagnostics().GetIterator()
+		for ruleDiagnostics.HasNext() {
+			var diagnostic = ruleDiagnostics.GetNext()
+			if diagnostic.IsSuppressed() {
+				continue
+			}
+			diagnostics.AppendValue(
+				DiagnosticClass().Diagnostic(
+					rule.GetIdentifier(),
+					severity,
+					diagnostic.GetComment(),
+					diagnostic.GetMessage(),
+					diagnostic.GetOptionalEdit(),
+				),
+			)
+		}
+	}
+	return diagnostics
+}
+
+func (v *linter_) FixModel(
+	model ast.ModelLike,
+) ast.ModelLike {
+	var passes = linterClass().passes_
+	for pass := 0; pass < passes; pass++ {
+		var edited bool
+		var diagnostics = v.LintModel(model).GetIterator()
+		for diagnostics.HasNext() {
+			var edit = diagnostics.GetNext().GetOptionalEdit()
+			if uti.IsDefined(edit) {
+				model = edit(model)
+				edited = true
+			}
+		}
+		if !edited {
+			return model
+		}
+	}
+	var message = fmt.Sprintf(
+		"The suggested edits did not fix the lint violations after %d passes.",
+		passes,
+	)
+	panic(message)
+}
+
+// Attribute Methods
+
+func (v *linter_) GetRegistry() RegistryLike {
+	return v.registry_
+}
+
+func (v *linter_) GetConfiguration() ConfigurationLike {
+	return v.configuration_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type linter_ struct {
+	// Declare the instance attributes.
+	registry_      RegistryLike
+	configuration_ ConfigurationLike
+}
+
+// Class Structure
+
+type linterClass_ struct {
+	// Declare the class constants.
+	passes_ int
+}
+
+// Class Reference
+
+func linterClass() *linterClass_ {
+	return linterClassReference_
+}
+
+var linterClassReference_ = &linterClass_{
+	// Initialize the class constants.
+	passes_: 8,
+}
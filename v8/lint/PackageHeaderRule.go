@@ -0,0 +1,317 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	reg "regexp"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func PackageHeaderRuleClass() PackageHeaderRuleClassLike {
+	return packageHeaderRuleClass()
+}
+
+// Constructor Methods
+
+func (c *packageHeaderRuleClass_) PackageHeaderRule() PackageHeaderRuleLike {
+	var instance = &packageHeaderRule_{
+		// Initialize the instance attributes.
+		options_:     com.CatalogFromMap[string, string](c.options_),
+		diagnostics_: com.List[DiagnosticLike](),
+
+		// Initialize the inherited aspects.
+		Methodical: gra.ProcessorClass().Processor(),
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *packageHeaderRule_) GetClass() PackageHeaderRuleClassLike {
+	return packageHeaderRuleClass()
+}
+
+func (v *packageHeaderRule_) FixModel(
+	model ast.ModelLike,
+) ast.ModelLike {
+	var packageDeclaration = model.GetPackageDeclaration()
+	var packageHeader = packageDeclaration.GetPackageHeader()
+	var name = packageHeader.GetName()
+	var comment = v.regenerateComment(name, packageHeader.GetComment())
+	return ast.ModelClass().Model(
+		ast.PackageDeclarationClass().PackageDeclaration(
+			packageDeclaration.GetLegalNotice(),
+			ast.PackageHeaderClass().PackageHeader(
+				comment,
+				packageHeader.GetDelimiter(),
+				name,
+			),
+			packageDeclaration.GetPackageImports(),
+		),
+		model.GetPrimitiveDeclarations(),
+		model.GetInterfaceDeclarations(),
+	)
+}
+
+// Lintable Methods
+
+func (v *packageHeaderRule_) GetIdentifier() string {
+	return packageHeaderRuleClass().identifier_
+}
+
+func (v *packageHeaderRule_) GetDefaultSeverity() Severity {
+	return packageHeaderRuleClass().severity_
+}
+
+func (v *packageHeaderRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *packageHeaderRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *packageHeaderRule_) PreprocessPackageHeader(
+	packageHeader ast.PackageHeaderLike,
+	index_ uint,
+	count_ uint,
+) {
+	var name = packageHeader.GetName()
+	var comment = packageHeader.GetComment()
+	var _, lines = v.splitBanner(v.getLines(comment))
+	var expected = fmt.Sprintf("Package %q", name)
+	if len(lines) == 0 || !sts.HasPrefix(sts.TrimSpace(lines[0])+" ", expected+" ") {
+		v.reportViolation(
+			comment,
+			"The package header comment must begin with: %s",
+			expected,
+		)
+	}
+	var missing = v.getMissingLines(lines).GetIterator()
+	for missing.HasNext() {
+		v.reportViolation(
+			comment,
+			"The package header comment is missing a required line: %s",
+			missing.GetNext(),
+		)
+	}
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *packageHeaderRule_) getLines(
+	comment string,
+) []string {
+	// Extract the lines between the comment delimiters.
+	var body = sts.TrimSpace(comment)
+	body = sts.TrimPrefix(body, "/*")
+	body = sts.TrimSuffix(body, "*/")
+	body = sts.Trim(body, "\n")
+	if len(sts.TrimSpace(body)) == 0 {
+		return nil
+	}
+	return sts.Split(body, "\n")
+}
+
+func (v *packageHeaderRule_) getMissingLines(
+	lines []string,
+) com.Sequential[string] {
+	var missing = com.List[string]()
+	var present = com.List[string]()
+	for _, line := range lines {
+		present.AppendValue(sts.TrimSpace(line))
+	}
+	var options = v.options_.GetIterator()
+	for options.HasNext() {
+		var line = sts.TrimSpace(options.GetNext().GetValue())
+		if !present.ContainsValue(line) {
+			missing.AppendValue(line)
+		}
+	}
+	return missing
+}
+
+func (v *packageHeaderRule_) regenerateComment(
+	name string,
+	comment string,
+) string {
+	// Preserve the package description and correct the package name in it.
+	var class = packageHeaderRuleClass()
+	var banner, lines = v.splitBanner(v.getLines(comment))
+	var description, markers []string
+	var standard bool
+	for _, line := range lines {
+		standard = standard || class.boilerplate_.MatchString(sts.TrimSpace(line))
+		switch {
+		case !standard:
+			description = append(description, line)
+		case sts.Contains(line, diagnosticClass().marker_):
+			// Any lint suppression markers must be retained.
+			markers = append(markers, line)
+		}
+	}
+	var text = sts.Join(description, "\n")
+	text = sts.TrimRight(sts.TrimLeft(text, "\n"), " \t\n")
+	var expected = fmt.Sprintf("Package %q", name)
+	switch {
+	case len(text) == 0:
+		text = expected + " provides..."
+	case class.name_.MatchString(text):
+		text = class.name_.ReplaceAllLiteralString(text, expected)
+	default:
+		text = expected + " provides...\n\n" + text
+	}
+
+	// Preserve the existing wiki link for the package.
+	var wiki = class.placeholder_
+	var matches = class.wiki_.FindStringSubmatch(comment)
+	if len(matches) > 1 {
+		wiki = matches[1]
+	}
+	var result = text + "\n\n" + fmt.Sprintf(class.template_, wiki)
+
+	// Append any additional required lines as a final paragraph.
+	var missing = v.getMissingLines(sts.Split(result, "\n"))
+	if !missing.IsEmpty() {
+		result += "\n" + sts.Join(missing.AsArray(), "\n") + "\n"
+	}
+	for _, marker := range markers {
+		result += marker + "\n"
+	}
+
+	// Preserve any generated banner at the start of the comment.
+	if len(banner) > 0 {
+		result = sts.Join(banner, "\n") + "\n\n" + result
+	}
+	return "/*\n" + result + "*/\n"
+}
+
+func (v *packageHeaderRule_) reportViolation(
+	comment string,
+	format string,
+	value string,
+) {
+	v.diagnostics_.AppendValue(
+		DiagnosticClass().Diagnostic(
+			v.GetIdentifier(),
+			v.GetDefaultSeverity(),
+			comment,
+			fmt.Sprintf(format, value),
+			v.FixModel,
+		),
+	)
+}
+
+func (v *packageHeaderRule_) splitBanner(
+	lines []string,
+) (
+	banner []string,
+	rest []string,
+) {
+	// A generated file begins its header comment with a boxed warning banner.
+	var matcher = packageHeaderRuleClass().banner_
+	var count int
+	for count < len(lines) && matcher.MatchString(lines[count]) {
+		count++
+	}
+	banner = lines[:count]
+	rest = lines[count:]
+	for count > 0 && len(rest) > 0 && len(sts.TrimSpace(rest[0])) == 0 {
+		rest = rest[1:]
+	}
+	return
+}
+
+// Instance Structure
+
+type packageHeaderRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type packageHeaderRuleClass_ struct {
+	// Declare the class constants.
+	identifier_  string
+	severity_    Severity
+	options_     map[string]string
+	boilerplate_ *reg.Regexp
+	banner_      *reg.Regexp
+	name_        *reg.Regexp
+	wiki_        *reg.Regexp
+	placeholder_ string
+	template_    string
+}
+
+// Class Reference
+
+func packageHeaderRuleClass() *packageHeaderRuleClass_ {
+	return packageHeaderRuleClassReference_
+}
+
+var packageHeaderRuleClassReference_ = &packageHeaderRuleClass_{
+	// Initialize the class constants.
+	identifier_: "package-header",
+	severity_:   WarningSeverity,
+	options_: map[string]string{
+		"conventions": "This package follows the Crater Dog Technologies™ Go Coding Conventions located",
+		"wiki":        "For detailed documentation on this package refer to the wiki:",
+	},
+	boilerplate_: reg.MustCompile(
+		`^(For detailed documentation|This package follows|Additional concrete implementations)`,
+	),
+	banner_:      reg.MustCompile(`^\s*[┌│└]`),
+	name_:        reg.MustCompile(`^Package "[^"]*"`),
+	wiki_:        reg.MustCompile(`refer to the wiki:\s*\n\s*- (\S+)`),
+	placeholder_: "https://github.com/<organization>/<repository>/wiki",
+	template_: `For detailed documentation on this package refer to the wiki:
+  - %s
+
+This package follows the Crater Dog Technologies™ Go Coding Conventions located
+here:
+  - https://github.com/craterdog/go-development-tools/wiki/Coding-Conventions
+
+Additional concrete implementations of the classes declared by this package can
+be developed and used seamlessly since the interface declarations only depend on
+other interfaces and intrinsic types—and the class implementations only depend
+on interfaces, not on each other.
+`,
+}
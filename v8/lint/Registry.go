@@ -0,0 +1,162 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RegistryClass() RegistryClassLike {
+	return registryClass()
+}
+
+// Constructor Methods
+
+func (c *registryClass_) Registry() RegistryLike {
+	var instance = &registry_{
+		// Initialize the instance attributes.
+		functions_: com.Catalog[string, RuleFunction](),
+	}
+
+	// Register the built-in lint rules.
+	instance.RegisterRule(
+		classGetterRuleClass().identifier_,
+		func() Lintable {
+			return ClassGetterRuleClass().ClassGetterRule()
+		},
+	)
+	instance.RegisterRule(
+		declarationCommentRuleClass().identifier_,
+		func() Lintable {
+			return DeclarationCommentRuleClass().DeclarationCommentRule()
+		},
+	)
+	instance.RegisterRule(
+		declarationOrderRuleClass().identifier_,
+		func() Lintable {
+			return DeclarationOrderRuleClass().DeclarationOrderRule()
+		},
+	)
+	instance.RegisterRule(
+		functionCommentRuleClass().identifier_,
+		func() Lintable {
+			return FunctionCommentRuleClass().FunctionCommentRule()
+		},
+	)
+	instance.RegisterRule(
+		importAliasRuleClass().identifier_,
+		func() Lintable {
+			return ImportAliasRuleClass().ImportAliasRule()
+		},
+	)
+	instance.RegisterRule(
+		packageHeaderRuleClass().identifier_,
+		func() Lintable {
+			return PackageHeaderRuleClass().PackageHeaderRule()
+		},
+	)
+	instance.RegisterRule(
+		unusedImportRuleClass().identifier_,
+		func() Lintable {
+			return UnusedImportRuleClass().UnusedImportRule()
+		},
+	)
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *registry_) GetClass() RegistryClassLike {
+	return registryClass()
+}
+
+func (v *registry_) GetIdentifiers() com.Sequential[string] {
+	return v.functions_.GetKeys()
+}
+
+func (v *registry_) ContainsRule(
+	identifier string,
+) bool {
+	return uti.IsDefined(v.functions_.GetValue(identifier))
+}
+
+func (v *registry_) CreateRule(
+	identifier string,
+) Lintable {
+	var function = v.functions_.GetValue(identifier)
+	if uti.IsUndefined(function) {
+		var message = fmt.Sprintf(
+			"The registry does not contain a lint rule named: %s",
+			identifier,
+		)
+		panic(message)
+	}
+	return function()
+}
+
+func (v *registry_) RegisterRule(
+	identifier string,
+	function RuleFunction,
+) {
+	if uti.IsUndefined(identifier) {
+		panic("The \"identifier\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(function) {
+		panic("The \"function\" attribute is required by this class.")
+	}
+	v.functions_.SetValue(identifier, function)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// Instance Structure
+
+type registry_ struct {
+	// Declare the instance attributes.
+	functions_ com.CatalogLike[string, RuleFunction]
+}
+
+// Class Structure
+
+type registryClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func registryClass() *registryClass_ {
+	return registryClassReference_
+}
+
+var registryClassReference_ = &registryClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,186 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Methodical Methods may be overwritten. │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package lint
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func UnusedImportRuleClass() UnusedImportRuleClassLike {
+	return unusedImportRuleClass()
+}
+
+// Constructor Methods
+
+func (c *unusedImportRuleClass_) UnusedImportRule() UnusedImportRuleLike {
+	var imports = ImportCollectorClass().ImportCollector()
+	var instance = &unusedImportRule_{
+		// Initialize the instance attributes.
+		options_:     com.Catalog[string, string](),
+		diagnostics_: com.List[DiagnosticLike](),
+		imports_:     imports,
+
+		// Initialize the inherited aspects.
+		Methodical: imports,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *unusedImportRule_) GetClass() UnusedImportRuleClassLike {
+	return unusedImportRuleClass()
+}
+
+// Lintable Methods
+
+func (v *unusedImportRule_) GetIdentifier() string {
+	return unusedImportRuleClass().identifier_
+}
+
+func (v *unusedImportRule_) GetDefaultSeverity() Severity {
+	return unusedImportRuleClass().severity_
+}
+
+func (v *unusedImportRule_) GetOptions() com.CatalogLike[string, string] {
+	return v.options_
+}
+
+func (v *unusedImportRule_) GetDiagnostics() com.Sequential[DiagnosticLike] {
+	return v.diagnostics_
+}
+
+// Methodical Methods
+
+func (v *unusedImportRule_) PostprocessModel(
+	model ast.ModelLike,
+	index_ uint,
+	count_ uint,
+) {
+	var importedPackages = v.imports_.GetImportedPackages().GetIterator()
+	for importedPackages.HasNext() {
+		var packageName = importedPackages.GetNext().GetName()
+		if v.imports_.IsReferenced(packageName) {
+			continue
+		}
+		var message = fmt.Sprintf(
+			"An imported package is not referenced by the class model: %s",
+			packageName,
+		)
+		v.diagnostics_.AppendValue(
+			DiagnosticClass().Diagnostic(
+				v.GetIdentifier(),
+				v.GetDefaultSeverity(),
+				v.imports_.GetComment(),
+				message,
+				func(model ast.ModelLike) ast.ModelLike {
+					return v.removeImport(model, packageName)
+				},
+			),
+		)
+	}
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *unusedImportRule_) removeImport(
+	model ast.ModelLike,
+	packageName string,
+) ast.ModelLike {
+	var packageDeclaration = model.GetPackageDeclaration()
+	var packageImports = packageDeclaration.GetPackageImports()
+	var importList = packageImports.GetOptionalImportList()
+	if uti.IsUndefined(importList) {
+		return model
+	}
+	var importedPackages = com.List[ast.ImportedPackageLike]()
+	var iterator = importList.GetImportedPackages().GetIterator()
+	for iterator.HasNext() {
+		var importedPackage = iterator.GetNext()
+		if importedPackage.GetName() != packageName {
+			importedPackages.AppendValue(importedPackage)
+		}
+	}
+	importList = nil
+	if !importedPackages.IsEmpty() {
+		importList = ast.ImportListClass().ImportList(importedPackages)
+	}
+	packageImports = ast.PackageImportsClass().PackageImports(
+		packageImports.GetDelimiter1(),
+		packageImports.GetDelimiter2(),
+		importList,
+		packageImports.GetDelimiter3(),
+	)
+	return ast.ModelClass().Model(
+		ast.PackageDeclarationClass().PackageDeclaration(
+			packageDeclaration.GetLegalNotice(),
+			packageDeclaration.GetPackageHeader(),
+			packageImports,
+		),
+		model.GetPrimitiveDeclarations(),
+		model.GetInterfaceDeclarations(),
+	)
+}
+
+// Instance Structure
+
+type unusedImportRule_ struct {
+	// Declare the instance attributes.
+	options_     com.CatalogLike[string, string]
+	diagnostics_ com.ListLike[DiagnosticLike]
+	imports_     ImportCollectorLike
+
+	// Declare the inherited aspects.
+	gra.Methodical
+}
+
+// Class Structure
+
+type unusedImportRuleClass_ struct {
+	// Declare the class constants.
+	identifier_ string
+	severity_   Severity
+}
+
+// Class Reference
+
+func unusedImportRuleClass() *unusedImportRuleClass_ {
+	return unusedImportRuleClassReference_
+}
+
+var unusedImportRuleClassReference_ = &unusedImportRuleClass_{
+	// Initialize the class constants.
+	identifier_: "unused-import",
+	severity_:   WarningSeverity,
+}
@@ -0,0 +1,479 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│         This "package_api.go" file was automatically generated using:        │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+
+Package "lint" provides the following classes that check the conventions used by
+a class model against a configurable set of lint rules:
+  - Configuration captures the rule severities and options chosen by a team.
+  - Diagnostic captures a single convention violation reported by a lint rule.
+  - Registry maintains the set of lint rules that are known to the linter.
+  - Linter runs each enabled lint rule against a class model.
+  - ImportAliasRule checks the length of each imported package alias, and can
+    rename an alias after the last word of its import path.
+  - ImportCollector collects the imported packages, and the package prefixes
+    that refer to them, for the lint rules that check the imports.
+  - ClassGetterRule checks that each instance declaration declares a GetClass()
+    principal method, and can insert the missing method.
+  - DeclarationCommentRule checks that each declaration comment starts with the
+    name of the declared type.
+  - DeclarationOrderRule checks that the class, instance, aspect and functional
+    declarations are each in alphabetical order, and can sort them.
+  - FunctionCommentRule checks that the class functions documented in each class
+    comment match the class functions that the class declares.
+  - PackageHeaderRule checks that the package header comment names the package
+    and contains each required line, and can regenerate its standard sections.
+  - UnusedImportRule checks that each imported package is referenced by the
+    class model, and can remove the imported packages that are not.
+
+A lint rule is suppressed for a specific declaration by adding the following
+marker to the comment for that declaration (or to the package header comment
+for package-wide rules):
+
+	gcmn:ignore [identifier...]
+
+If no rule identifiers are listed all lint rules are suppressed.
+
+For detailed documentation on this package refer to the wiki:
+  - https://github.com/craterdog/go-class-model/wiki
+
+This package follows the Crater Dog Technologies™ Go Coding Conventions located
+here:
+  - https://github.com/craterdog/go-development-tools/wiki/Coding-Conventions
+
+Additional concrete implementations of the classes declared by this package can
+be developed and used seamlessly since the interface declarations only depend on
+other interfaces and intrinsic types—and the class implementations only depend
+on interfaces, not on each other.
+*/
+package lint
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+)
+
+// TYPE DECLARATIONS
+
+/*
+Severity is a constrained type representing how seriously a violation of a lint
+rule should be taken.  A lint rule with an off severity is not run.
+*/
+type Severity uint8
+
+const (
+	OffSeverity Severity = iota
+	InfoSeverity
+	WarningSeverity
+	ErrorSeverity
+)
+
+// FUNCTIONAL DECLARATIONS
+
+/*
+EditFunction is a functional type that defines the signature of a suggested edit
+that returns a copy of a class model with a lint violation fixed.  Since several
+edits may be applied in turn, an edit must locate the nodes that it changes
+within the class model that it is passed.
+*/
+type EditFunction func(
+	model ast.ModelLike,
+) ast.ModelLike
+
+/*
+RuleFunction is a functional type that defines the signature of the factory
+function used by a registry to create a new instance of a lint rule.
+*/
+type RuleFunction func() Lintable
+
+// CLASS DECLARATIONS
+
+/*
+ClassGetterRuleClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete class-getter-rule-like class.
+*/
+type ClassGetterRuleClassLike interface {
+	// Constructor Methods
+	ClassGetterRule() ClassGetterRuleLike
+}
+
+/*
+ConfigurationClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete configuration-like class.
+*/
+type ConfigurationClassLike interface {
+	// Constructor Methods
+	Configuration() ConfigurationLike
+	ConfigurationFromSource(
+		source string,
+	) ConfigurationLike
+}
+
+/*
+DeclarationCommentRuleClassLike is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete declaration-comment-rule-like class.
+*/
+type DeclarationCommentRuleClassLike interface {
+	// Constructor Methods
+	DeclarationCommentRule() DeclarationCommentRuleLike
+}
+
+/*
+DeclarationOrderRuleClassLike is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete declaration-order-rule-like class.
+*/
+type DeclarationOrderRuleClassLike interface {
+	// Constructor Methods
+	DeclarationOrderRule() DeclarationOrderRuleLike
+}
+
+/*
+DiagnosticClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+diagnostic-like class.
+*/
+type DiagnosticClassLike interface {
+	// Constructor Methods
+	Diagnostic(
+		identifier string,
+		severity Severity,
+		comment string,
+		message string,
+		optionalEdit EditFunction,
+	) DiagnosticLike
+}
+
+/*
+FunctionCommentRuleClassLike is a class interface that declares the complete set
+of class constructors, constants and functions that must be supported by each
+concrete function-comment-rule-like class.
+*/
+type FunctionCommentRuleClassLike interface {
+	// Constructor Methods
+	FunctionCommentRule() FunctionCommentRuleLike
+}
+
+/*
+ImportAliasRuleClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete import-alias-rule-like class.
+*/
+type ImportAliasRuleClassLike interface {
+	// Constructor Methods
+	ImportAliasRule() ImportAliasRuleLike
+}
+
+/*
+ImportCollectorClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete import-collector-like class.
+*/
+type ImportCollectorClassLike interface {
+	// Constructor Methods
+	ImportCollector() ImportCollectorLike
+}
+
+/*
+LinterClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+linter-like class.
+*/
+type LinterClassLike interface {
+	// Constructor Methods
+	Linter(
+		registry RegistryLike,
+		configuration ConfigurationLike,
+	) LinterLike
+}
+
+/*
+PackageHeaderRuleClassLike is a class interface that declares the complete set
+of class constructors, constants and functions that must be supported by each
+concrete package-header-rule-like class.
+*/
+type PackageHeaderRuleClassLike interface {
+	// Constructor Methods
+	PackageHeaderRule() PackageHeaderRuleLike
+}
+
+/*
+RegistryClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+registry-like class.
+*/
+type RegistryClassLike interface {
+	// Constructor Methods
+	Registry() RegistryLike
+}
+
+/*
+UnusedImportRuleClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete unused-import-rule-like class.
+*/
+type UnusedImportRuleClassLike interface {
+	// Constructor Methods
+	UnusedImportRule() UnusedImportRuleLike
+}
+
+// INSTANCE DECLARATIONS
+
+/*
+ClassGetterRuleLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete class-getter-rule-like class.  The missing GetClass() method is
+inserted as the first principal method of the instance declaration, returning
+the class interface with the same type parameters as the instance interface.
+*/
+type ClassGetterRuleLike interface {
+	// Principal Methods
+	GetClass() ClassGetterRuleClassLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+/*
+ConfigurationLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete configuration-like class.
+*/
+type ConfigurationLike interface {
+	// Principal Methods
+	GetClass() ConfigurationClassLike
+	GetIdentifiers() com.Sequential[string]
+	GetSeverity(
+		identifier string,
+	) (
+		severity Severity,
+		found bool,
+	)
+	SetSeverity(
+		identifier string,
+		severity Severity,
+	)
+	GetOptions(
+		identifier string,
+	) com.CatalogLike[string, string]
+	SetOption(
+		identifier string,
+		name string,
+		value string,
+	)
+}
+
+/*
+DeclarationCommentRuleLike is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+instance of a concrete declaration-comment-rule-like class.
+*/
+type DeclarationCommentRuleLike interface {
+	// Principal Methods
+	GetClass() DeclarationCommentRuleClassLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+/*
+DeclarationOrderRuleLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete declaration-order-rule-like class.  The declarations are
+ordered by name in the same way as the validator orders them, ignoring the
+"ClassLike", "Like" and "Function" suffixes of the class, instance and
+functional declarations.
+*/
+type DeclarationOrderRuleLike interface {
+	// Principal Methods
+	GetClass() DeclarationOrderRuleClassLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+/*
+DiagnosticLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete diagnostic-like class.  A diagnostic for a violation that has a
+mechanical fix carries a suggested edit that applies the fix to the class model.
+*/
+type DiagnosticLike interface {
+	// Principal Methods
+	GetClass() DiagnosticClassLike
+	AsString() string
+	IsSuppressed() bool
+
+	// Attribute Methods
+	GetIdentifier() string
+	GetSeverity() Severity
+	GetComment() string
+	GetMessage() string
+	GetOptionalEdit() EditFunction
+}
+
+/*
+FunctionCommentRuleLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete function-comment-rule-like class.  The class functions
+are documented in a class comment by a paragraph for each function following
+a marker such as "The following class functions are supported:", where each
+paragraph starts with the name of the function followed by "()".  The "marker"
+option is a regular expression that matches the marker.
+*/
+type FunctionCommentRuleLike interface {
+	// Principal Methods
+	GetClass() FunctionCommentRuleClassLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+/*
+ImportAliasRuleLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete import-alias-rule-like class.  A violation is only fixable if the
+suggested alias does not collide with another imported package name and, when
+the alias is referenced, is still a valid prefix.
+*/
+type ImportAliasRuleLike interface {
+	// Principal Methods
+	GetClass() ImportAliasRuleClassLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+/*
+ImportCollectorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete import-collector-like class.  The lint rules that check imported
+packages embed an import collector, which is complete once the visitor has
+walked the class model.
+*/
+type ImportCollectorLike interface {
+	// Principal Methods
+	GetClass() ImportCollectorClassLike
+	IsReferenced(
+		packageName string,
+	) bool
+
+	// Attribute Methods
+	GetComment() string
+	GetImportedPackages() com.Sequential[ast.ImportedPackageLike]
+
+	// Aspect Interfaces
+	gra.Methodical
+}
+
+/*
+LinterLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete linter-like class.  The FixModel() method applies the suggested edits
+of the reported diagnostics to a copy of the class model, and then lints the
+result again, until no diagnostics with suggested edits remain.
+*/
+type LinterLike interface {
+	// Principal Methods
+	GetClass() LinterClassLike
+	LintModel(
+		model ast.ModelLike,
+	) com.Sequential[DiagnosticLike]
+	FixModel(
+		model ast.ModelLike,
+	) ast.ModelLike
+
+	// Attribute Methods
+	GetRegistry() RegistryLike
+	GetConfiguration() ConfigurationLike
+}
+
+/*
+PackageHeaderRuleLike is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete package-header-rule-like class.  The package header
+comment must begin with the quoted package name (e.g. Package "example"), after
+any generated warning banner, and must contain each line given as the value of
+an option for the rule.  The FixModel() method returns a copy of the model whose
+package header comment keeps its warning banner, package description and wiki
+link but has its standard sections regenerated, followed by any other required
+lines.  A missing wiki link is replaced by a placeholder.
+*/
+type PackageHeaderRuleLike interface {
+	// Principal Methods
+	GetClass() PackageHeaderRuleClassLike
+	FixModel(
+		model ast.ModelLike,
+	) ast.ModelLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+/*
+RegistryLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete registry-like class.
+*/
+type RegistryLike interface {
+	// Principal Methods
+	GetClass() RegistryClassLike
+	GetIdentifiers() com.Sequential[string]
+	ContainsRule(
+		identifier string,
+	) bool
+	CreateRule(
+		identifier string,
+	) Lintable
+	RegisterRule(
+		identifier string,
+		function RuleFunction,
+	)
+}
+
+/*
+UnusedImportRuleLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete unused-import-rule-like class.
+*/
+type UnusedImportRuleLike interface {
+	// Principal Methods
+	GetClass() UnusedImportRuleClassLike
+
+	// Aspect Interfaces
+	Lintable
+}
+
+// ASPECT DECLARATIONS
+
+/*
+Lintable declares the set of method signatures that must be supported by all
+lint rules.  Each lint rule is a methodical processor that collects diagnostics
+while the visitor walks the class model.
+*/
+type Lintable interface {
+	gra.Methodical
+	GetIdentifier() string
+	GetDefaultSeverity() Severity
+	GetOptions() com.CatalogLike[string, string]
+	GetDiagnostics() com.Sequential[DiagnosticLike]
+}
@@ -31,13 +31,31 @@ For detailed documentation on this entire module refer to the wiki:
 package module
 
 import (
+	ana "github.com/craterdog/go-class-model/v8/analysis"
 	ast "github.com/craterdog/go-class-model/v8/ast"
+	gen "github.com/craterdog/go-class-model/v8/generator"
 	gra "github.com/craterdog/go-class-model/v8/grammar"
+	lin "github.com/craterdog/go-class-model/v8/lint"
+	ref "github.com/craterdog/go-class-model/v8/refactor"
 	com "github.com/craterdog/go-essential-composites/v8"
 )
 
 // TYPE ALIASES
 
+// Analysis
+
+type (
+	ConformanceCheckerClassLike = ana.ConformanceCheckerClassLike
+	ImporterClassLike           = ana.ImporterClassLike
+	SelectorClassLike           = ana.SelectorClassLike
+)
+
+type (
+	ConformanceCheckerLike = ana.ConformanceCheckerLike
+	ImporterLike           = ana.ImporterLike
+	SelectorLike           = ana.SelectorLike
+)
+
 // Ast
 
 type (
@@ -50,9 +68,11 @@ type (
 	ArrayClassLike                 = ast.ArrayClassLike
 	AspectDeclarationClassLike     = ast.AspectDeclarationClassLike
 	AspectInterfaceClassLike       = ast.AspectInterfaceClassLike
+	AspectMemberClassLike          = ast.AspectMemberClassLike
 	AspectMethodClassLike          = ast.AspectMethodClassLike
 	AspectSectionClassLike         = ast.AspectSectionClassLike
 	AspectSubsectionClassLike      = ast.AspectSubsectionClassLike
+	AssignmentClassLike            = ast.AssignmentClassLike
 	AttributeMethodClassLike       = ast.AttributeMethodClassLike
 	AttributeSubsectionClassLike   = ast.AttributeSubsectionClassLike
 	ChannelClassLike               = ast.ChannelClassLike
@@ -68,6 +88,7 @@ type (
 	DeclarationClassLike           = ast.DeclarationClassLike
 	DotsClassLike                  = ast.DotsClassLike
 	EnumerationClassLike           = ast.EnumerationClassLike
+	ExpressionClassLike            = ast.ExpressionClassLike
 	FunctionMethodClassLike        = ast.FunctionMethodClassLike
 	FunctionSubsectionClassLike    = ast.FunctionSubsectionClassLike
 	FunctionalClassLike            = ast.FunctionalClassLike
@@ -87,6 +108,8 @@ type (
 	MultivalueClassLike            = ast.MultivalueClassLike
 	NamedClassLike                 = ast.NamedClassLike
 	NoneClassLike                  = ast.NoneClassLike
+	OperandClassLike               = ast.OperandClassLike
+	OperationClassLike             = ast.OperationClassLike
 	PackageDeclarationClassLike    = ast.PackageDeclarationClassLike
 	PackageHeaderClassLike         = ast.PackageHeaderClassLike
 	PackageImportsClassLike        = ast.PackageImportsClassLike
@@ -97,6 +120,7 @@ type (
 	PrincipalSubsectionClassLike   = ast.PrincipalSubsectionClassLike
 	ResultClassLike                = ast.ResultClassLike
 	SetterMethodClassLike          = ast.SetterMethodClassLike
+	SpecificationClassLike         = ast.SpecificationClassLike
 	StarClassLike                  = ast.StarClassLike
 	TypeClassLike                  = ast.TypeClassLike
 	TypeDeclarationClassLike       = ast.TypeDeclarationClassLike
@@ -115,9 +139,11 @@ type (
 	ArrayLike                 = ast.ArrayLike
 	AspectDeclarationLike     = ast.AspectDeclarationLike
 	AspectInterfaceLike       = ast.AspectInterfaceLike
+	AspectMemberLike          = ast.AspectMemberLike
 	AspectMethodLike          = ast.AspectMethodLike
 	AspectSectionLike         = ast.AspectSectionLike
 	AspectSubsectionLike      = ast.AspectSubsectionLike
+	AssignmentLike            = ast.AssignmentLike
 	AttributeMethodLike       = ast.AttributeMethodLike
 	AttributeSubsectionLike   = ast.AttributeSubsectionLike
 	ChannelLike               = ast.ChannelLike
@@ -133,6 +159,7 @@ type (
 	DeclarationLike           = ast.DeclarationLike
 	DotsLike                  = ast.DotsLike
 	EnumerationLike           = ast.EnumerationLike
+	ExpressionLike            = ast.ExpressionLike
 	FunctionMethodLike        = ast.FunctionMethodLike
 	FunctionSubsectionLike    = ast.FunctionSubsectionLike
 	FunctionalLike            = ast.FunctionalLike
@@ -152,6 +179,8 @@ type (
 	MultivalueLike            = ast.MultivalueLike
 	NamedLike                 = ast.NamedLike
 	NoneLike                  = ast.NoneLike
+	OperandLike               = ast.OperandLike
+	OperationLike             = ast.OperationLike
 	PackageDeclarationLike    = ast.PackageDeclarationLike
 	PackageHeaderLike         = ast.PackageHeaderLike
 	PackageImportsLike        = ast.PackageImportsLike
@@ -162,6 +191,7 @@ type (
 	PrincipalSubsectionLike   = ast.PrincipalSubsectionLike
 	ResultLike                = ast.ResultLike
 	SetterMethodLike          = ast.SetterMethodLike
+	SpecificationLike         = ast.SpecificationLike
 	StarLike                  = ast.StarLike
 	TypeLike                  = ast.TypeLike
 	TypeDeclarationLike       = ast.TypeDeclarationLike
@@ -170,6 +200,43 @@ type (
 	WrapperLike               = ast.WrapperLike
 )
 
+// Generator
+
+type (
+	Cardinality = gen.Cardinality
+)
+
+const (
+	SingleCardinality     = gen.SingleCardinality
+	OptionalCardinality   = gen.OptionalCardinality
+	ZeroOrMoreCardinality = gen.ZeroOrMoreCardinality
+	OneOrMoreCardinality  = gen.OneOrMoreCardinality
+)
+
+type (
+	DiagramGeneratorClassLike  = gen.DiagramGeneratorClassLike
+	DocumentGeneratorClassLike = gen.DocumentGeneratorClassLike
+	FakeGeneratorClassLike     = gen.FakeGeneratorClassLike
+	GeneratorClassLike         = gen.GeneratorClassLike
+	ReaderClassLike            = gen.ReaderClassLike
+	RuleClassLike              = gen.RuleClassLike
+	ScaffoldGeneratorClassLike = gen.ScaffoldGeneratorClassLike
+	SyntaxClassLike            = gen.SyntaxClassLike
+	TermClassLike              = gen.TermClassLike
+)
+
+type (
+	DiagramGeneratorLike  = gen.DiagramGeneratorLike
+	DocumentGeneratorLike = gen.DocumentGeneratorLike
+	FakeGeneratorLike     = gen.FakeGeneratorLike
+	GeneratorLike         = gen.GeneratorLike
+	ReaderLike            = gen.ReaderLike
+	RuleLike              = gen.RuleLike
+	ScaffoldGeneratorLike = gen.ScaffoldGeneratorLike
+	SyntaxLike            = gen.SyntaxLike
+	TermLike              = gen.TermLike
+)
+
 // Grammar
 
 type (
@@ -182,37 +249,156 @@ const (
 	DelimiterToken = gra.DelimiterToken
 	NameToken      = gra.NameToken
 	NewlineToken   = gra.NewlineToken
-	PathToken      = gra.PathToken
+	NoteToken      = gra.NoteToken
+	NumberToken    = gra.NumberToken
+	OperatorToken  = gra.OperatorToken
 	PrefixToken    = gra.PrefixToken
 	SpaceToken     = gra.SpaceToken
+	StringToken    = gra.StringToken
 )
 
 type (
-	FormatterClassLike = gra.FormatterClassLike
-	ParserClassLike    = gra.ParserClassLike
-	ProcessorClassLike = gra.ProcessorClassLike
-	ScannerClassLike   = gra.ScannerClassLike
-	TokenClassLike     = gra.TokenClassLike
-	ValidatorClassLike = gra.ValidatorClassLike
-	VisitorClassLike   = gra.VisitorClassLike
+	InspectionFunction     = gra.InspectionFunction
+	RenderingFunction      = gra.RenderingFunction
+	TransformationFunction = gra.TransformationFunction
 )
 
 type (
-	FormatterLike = gra.FormatterLike
-	ParserLike    = gra.ParserLike
-	ProcessorLike = gra.ProcessorLike
-	ScannerLike   = gra.ScannerLike
-	TokenLike     = gra.TokenLike
-	ValidatorLike = gra.ValidatorLike
-	VisitorLike   = gra.VisitorLike
+	CompositeProcessorClassLike = gra.CompositeProcessorClassLike
+	ConcreteSyntaxClassLike     = gra.ConcreteSyntaxClassLike
+	ConcreteTokenClassLike      = gra.ConcreteTokenClassLike
+	DecoderClassLike            = gra.DecoderClassLike
+	EncoderClassLike            = gra.EncoderClassLike
+	FormatterClassLike          = gra.FormatterClassLike
+	InspectorClassLike          = gra.InspectorClassLike
+	ParserClassLike             = gra.ParserClassLike
+	ProcessorClassLike          = gra.ProcessorClassLike
+	ScannerClassLike            = gra.ScannerClassLike
+	TokenClassLike              = gra.TokenClassLike
+	TransformerClassLike        = gra.TransformerClassLike
+	ValidatorClassLike          = gra.ValidatorClassLike
+	VisitorClassLike            = gra.VisitorClassLike
+)
+
+type (
+	CompositeProcessorLike = gra.CompositeProcessorLike
+	ConcreteSyntaxLike     = gra.ConcreteSyntaxLike
+	ConcreteTokenLike      = gra.ConcreteTokenLike
+	DecoderLike            = gra.DecoderLike
+	EncoderLike            = gra.EncoderLike
+	FormatterLike          = gra.FormatterLike
+	InspectorLike          = gra.InspectorLike
+	ParserLike             = gra.ParserLike
+	ProcessorLike          = gra.ProcessorLike
+	ScannerLike            = gra.ScannerLike
+	TokenLike              = gra.TokenLike
+	TransformerLike        = gra.TransformerLike
+	ValidatorLike          = gra.ValidatorLike
+	VisitorLike            = gra.VisitorLike
 )
 
 type (
 	Methodical = gra.Methodical
 )
 
+// Lint
+
+type (
+	Severity = lin.Severity
+)
+
+const (
+	OffSeverity     = lin.OffSeverity
+	InfoSeverity    = lin.InfoSeverity
+	WarningSeverity = lin.WarningSeverity
+	ErrorSeverity   = lin.ErrorSeverity
+)
+
+type (
+	EditFunction = lin.EditFunction
+	RuleFunction = lin.RuleFunction
+)
+
+type (
+	ClassGetterRuleClassLike        = lin.ClassGetterRuleClassLike
+	ConfigurationClassLike          = lin.ConfigurationClassLike
+	DeclarationCommentRuleClassLike = lin.DeclarationCommentRuleClassLike
+	DeclarationOrderRuleClassLike   = lin.DeclarationOrderRuleClassLike
+	DiagnosticClassLike             = lin.DiagnosticClassLike
+	FunctionCommentRuleClassLike    = lin.FunctionCommentRuleClassLike
+	ImportAliasRuleClassLike        = lin.ImportAliasRuleClassLike
+	ImportCollectorClassLike        = lin.ImportCollectorClassLike
+	LinterClassLike                 = lin.LinterClassLike
+	PackageHeaderRuleClassLike      = lin.PackageHeaderRuleClassLike
+	RegistryClassLike               = lin.RegistryClassLike
+	UnusedImportRuleClassLike       = lin.UnusedImportRuleClassLike
+)
+
+type (
+	ClassGetterRuleLike        = lin.ClassGetterRuleLike
+	ConfigurationLike          = lin.ConfigurationLike
+	DeclarationCommentRuleLike = lin.DeclarationCommentRuleLike
+	DeclarationOrderRuleLike   = lin.DeclarationOrderRuleLike
+	DiagnosticLike             = lin.DiagnosticLike
+	FunctionCommentRuleLike    = lin.FunctionCommentRuleLike
+	ImportAliasRuleLike        = lin.ImportAliasRuleLike
+	ImportCollectorLike        = lin.ImportCollectorLike
+	LinterLike                 = lin.LinterLike
+	PackageHeaderRuleLike      = lin.PackageHeaderRuleLike
+	RegistryLike               = lin.RegistryLike
+	UnusedImportRuleLike       = lin.UnusedImportRuleLike
+)
+
+type (
+	Lintable = lin.Lintable
+)
+
+// Refactor
+
+type (
+	EditorClassLike   = ref.EditorClassLike
+	LicenserClassLike = ref.LicenserClassLike
+	RenamerClassLike  = ref.RenamerClassLike
+)
+
+type (
+	EditorLike   = ref.EditorLike
+	LicenserLike = ref.LicenserLike
+	RenamerLike  = ref.RenamerLike
+)
+
 // CLASS ACCESSORS
 
+// Analysis
+
+func ConformanceCheckerClass() ConformanceCheckerClassLike {
+	return ana.ConformanceCheckerClass()
+}
+
+func ConformanceChecker() ConformanceCheckerLike {
+	return ConformanceCheckerClass().ConformanceChecker()
+}
+
+func ImporterClass() ImporterClassLike {
+	return ana.ImporterClass()
+}
+
+func Importer() ImporterLike {
+	return ImporterClass().Importer()
+}
+
+func SelectorClass() SelectorClassLike {
+	return ana.SelectorClass()
+}
+
+func Selector(
+	expression string,
+) SelectorLike {
+	return SelectorClass().Selector(
+		expression,
+	)
+}
+
 // Ast
 
 func AbstractionClass() AbstractionClassLike {
@@ -263,9 +449,11 @@ func AdditionalValueClass() AdditionalValueClassLike {
 
 func AdditionalValue(
 	name string,
+	specification ast.SpecificationLike,
 ) AdditionalValueLike {
 	return AdditionalValueClass().AdditionalValue(
 		name,
+		specification,
 	)
 }
 
@@ -321,14 +509,14 @@ func AspectDeclaration(
 	declaration ast.DeclarationLike,
 	delimiter1 string,
 	delimiter2 string,
-	aspectMethods com.Sequential[ast.AspectMethodLike],
+	aspectMembers com.Sequential[ast.AspectMemberLike],
 	delimiter3 string,
 ) AspectDeclarationLike {
 	return AspectDeclarationClass().AspectDeclaration(
 		declaration,
 		delimiter1,
 		delimiter2,
-		aspectMethods,
+		aspectMembers,
 		delimiter3,
 	)
 }
@@ -345,6 +533,18 @@ func AspectInterface(
 	)
 }
 
+func AspectMemberClass() AspectMemberClassLike {
+	return ast.AspectMemberClass()
+}
+
+func AspectMember(
+	any_ any,
+) AspectMemberLike {
+	return AspectMemberClass().AspectMember(
+		any_,
+	)
+}
+
 func AspectMethodClass() AspectMethodClassLike {
 	return ast.AspectMethodClass()
 }
@@ -385,6 +585,22 @@ func AspectSubsection(
 	)
 }
 
+func AssignmentClass() AssignmentClassLike {
+	return ast.AssignmentClass()
+}
+
+func Assignment(
+	abstraction ast.AbstractionLike,
+	delimiter string,
+	expression ast.ExpressionLike,
+) AssignmentLike {
+	return AssignmentClass().Assignment(
+		abstraction,
+		delimiter,
+		expression,
+	)
+}
+
 func AttributeMethodClass() AttributeMethodClassLike {
 	return ast.AttributeMethodClass()
 }
@@ -478,12 +694,14 @@ func ConstantMethodClass() ConstantMethodClassLike {
 }
 
 func ConstantMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	delimiter2 string,
 	abstraction ast.AbstractionLike,
 ) ConstantMethodLike {
 	return ConstantMethodClass().ConstantMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		delimiter2,
@@ -542,6 +760,7 @@ func ConstructorMethodClass() ConstructorMethodClassLike {
 }
 
 func ConstructorMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	optionalParameterList ast.ParameterListLike,
@@ -549,6 +768,7 @@ func ConstructorMethod(
 	abstraction ast.AbstractionLike,
 ) ConstructorMethodLike {
 	return ConstructorMethodClass().ConstructorMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		optionalParameterList,
@@ -621,11 +841,26 @@ func Enumeration(
 	)
 }
 
+func ExpressionClass() ExpressionClassLike {
+	return ast.ExpressionClass()
+}
+
+func Expression(
+	operand ast.OperandLike,
+	optionalOperation ast.OperationLike,
+) ExpressionLike {
+	return ExpressionClass().Expression(
+		operand,
+		optionalOperation,
+	)
+}
+
 func FunctionMethodClass() FunctionMethodClassLike {
 	return ast.FunctionMethodClass()
 }
 
 func FunctionMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	optionalParameterList ast.ParameterListLike,
@@ -633,6 +868,7 @@ func FunctionMethod(
 	result ast.ResultLike,
 ) FunctionMethodLike {
 	return FunctionMethodClass().FunctionMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		optionalParameterList,
@@ -708,12 +944,14 @@ func GetterMethodClass() GetterMethodClassLike {
 }
 
 func GetterMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	delimiter2 string,
 	abstraction ast.AbstractionLike,
 ) GetterMethodLike {
 	return GetterMethodClass().GetterMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		delimiter2,
@@ -739,11 +977,11 @@ func ImportedPackageClass() ImportedPackageClassLike {
 
 func ImportedPackage(
 	name string,
-	path string,
+	string_ string,
 ) ImportedPackageLike {
 	return ImportedPackageClass().ImportedPackage(
 		name,
-		path,
+		string_,
 	)
 }
 
@@ -848,6 +1086,7 @@ func MethodClass() MethodClassLike {
 }
 
 func Method(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	optionalParameterList ast.ParameterListLike,
@@ -855,6 +1094,7 @@ func Method(
 	result ast.ResultLike,
 ) MethodLike {
 	return MethodClass().Method(
+		optionalNote,
 		name,
 		delimiter1,
 		optionalParameterList,
@@ -923,6 +1163,32 @@ func None(
 	)
 }
 
+func OperandClass() OperandClassLike {
+	return ast.OperandClass()
+}
+
+func Operand(
+	any_ any,
+) OperandLike {
+	return OperandClass().Operand(
+		any_,
+	)
+}
+
+func OperationClass() OperationClassLike {
+	return ast.OperationClass()
+}
+
+func Operation(
+	operator string,
+	operand ast.OperandLike,
+) OperationLike {
+	return OperationClass().Operation(
+		operator,
+		operand,
+	)
+}
+
 func PackageDeclarationClass() PackageDeclarationClassLike {
 	return ast.PackageDeclarationClass()
 }
@@ -1058,12 +1324,14 @@ func SetterMethodClass() SetterMethodClassLike {
 }
 
 func SetterMethod(
+	optionalNote string,
 	name string,
 	delimiter1 string,
 	parameter ast.ParameterLike,
 	delimiter2 string,
 ) SetterMethodLike {
 	return SetterMethodClass().SetterMethod(
+		optionalNote,
 		name,
 		delimiter1,
 		parameter,
@@ -1071,6 +1339,18 @@ func SetterMethod(
 	)
 }
 
+func SpecificationClass() SpecificationClassLike {
+	return ast.SpecificationClass()
+}
+
+func Specification(
+	any_ any,
+) SpecificationLike {
+	return SpecificationClass().Specification(
+		any_,
+	)
+}
+
 func StarClass() StarClassLike {
 	return ast.StarClass()
 }
@@ -1132,14 +1412,14 @@ func ValueClass() ValueClassLike {
 func Value(
 	name string,
 	abstraction ast.AbstractionLike,
-	delimiter1 string,
-	delimiter2 string,
+	delimiter string,
+	expression ast.ExpressionLike,
 ) ValueLike {
 	return ValueClass().Value(
 		name,
 		abstraction,
-		delimiter1,
-		delimiter2,
+		delimiter,
+		expression,
 	)
 }
 
@@ -1155,8 +1435,162 @@ func Wrapper(
 	)
 }
 
+// Generator
+
+func DiagramGeneratorClass() DiagramGeneratorClassLike {
+	return gen.DiagramGeneratorClass()
+}
+
+func DiagramGenerator() DiagramGeneratorLike {
+	return DiagramGeneratorClass().DiagramGenerator()
+}
+
+func DocumentGeneratorClass() DocumentGeneratorClassLike {
+	return gen.DocumentGeneratorClass()
+}
+
+func DocumentGenerator() DocumentGeneratorLike {
+	return DocumentGeneratorClass().DocumentGenerator()
+}
+
+func FakeGeneratorClass() FakeGeneratorClassLike {
+	return gen.FakeGeneratorClass()
+}
+
+func FakeGenerator() FakeGeneratorLike {
+	return FakeGeneratorClass().FakeGenerator()
+}
+
+func GeneratorClass() GeneratorClassLike {
+	return gen.GeneratorClass()
+}
+
+func Generator() GeneratorLike {
+	return GeneratorClass().Generator()
+}
+
+func ReaderClass() ReaderClassLike {
+	return gen.ReaderClass()
+}
+
+func Reader() ReaderLike {
+	return ReaderClass().Reader()
+}
+
+func RuleClass() RuleClassLike {
+	return gen.RuleClass()
+}
+
+func Rule(
+	name string,
+	definition string,
+	alternatives com.Sequential[string],
+	terms com.Sequential[gen.TermLike],
+) RuleLike {
+	return RuleClass().Rule(
+		name,
+		definition,
+		alternatives,
+		terms,
+	)
+}
+
+func ScaffoldGeneratorClass() ScaffoldGeneratorClassLike {
+	return gen.ScaffoldGeneratorClass()
+}
+
+func ScaffoldGenerator() ScaffoldGeneratorLike {
+	return ScaffoldGeneratorClass().ScaffoldGenerator()
+}
+
+func SyntaxClass() SyntaxClassLike {
+	return gen.SyntaxClass()
+}
+
+func Syntax(
+	notice string,
+	rules com.Sequential[gen.RuleLike],
+	tokens com.Sequential[string],
+) SyntaxLike {
+	return SyntaxClass().Syntax(
+		notice,
+		rules,
+		tokens,
+	)
+}
+
+func TermClass() TermClassLike {
+	return gen.TermClass()
+}
+
+func Term(
+	text string,
+	cardinality gen.Cardinality,
+) TermLike {
+	return TermClass().Term(
+		text,
+		cardinality,
+	)
+}
+
 // Grammar
 
+func CompositeProcessorClass() CompositeProcessorClassLike {
+	return gra.CompositeProcessorClass()
+}
+
+func CompositeProcessor(
+	processors com.Sequential[Methodical],
+) CompositeProcessorLike {
+	return CompositeProcessorClass().CompositeProcessor(
+		processors,
+	)
+}
+
+func ConcreteSyntaxClass() ConcreteSyntaxClassLike {
+	return gra.ConcreteSyntaxClass()
+}
+
+func ConcreteSyntax(
+	source string,
+) ConcreteSyntaxLike {
+	return ConcreteSyntaxClass().ConcreteSyntax(
+		source,
+	)
+}
+
+func ConcreteTokenClass() ConcreteTokenClassLike {
+	return gra.ConcreteTokenClass()
+}
+
+func ConcreteToken(
+	leading string,
+	token TokenLike,
+	trailing string,
+) ConcreteTokenLike {
+	return ConcreteTokenClass().ConcreteToken(
+		leading,
+		token,
+		trailing,
+	)
+}
+
+func DecoderClass() DecoderClassLike {
+	return gra.DecoderClass()
+}
+
+func Decoder() DecoderLike {
+	return DecoderClass().Decoder()
+}
+
+func EncoderClass() EncoderClassLike {
+	return gra.EncoderClass()
+}
+
+func Encoder() EncoderLike {
+	return EncoderClass().Encoder()
+}
+
 func FormatterClass() FormatterClassLike {
 	return gra.FormatterClass()
 }
@@ -1165,6 +1599,28 @@ func Formatter() FormatterLike {
 	return FormatterClass().Formatter()
 }
 
+func FormatterWithRendering(
+	rendering RenderingFunction,
+) FormatterLike {
+	return FormatterClass().FormatterWithRendering(
+		rendering,
+	)
+}
+
+func InspectorClass() InspectorClassLike {
+	return gra.InspectorClass()
+}
+
+func Inspector(
+	preprocess InspectionFunction,
+	postprocess InspectionFunction,
+) InspectorLike {
+	return InspectorClass().Inspector(
+		preprocess,
+		postprocess,
+	)
+}
+
 func ParserClass() ParserClassLike {
 	return gra.ParserClass()
 }
@@ -1213,6 +1669,18 @@ func Token(
 	)
 }
 
+func TransformerClass() TransformerClassLike {
+	return gra.TransformerClass()
+}
+
+func Transformer(
+	transformation gra.TransformationFunction,
+) TransformerLike {
+	return TransformerClass().Transformer(
+		transformation,
+	)
+}
+
 func ValidatorClass() ValidatorClassLike {
 	return gra.ValidatorClass()
 }
@@ -1233,8 +1701,206 @@ func Visitor(
 	)
 }
 
+// Lint
+
+func ClassGetterRuleClass() ClassGetterRuleClassLike {
+	return lin.ClassGetterRuleClass()
+}
+
+func ClassGetterRule() ClassGetterRuleLike {
+	return ClassGetterRuleClass().ClassGetterRule()
+}
+
+func ConfigurationClass() ConfigurationClassLike {
+	return lin.ConfigurationClass()
+}
+
+func Configuration() ConfigurationLike {
+	return ConfigurationClass().Configuration()
+}
+
+func ConfigurationFromSource(
+	source string,
+) ConfigurationLike {
+	return ConfigurationClass().ConfigurationFromSource(
+		source,
+	)
+}
+
+func DeclarationCommentRuleClass() DeclarationCommentRuleClassLike {
+	return lin.DeclarationCommentRuleClass()
+}
+
+func DeclarationCommentRule() DeclarationCommentRuleLike {
+	return DeclarationCommentRuleClass().DeclarationCommentRule()
+}
+
+func DeclarationOrderRuleClass() DeclarationOrderRuleClassLike {
+	return lin.DeclarationOrderRuleClass()
+}
+
+func DeclarationOrderRule() DeclarationOrderRuleLike {
+	return DeclarationOrderRuleClass().DeclarationOrderRule()
+}
+
+func DiagnosticClass() DiagnosticClassLike {
+	return lin.DiagnosticClass()
+}
+
+func Diagnostic(
+	identifier string,
+	severity lin.Severity,
+	comment string,
+	message string,
+	optionalEdit lin.EditFunction,
+) DiagnosticLike {
+	return DiagnosticClass().Diagnostic(
+		identifier,
+		severity,
+		comment,
+		message,
+		optionalEdit,
+	)
+}
+
+func FunctionCommentRuleClass() FunctionCommentRuleClassLike {
+	return lin.FunctionCommentRuleClass()
+}
+
+func FunctionCommentRule() FunctionCommentRuleLike {
+	return FunctionCommentRuleClass().FunctionCommentRule()
+}
+
+func ImportAliasRuleClass() ImportAliasRuleClassLike {
+	return lin.ImportAliasRuleClass()
+}
+
+func ImportAliasRule() ImportAliasRuleLike {
+	return ImportAliasRuleClass().ImportAliasRule()
+}
+
+func ImportCollectorClass() ImportCollectorClassLike {
+	return lin.ImportCollectorClass()
+}
+
+func ImportCollector() ImportCollectorLike {
+	return ImportCollectorClass().ImportCollector()
+}
+
+func LinterClass() LinterClassLike {
+	return lin.LinterClass()
+}
+
+func Linter(
+	registry lin.RegistryLike,
+	configuration lin.ConfigurationLike,
+) LinterLike {
+	return LinterClass().Linter(
+		registry,
+		configuration,
+	)
+}
+
+func PackageHeaderRuleClass() PackageHeaderRuleClassLike {
+	return lin.PackageHeaderRuleClass()
+}
+
+func PackageHeaderRule() PackageHeaderRuleLike {
+	return PackageHeaderRuleClass().PackageHeaderRule()
+}
+
+func RegistryClass() RegistryClassLike {
+	return lin.RegistryClass()
+}
+
+func Registry() RegistryLike {
+	return RegistryClass().Registry()
+}
+
+func UnusedImportRuleClass() UnusedImportRuleClassLike {
+	return lin.UnusedImportRuleClass()
+}
+
+func UnusedImportRule() UnusedImportRuleLike {
+	return UnusedImportRuleClass().UnusedImportRule()
+}
+
+// Refactor
+
+func EditorClass() EditorClassLike {
+	return ref.EditorClass()
+}
+
+func Editor() EditorLike {
+	return EditorClass().Editor()
+}
+
+func LicenserClass() LicenserClassLike {
+	return ref.LicenserClass()
+}
+
+func Licenser(
+	template string,
+	year uint,
+) LicenserLike {
+	return LicenserClass().Licenser(
+		template,
+		year,
+	)
+}
+
+func RenamerClass() RenamerClassLike {
+	return ref.RenamerClass()
+}
+
+func Renamer(
+	oldName string,
+	newName string,
+) RenamerLike {
+	return RenamerClass().Renamer(
+		oldName,
+		newName,
+	)
+}
+
 // GLOBAL FUNCTIONS
 
+func DecodeModel(
+	source string,
+) ModelLike {
+	var decoder = Decoder()
+	return decoder.DecodeModel(source)
+}
+
+func EncodeModel(
+	model ModelLike,
+) string {
+	var encoder = Encoder()
+	return encoder.EncodeModel(model)
+}
+
+func FindAll[T any](
+	model ModelLike,
+) com.Sequential[T] {
+	var nodes = com.List[T]()
+	Inspect(model, func(node any, depth uint) bool {
+		var match, ok = node.(T)
+		if ok {
+			nodes.AppendValue(match)
+		}
+		return true
+	})
+	return nodes
+}
+
+func FixModel(
+	model ModelLike,
+	configuration ConfigurationLike,
+) ModelLike {
+	var linter = Linter(Registry(), configuration)
+	return linter.FixModel(model)
+}
+
 func FormatModel(
 	model ModelLike,
 ) string {
@@ -1242,6 +1908,70 @@ func FormatModel(
 	return formatter.FormatModel(model)
 }
 
+func GenerateFakes(
+	model ModelLike,
+) string {
+	var generator = FakeGenerator()
+	return generator.GenerateFakes(model)
+}
+
+func GenerateHTML(
+	model ModelLike,
+) string {
+	var generator = DocumentGenerator()
+	return generator.GenerateHTML(model)
+}
+
+func GenerateMarkdown(
+	model ModelLike,
+) string {
+	var generator = DocumentGenerator()
+	return generator.GenerateMarkdown(model)
+}
+
+func GenerateMermaid(
+	model ModelLike,
+) string {
+	var generator = DiagramGenerator()
+	return generator.GenerateMermaid(model)
+}
+
+func GeneratePlantUML(
+	model ModelLike,
+) string {
+	var generator = DiagramGenerator()
+	return generator.GeneratePlantUML(model)
+}
+
+func Inspect(
+	model ModelLike,
+	inspect func(node any, depth uint) bool,
+) {
+	// The nodes are inspected in the order in which the visitor visits them.
+	var depth uint
+	var visitor VisitorLike
+	var preprocess = func(node any, name string) {
+		var _, token = node.(string)
+		if !inspect(node, depth) && !token {
+			visitor.SkipChildren()
+		}
+		depth++
+	}
+	var postprocess = func(node any, name string) {
+		depth--
+	}
+	visitor = Visitor(Inspector(preprocess, postprocess))
+	visitor.VisitModel(model)
+}
+
+func LintModel(
+	model ModelLike,
+	configuration ConfigurationLike,
+) com.Sequential[DiagnosticLike] {
+	var linter = Linter(Registry(), configuration)
+	return linter.LintModel(model)
+}
+
 func MatchesType(
 	tokenValue string,
 	tokenType TokenType,
@@ -1250,6 +1980,12 @@ func MatchesType(
 	return scannerClass.MatchesType(tokenValue, tokenType)
 }
 
+func ParseConcrete(
+	source string,
+) ConcreteSyntaxLike {
+	return ConcreteSyntax(source)
+}
+
 func ParseSource(
 	source string,
 ) ModelLike {
@@ -1257,6 +1993,30 @@ func ParseSource(
 	return parser.ParseSource(source)
 }
 
+func ReadSyntax(
+	source string,
+) SyntaxLike {
+	var reader = Reader()
+	return reader.ReadSyntax(source)
+}
+
+func RenameModel(
+	model ModelLike,
+	oldName string,
+	newName string,
+) ModelLike {
+	var renamer = Renamer(oldName, newName)
+	return renamer.RenameModel(model)
+}
+
+func SelectNodes(
+	model ModelLike,
+	expression string,
+) com.Sequential[any] {
+	var selector = Selector(expression)
+	return selector.SelectNodes(model)
+}
+
 func ValidateModel(
 	model ModelLike,
 ) {
@@ -15,6 +15,7 @@ package module_test
 import (
 	fmt "fmt"
 	mod "github.com/craterdog/go-class-model/v8"
+	com "github.com/craterdog/go-essential-composites/v8"
 	uti "github.com/craterdog/go-essential-utilities/v8"
 	ass "github.com/stretchr/testify/assert"
 	sts "strings"
@@ -22,8 +23,12 @@ import (
 )
 
 var modelFiles = []string{
+	"./analysis/package_api.go",
 	"./ast/package_api.go",
+	"./generator/package_api.go",
 	"./grammar/package_api.go",
+	"./lint/package_api.go",
+	"./refactor/package_api.go",
 	"./test/package_api.go",
 }
 
@@ -41,3 +46,1035 @@ func TestRoundTrips(t *tes.T) {
 	}
 	fmt.Println("Done.")
 }
+
+func TestJSONRoundTrips(t *tes.T) {
+	for _, modelFile := range modelFiles {
+		var source = uti.ReadFile(modelFile)
+		var json = mod.EncodeModel(mod.ParseSource(source))
+		var model = mod.DecodeModel(json)
+		ass.Equal(t, json, mod.EncodeModel(model))
+		var actual = mod.FormatModel(model)
+		source = sts.ReplaceAll(source, "\t", "    ")
+		actual = sts.ReplaceAll(actual, "\t", "    ")
+		ass.Equal(t, source, actual)
+	}
+}
+
+func TestAspectEmbeddings(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+	var embeddings = "Searchable[V any] interface {\n\tAccessible[V]\n\tSequential[V]\n"
+
+	// An aspect that embeds itself forms a cycle.
+	var cycle = sts.Replace(
+		source,
+		embeddings,
+		"Searchable[V any] interface {\n\tSearchable[V]\n",
+		1,
+	)
+	ass.PanicsWithValue(
+		t,
+		"The aspect Searchable has an embedding cycle: Searchable -> Searchable",
+		func() {
+			mod.ValidateModel(mod.ParseSource(cycle))
+		},
+	)
+
+	// An aspect may not redeclare an embedded method with a new signature.
+	var conflict = sts.Replace(
+		source,
+		embeddings,
+		embeddings+"\tGetSize() Ordinal\n",
+		1,
+	)
+	ass.PanicsWithValue(
+		t,
+		"The aspect Searchable has conflicting signatures for method GetSize: GetSize() Cardinal and GetSize() Ordinal",
+		func() {
+			mod.ValidateModel(mod.ParseSource(conflict))
+		},
+	)
+}
+
+func TestMethodSignatures(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+
+	// Only the last parameter may be variadic.
+	var variadic = sts.Replace(
+		source,
+		"\t\tarrays ...ArrayLike[V],\n",
+		"\t\tarrays ...ArrayLike[V],\n\t\tsize Cardinal,\n",
+		1,
+	)
+	ass.PanicsWithValue(
+		t,
+		"The \"...\" wrapper may only be used on the last parameter of a method or function: ...ArrayLike[V]",
+		func() {
+			mod.ValidateModel(mod.ParseSource(variadic))
+		},
+	)
+
+	// The names of multiple results must be unique.
+	var duplicate = sts.Replace(
+		source,
+		"\t\tx float64,\n\t\ty float64,\n",
+		"\t\tx float64,\n\t\tx float64,\n",
+		1,
+	)
+	ass.PanicsWithValue(
+		t,
+		"The result name x is already used by a parameter or result of: GetParts",
+		func() {
+			mod.ValidateModel(mod.ParseSource(duplicate))
+		},
+	)
+
+	// Method signatures may be formatted inline with each fragment rendered.
+	var functional = mod.ParseSource(source).GetPrimitiveDeclarations().
+		GetFunctionalSection().GetFunctionalDeclarations().GetIterator().
+		GetNext().GetFunctional()
+	var abstraction = mod.Abstraction(
+		nil,
+		mod.Type(functional),
+	)
+	ass.Equal(
+		t,
+		"func(first V, second V) Rank",
+		mod.Formatter().FormatAbstraction(abstraction),
+	)
+	var rendering = func(fragment string, name string) string {
+		switch name {
+		case "Named":
+			return "<" + fragment + ">"
+		case "Parameter":
+			return ""
+		default:
+			return fragment
+		}
+	}
+	ass.Equal(
+		t,
+		"func(<V>, <V>) <Rank>",
+		mod.FormatterWithRendering(rendering).FormatAbstraction(abstraction),
+	)
+}
+
+func TestConstantValues(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+
+	// String constants may be concatenated and contain escaped quotes.
+	var concatenated = sts.Replace(
+		source,
+		"CurrentVersion Version = \"8.5\"\n",
+		"CurrentVersion Version = \"8.5\" + \"-\\\"beta\\\"\"\n",
+		1,
+	)
+	var model = mod.ParseSource(concatenated)
+	mod.ValidateModel(model)
+	ass.Contains(
+		t,
+		mod.FormatModel(model),
+		"\tCurrentVersion Version = \"8.5\" + \"-\\\"beta\\\"\"\n",
+	)
+
+	// Only concatenation is allowed on string constants.
+	var subtracted = sts.Replace(concatenated, "\"8.5\" +", "\"8.5\" -", 1)
+	ass.PanicsWithValue(
+		t,
+		"The only operator allowed on the string constant CurrentVersion is: +",
+		func() {
+			mod.ValidateModel(mod.ParseSource(subtracted))
+		},
+	)
+
+	// The operands of a constant must match its intrinsic type.
+	var mixed = sts.Replace(
+		source,
+		"DefaultLimit Limit = 1024\n",
+		"DefaultLimit Limit = 1024 + \"x\"\n",
+		1,
+	)
+	ass.PanicsWithValue(
+		t,
+		"The value \"x\" of constant DefaultLimit is not compatible with its type: Limit",
+		func() {
+			mod.ValidateModel(mod.ParseSource(mixed))
+		},
+	)
+
+	// Negative numbers are only compatible with signed types.
+	var negative = sts.Replace(
+		source,
+		"DefaultLimit Limit = 1024\n",
+		"DefaultLimit Limit = -1\n",
+		1,
+	)
+	ass.PanicsWithValue(
+		t,
+		"The value -1 of constant DefaultLimit is not compatible with its type: Limit",
+		func() {
+			mod.ValidateModel(mod.ParseSource(negative))
+		},
+	)
+	var signed = sts.Replace(negative, "type Limit uint32\n", "type Limit int32\n", 1)
+	model = mod.ParseSource(signed)
+	mod.ValidateModel(model)
+	ass.Contains(t, mod.FormatModel(model), "\tDefaultLimit Limit = -1\n")
+
+	// Boolean constants are rejected since there are no boolean literals.
+	var boolean = sts.Replace(source, "type Limit uint32\n", "type Limit bool\n", 1)
+	ass.PanicsWithValue(
+		t,
+		"The constant DefaultLimit cannot have the boolean type Limit since there are no boolean literals.",
+		func() {
+			mod.ValidateModel(mod.ParseSource(boolean))
+		},
+	)
+}
+
+func TestMethodNotes(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+	source = sts.ReplaceAll(source, "\t", "    ")
+	for _, replacement := range [][2]string{
+		{"    Angle(\n", "    // Angle returns a new angle.\n    Angle(\n"},
+		{"    Pi() AngleLike\n", "    // Pi returns the angle π.\n    Pi() AngleLike\n"},
+		{"    Sine(\n", "    // Sine returns the sine of the angle.\n    Sine(\n"},
+		{"    IsZero() bool\n", "    // IsZero is true for a zero angle.\n    IsZero() bool\n"},
+		{"    GetSize() Cardinal\n", "    // GetSize returns the size.\n    GetSize() Cardinal\n"},
+		{"    SetSlot(\n", "    // SetSlot moves the iterator\n    // to the specified slot.\n    SetSlot(\n"},
+		{"    AsNormalized() AngleLike\n", "    // AsNormalized is an aspect method.\n    AsNormalized() AngleLike\n"},
+	} {
+		ass.Contains(t, source, replacement[0])
+		source = sts.Replace(source, replacement[0], replacement[1], 1)
+	}
+	var model = mod.ParseSource(source)
+	mod.ValidateModel(model)
+	var actual = sts.ReplaceAll(mod.FormatModel(model), "\t", "    ")
+	ass.Equal(t, source, actual)
+	model = mod.DecodeModel(mod.EncodeModel(model))
+	actual = sts.ReplaceAll(mod.FormatModel(model), "\t", "    ")
+	ass.Equal(t, source, actual)
+	var setters = mod.FindAll[mod.SetterMethodLike](model).AsArray()
+	ass.Equal(t, "", setters[0].GetOptionalNote())
+	ass.Equal(t, "// SetSlot moves the iterator\n    // to the specified slot.", setters[1].GetOptionalNote())
+
+	// Continued note lines may also be indented with tabs.
+	ass.True(t, mod.MatchesType("// SetSlot moves the iterator\n\t// to the slot.", mod.NoteToken))
+	ass.True(t, mod.MatchesType("// SetSlot moves the iterator\n \t // to the slot.", mod.NoteToken))
+}
+
+func TestLintRules(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+	source = sts.Replace(source, "\treg \"regexp\"\n", "\tre \"regexp\"\n", 1)
+	var model = mod.ParseSource(source)
+
+	// The import rules share the imported packages and their references.
+	var collector = mod.ImportCollector()
+	mod.Visitor(collector).VisitModel(model)
+	ass.Equal(t, uint(1), collector.GetImportedPackages().GetSize())
+	ass.True(t, collector.IsReferenced("reg"))
+	ass.False(t, collector.IsReferenced("re"))
+	ass.Contains(t, collector.GetComment(), "Package \"example\" provides...")
+
+	// The built-in rules use their default severities and options.
+	var diagnostics = mod.LintModel(model, mod.Configuration())
+	ass.Equal(t, uint(2), diagnostics.GetSize())
+	ass.Equal(
+		t,
+		"error[import-alias]: An imported package name must be exactly 3 characters long: re",
+		diagnostics.AsArray()[0].AsString(),
+	)
+	ass.Equal(
+		t,
+		"warning[unused-import]: An imported package is not referenced by the class model: re",
+		diagnostics.AsArray()[1].AsString(),
+	)
+
+	// A configuration may change the severity of a rule.
+	var configuration = mod.ConfigurationFromSource(`
+rules:
+  import-alias:
+    severity: warning
+`)
+	diagnostics = mod.LintModel(model, configuration)
+	ass.Equal(t, uint(2), diagnostics.GetSize())
+	ass.Equal(t, mod.WarningSeverity, diagnostics.AsArray()[0].GetSeverity())
+
+	// A configuration may turn a rule off.
+	configuration = mod.Configuration()
+	configuration.SetSeverity("import-alias", mod.OffSeverity)
+	diagnostics = mod.LintModel(model, configuration)
+	ass.Equal(t, uint(1), diagnostics.GetSize())
+	ass.Equal(t, "unused-import", diagnostics.AsArray()[0].GetIdentifier())
+
+	// A rule may be suppressed by a marker in its governing comment.
+	var suppressed = sts.Replace(
+		source,
+		"\n*/\npackage example\n",
+		"\ngcmn:ignore import-alias unused-import\n*/\npackage example\n",
+		1,
+	)
+	ass.True(t, mod.LintModel(mod.ParseSource(suppressed), mod.Configuration()).IsEmpty())
+
+	// The documented class functions must match the declared class functions.
+	var undocumented = sts.Replace(
+		suppressed,
+		"\nCosine() returns",
+		"\nCosecant() returns",
+		1,
+	)
+	diagnostics = mod.LintModel(mod.ParseSource(undocumented), mod.Configuration())
+	ass.Equal(t, uint(2), diagnostics.GetSize())
+	ass.Equal(
+		t,
+		"warning[function-comment]: The comment for a class documents a function that it does not declare: Cosecant",
+		diagnostics.AsArray()[0].AsString(),
+	)
+	ass.Equal(
+		t,
+		"warning[function-comment]: The comment for a class does not document a function that it declares: Cosine",
+		diagnostics.AsArray()[1].AsString(),
+	)
+
+	// The marker preceding the documented class functions is configurable.
+	var unmarked = sts.ReplaceAll(
+		suppressed,
+		"The following class functions are supported:",
+		"The following functions are supported:",
+	)
+	ass.True(t, mod.LintModel(mod.ParseSource(unmarked), mod.Configuration()).IsEmpty())
+	configuration = mod.Configuration()
+	configuration.SetOption("function-comment", "marker", "Functions:")
+	diagnostics = mod.LintModel(mod.ParseSource(unmarked), configuration)
+	ass.Equal(t, uint(6), diagnostics.GetSize())
+	configuration.SetOption("function-comment", "marker", "(")
+	ass.PanicsWithValue(
+		t,
+		"The \"function-comment\" lint rule failed: The \"marker\" option for the function-comment rule must be a regular expression: (",
+		func() {
+			mod.LintModel(mod.ParseSource(unmarked), configuration)
+		},
+	)
+
+	// The package header comment must name the package and contain the
+	// required lines, and it can be regenerated from its description.
+	var header = sts.Replace(
+		suppressed,
+		"Package \"example\" provides...",
+		"Package \"sample\" provides...",
+		1,
+	)
+	header = sts.Replace(
+		header,
+		"This package follows the Crater Dog Technologies™ Go Coding Conventions located\nhere:\n",
+		"",
+		1,
+	)
+	model = mod.ParseSource(header)
+	diagnostics = mod.LintModel(model, mod.Configuration())
+	ass.Equal(t, uint(2), diagnostics.GetSize())
+	ass.Equal(
+		t,
+		"warning[package-header]: The package header comment must begin with: Package \"example\"",
+		diagnostics.AsArray()[0].AsString(),
+	)
+	var expected = mod.ParseSource(suppressed).GetPackageDeclaration().GetPackageHeader().GetComment()
+	model = mod.PackageHeaderRule().FixModel(model)
+	ass.Equal(t, expected, model.GetPackageDeclaration().GetPackageHeader().GetComment())
+	ass.True(t, mod.LintModel(model, mod.Configuration()).IsEmpty())
+
+	// The warning banner of a generated package header comment is preserved.
+	model = mod.ParseSource(uti.ReadFile("./ast/package_api.go"))
+	ass.True(t, mod.LintModel(model, mod.Configuration()).IsEmpty())
+	expected = model.GetPackageDeclaration().GetPackageHeader().GetComment()
+	model = mod.PackageHeaderRule().FixModel(model)
+	ass.Equal(t, expected, model.GetPackageDeclaration().GetPackageHeader().GetComment())
+
+	// A missing wiki link is replaced by a placeholder.
+	header = sts.Replace(
+		suppressed,
+		"For detailed documentation on this package refer to the wiki:\n  - https://github.com/craterdog/go-package-example/wiki\n",
+		"",
+		1,
+	)
+	model = mod.PackageHeaderRule().FixModel(mod.ParseSource(header))
+	ass.Contains(
+		t,
+		model.GetPackageDeclaration().GetPackageHeader().GetComment(),
+		"\n  - https://github.com/<organization>/<repository>/wiki\n",
+	)
+}
+
+func TestFixModel(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+	var model = mod.ParseSource(source)
+	var expected = mod.FormatModel(model)
+	var configuration = mod.Configuration()
+	configuration.SetSeverity("package-header", mod.OffSeverity)
+
+	// An unused import with the wrong alias length is renamed and removed.
+	var imported = sts.Replace(
+		source,
+		"\treg \"regexp\"\n",
+		"\tjson \"encoding/json\"\n\treg \"regexp\"\n",
+		1,
+	)
+	model = mod.ParseSource(imported)
+	var diagnostics = mod.LintModel(model, configuration)
+	ass.Equal(t, uint(2), diagnostics.GetSize())
+	var iterator = diagnostics.GetIterator()
+	for iterator.HasNext() {
+		ass.NotNil(t, iterator.GetNext().GetOptionalEdit())
+	}
+	var renamed = diagnostics.AsArray()[0].GetOptionalEdit()(model)
+	ass.Contains(t, mod.FormatModel(renamed), "\tjso \"encoding/json\"\n")
+	var fixed = mod.FixModel(model, configuration)
+	ass.Equal(t, expected, mod.FormatModel(fixed))
+	ass.Contains(t, mod.FormatModel(model), "\tjson \"encoding/json\"\n")
+
+	// A missing GetClass() principal method is inserted.
+	var getterless = sts.Replace(
+		source,
+		"\tGetClass() CatalogClassLike[V]\n",
+		"",
+		1,
+	)
+	model = mod.ParseSource(getterless)
+	diagnostics = mod.LintModel(model, configuration)
+	ass.Equal(t, uint(1), diagnostics.GetSize())
+	ass.Equal(
+		t,
+		"warning[class-getter]: An instance declaration must declare a GetClass() principal method: CatalogLike",
+		diagnostics.AsArray()[0].AsString(),
+	)
+	ass.Equal(t, expected, mod.FormatModel(mod.FixModel(model, configuration)))
+
+	// Declarations that are out of order are sorted.
+	model = mod.ParseSource(source)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var classSection = interfaceDeclarations.GetClassSection()
+	var classDeclarations = com.List[mod.ClassDeclarationLike]()
+	var classes = classSection.GetClassDeclarations().GetIterator()
+	for classes.HasNext() {
+		classDeclarations.InsertValue(0, classes.GetNext())
+	}
+	model = mod.Model(
+		model.GetPackageDeclaration(),
+		model.GetPrimitiveDeclarations(),
+		mod.InterfaceDeclarations(
+			mod.ClassSection(classSection.GetDelimiter(), classDeclarations),
+			interfaceDeclarations.GetInstanceSection(),
+			interfaceDeclarations.GetAspectSection(),
+		),
+	)
+	diagnostics = mod.LintModel(model, configuration)
+	ass.False(t, diagnostics.IsEmpty())
+	ass.Equal(t, "declaration-order", diagnostics.AsArray()[0].GetIdentifier())
+	ass.Equal(t, expected, mod.FormatModel(mod.FixModel(model, configuration)))
+}
+
+func TestDiagrams(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./generator/package_api.go"))
+
+	var mermaid = mod.GenerateMermaid(model)
+	ass.True(t, sts.HasPrefix(mermaid, "classDiagram\n"))
+	ass.Contains(t, mermaid, "        +Rule(name string, definition string, alternatives com.Sequential~string~, terms com.Sequential~TermLike~) RuleLike$\n")
+	ass.Contains(t, mermaid, "    DiagramGenerator ..|> gra_Methodical\n")
+	ass.Contains(t, mermaid, "    Rule --> Term\n")
+
+	var plantUML = mod.GeneratePlantUML(model)
+	ass.True(t, sts.HasPrefix(plantUML, "@startuml\n"))
+	ass.Contains(t, plantUML, "enum Cardinality {\n")
+	ass.Contains(t, plantUML, "  +cardinality Cardinality\n")
+	ass.Contains(t, plantUML, "Term --> Cardinality\n")
+
+	// A getter named just "Get" is shown as a "get" attribute.
+	var getter = sts.Replace(
+		uti.ReadFile("./generator/package_api.go"),
+		"\tGetCardinality() Cardinality\n",
+		"\tGet() Cardinality\n",
+		1,
+	)
+	plantUML = mod.GeneratePlantUML(mod.ParseSource(getter))
+	ass.Contains(t, plantUML, "  +get Cardinality\n")
+}
+
+func TestDocuments(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./generator/package_api.go"))
+
+	var markdown = mod.GenerateMarkdown(model)
+	ass.True(t, sts.HasPrefix(markdown, "# Package generator\n"))
+	ass.Contains(t, markdown, "\n## Type Declarations\n")
+	ass.Contains(t, markdown, "\n### RuleLike\n")
+	ass.Contains(t, markdown, "- GetTerms() com.Sequential\\[[TermLike](#termlike)\\]\n")
+
+	var html = mod.GenerateHTML(model)
+	ass.True(t, sts.HasPrefix(html, "<!DOCTYPE html>\n"))
+	ass.Contains(t, html, "<h3 id=\"RuleLike\">RuleLike</h3>\n")
+	ass.Contains(t, html, "<li><code>GetCardinality() <a href=\"#Cardinality\">Cardinality</a></code></li>\n")
+}
+
+func TestScaffolds(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./lint/package_api.go"))
+	var scaffolds = mod.ScaffoldGenerator().GenerateScaffolds(model)
+	ass.Equal(
+		t,
+		[]string{
+			"Configuration_test.go",
+			"Diagnostic_test.go",
+			"ImportCollector_test.go",
+			"Linter_test.go",
+			"PackageHeaderRule_test.go",
+			"Registry_test.go",
+		},
+		scaffolds.GetKeys().AsArray(),
+	)
+	var scaffold = scaffolds.GetValue("Diagnostic_test.go")
+	ass.Contains(t, scaffold, "\nfunc TestDiagnosticIsSuppressed(t *tes.T) {\n")
+	ass.Contains(t, scaffold, "\t\t\tass.Equal(t, test.severity, instance.GetSeverity())\n")
+	ass.Contains(t, scaffold, "\nfunc TestDiagnosticRequiredAttributes(t *tes.T) {\n")
+	ass.Contains(t, scaffold, "\"The \\\"message\\\" attribute is required by this class.\"")
+	ass.Contains(
+		t,
+		scaffold,
+		"\t\t\tlabel:        \"undefined message\",\n\t\t\tidentifier:   \"identifier\",\n\t\t\tcomment:      \"comment\",\n",
+	)
+	ass.NotContains(t, scaffold, "TODO: Define")
+	scaffold = scaffolds.GetValue("Linter_test.go")
+	ass.Contains(t, scaffold, "\t\texpected      com.Sequential[DiagnosticLike]\n")
+	ass.Contains(t, scaffold, "\tcom \"github.com/craterdog/go-essential-composites/v8\"\n")
+}
+
+func TestFakes(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./lint/package_api.go"))
+	var fakes = mod.GenerateFakes(model)
+	ass.Contains(t, fakes, "\ntype FakeConfiguration struct {\n")
+	ass.Contains(t, fakes, "\nvar _ ConfigurationLike = (*FakeConfiguration)(nil)\n")
+	ass.Contains(t, fakes, "\tGetSeveritySeverity  Severity\n\tGetSeverityFound     bool\n")
+	ass.Contains(t, fakes, "\treturn v.GetSeveritySeverity, v.GetSeverityFound\n")
+
+	model = mod.ParseSource(uti.ReadFile("./grammar/package_api.go"))
+	fakes = mod.GenerateFakes(model)
+	ass.Contains(t, fakes, "\ntype FakeMethodical struct {\n")
+	ass.Contains(t, fakes, "\nfunc (v *FakeProcessor) ProcessComment(comment string) {\n")
+}
+
+func TestSelector(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var nodes = mod.SelectNodes(
+		model,
+		"ClassMethods ConstructorMethod[ParameterList/Parameter/Abstraction/Wrapper=Map]",
+	)
+	ass.Equal(t, 1, int(nodes.GetSize()))
+	var constructor = nodes.AsArray()[0].(mod.ConstructorMethodLike)
+	ass.Equal(t, "CatalogFromMap", constructor.GetName())
+
+	nodes = mod.SelectNodes(model, "InstanceMethods > AttributeSubsection SetterMethod > name")
+	ass.Equal(t, []any{"SetValue", "SetSlot"}, nodes.AsArray())
+	nodes = mod.SelectNodes(model, "AspectDeclaration Method[name=SetValue]")
+	ass.Equal(t, 2, int(nodes.GetSize()))
+	ass.Panics(t, func() {
+		mod.Selector("Declaration > > name")
+	})
+}
+
+func TestRenamer(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var original = mod.FormatModel(model)
+	var renamed = mod.RenameModel(model, "Angle", "Heading")
+	var formatted = mod.FormatModel(renamed)
+	ass.True(t, original == mod.FormatModel(model))
+	ass.NotContains(t, formatted, "Angle")
+	ass.Contains(t, formatted, "\nHeadingClassLike is a class interface")
+	ass.Contains(t, formatted, "\n\tHeadingFromSource(\n")
+	ass.Contains(t, formatted, "\n\tGetClass() HeadingClassLike\n")
+	ass.Contains(t, formatted, "\t\tangle HeadingLike,\n")
+	mod.ValidateModel(renamed)
+	ass.Panics(t, func() {
+		mod.RenameModel(model, "Angle", "Catalog")
+	})
+	ass.Panics(t, func() {
+		mod.RenameModel(model, "Missing", "Present")
+	})
+
+	// A constructor in another class that shares the old name is left alone.
+	var source = sts.Replace(
+		uti.ReadFile("./test/package_api.go"),
+		"\tCatalogFromArray(",
+		"\tAngleFromArray(",
+		1,
+	)
+	model = mod.ParseSource(source)
+	formatted = mod.FormatModel(mod.RenameModel(model, "Angle", "Heading"))
+	ass.Contains(t, formatted, "\n\tHeadingFromSource(\n")
+	ass.Contains(t, formatted, "\n\tAngleFromArray(\n")
+}
+
+func TestLicenser(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var notice = model.GetPackageDeclaration().GetLegalNotice().GetComment()
+	var template = sts.ReplaceAll(notice, "2009-2026", "<year>")
+	ass.True(t, mod.Licenser(template, 2026).CheckNotice(model))
+	var licenser = mod.Licenser(template, 2027)
+	ass.False(t, licenser.CheckNotice(model))
+	var updated = licenser.UpdateNotice(model)
+	ass.True(t, licenser.CheckNotice(updated))
+	ass.Equal(
+		t,
+		sts.ReplaceAll(notice, "2009-2026", "2009-2027"),
+		updated.GetPackageDeclaration().GetLegalNotice().GetComment(),
+	)
+	ass.Equal(t, notice, model.GetPackageDeclaration().GetLegalNotice().GetComment())
+	licenser = mod.Licenser("/*\n(C) <year> Example.\n*/", 2027)
+	updated = licenser.UpdateNotice(model)
+	ass.Equal(
+		t,
+		"/*\n(C) 2027 Example.\n*/\n",
+		updated.GetPackageDeclaration().GetLegalNotice().GetComment(),
+	)
+	var formatted = mod.FormatModel(updated)
+	ass.True(t, sts.HasPrefix(formatted, "/*\n(C) 2027 Example.\n*/\n"))
+}
+
+func TestEditor(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var original = mod.FormatModel(model)
+	var editor = mod.Editor()
+	var float = mod.Abstraction(nil, mod.Type(mod.Named("", "float64", nil)))
+	var edited = editor.WithAddedPrincipalMethod(
+		model,
+		"Angle",
+		mod.Method("", "GetDegrees", "(", nil, ")", mod.Result(float)),
+	)
+	edited = editor.WithAddedAttributeMethod(
+		edited,
+		"Angle",
+		mod.AttributeMethod(mod.GetterMethod("", "GetRadians", "(", ")", float)),
+	)
+	edited = editor.WithAddedImportedPackage(
+		edited,
+		mod.ImportedPackage("mat", "\"math\""),
+	)
+	mod.ValidateModel(edited)
+	ass.True(t, original == mod.FormatModel(model))
+	ass.Equal(t, model.GetPrimitiveDeclarations(), edited.GetPrimitiveDeclarations())
+	var formatted = mod.FormatModel(edited)
+	ass.Contains(t, formatted, "\tIsZero() bool\n\tGetDegrees() float64\n\n")
+	ass.Contains(t, formatted, "\t// Attribute Methods\n\tGetRadians() float64\n\n\t// Aspect Interfaces\n\tAngular\n")
+	ass.Contains(t, formatted, "\tmat \"math\"\n\treg \"regexp\"\n")
+	ass.Panics(t, func() {
+		editor.WithAddedPrincipalMethod(
+			model,
+			"Angle",
+			mod.Method("", "IsZero", "(", nil, ")", mod.Result(float)),
+		)
+	})
+	ass.Panics(t, func() {
+		editor.WithAddedPrincipalMethod(
+			model,
+			"Missing",
+			mod.Method("", "IsZero", "(", nil, ")", mod.Result(float)),
+		)
+	})
+	ass.Panics(t, func() {
+		var angular = mod.Abstraction(nil, mod.Type(mod.Named("", "Angular", nil)))
+		editor.WithAddedAspectInterface(model, "Angle", mod.AspectInterface(angular))
+	})
+
+	// A declaration whose name extends another is ordered after it.
+	var instances = model.GetInterfaceDeclarations().GetInstanceSection()
+	var angle = instances.GetInstanceDeclarations().AsArray()[0]
+	var declaration = angle.GetDeclaration()
+	var item = mod.InstanceDeclaration(
+		mod.Declaration(
+			sts.ReplaceAll(declaration.GetComment(), "AngleLike", "AngleItemLike"),
+			declaration.GetDelimiter(),
+			"AngleItemLike",
+			nil,
+		),
+		angle.GetDelimiter1(),
+		angle.GetDelimiter2(),
+		angle.GetInstanceMethods(),
+		angle.GetDelimiter3(),
+	)
+	edited = editor.WithAddedInstanceDeclaration(model, item)
+	formatted = mod.FormatModel(edited)
+	ass.Less(t, sts.Index(formatted, "\ntype AngleLike "), sts.Index(formatted, "\ntype AngleItemLike "))
+	var diagnostics = mod.LintModel(edited, mod.Configuration()).GetIterator()
+	for diagnostics.HasNext() {
+		ass.NotEqual(t, "declaration-order", diagnostics.GetNext().GetIdentifier())
+	}
+}
+
+func TestVisitorControl(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var visitor mod.VisitorLike
+	var names []string
+	var parameters, postprocessed int
+	var preprocess = func(node any, name string) {
+		switch name {
+		case "ClassSection":
+			visitor.SkipChildren()
+		case "Declaration":
+			var declaration = node.(mod.DeclarationLike)
+			names = append(names, declaration.GetName())
+			if declaration.GetName() == "AngleLike" {
+				visitor.StopVisiting()
+			}
+		case "Parameter":
+			parameters++
+		}
+	}
+	var postprocess = func(node any, name string) {
+		if name == "ClassSection" || name == "Model" {
+			postprocessed++
+		}
+	}
+	visitor = mod.Visitor(mod.Inspector(preprocess, postprocess))
+	visitor.VisitModel(model)
+	ass.Equal(t, []string{"RankingFunction", "AngleLike"}, names[len(names)-2:])
+	ass.NotContains(t, names, "AngleClassLike")
+	ass.Equal(t, 2, parameters)
+	ass.Equal(t, 1, postprocessed)
+
+	// Each traversal starts afresh.
+	var count = len(names)
+	visitor.VisitModel(model)
+	ass.Equal(t, 2*count, len(names))
+}
+
+func TestVisitorAncestors(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var visitor mod.VisitorLike
+	var setters, constructors int
+	var preprocess = func(node any, name string) {
+		if name != "Parameter" {
+			return
+		}
+		var ancestors = visitor.GetAncestors().AsArray()
+		ass.Equal(t, model, ancestors[0])
+		switch ancestors[len(ancestors)-1].(type) {
+		case mod.SetterMethodLike:
+			setters++
+		case mod.ParameterListLike:
+			var _, ok = ancestors[len(ancestors)-2].(mod.ConstructorMethodLike)
+			if ok {
+				constructors++
+			}
+		}
+	}
+	var postprocess = func(node any, name string) {}
+	visitor = mod.Visitor(mod.Inspector(preprocess, postprocess))
+	visitor.VisitModel(model)
+	ass.Equal(t, 2, setters)
+	ass.Equal(t, 11, constructors)
+	ass.True(t, visitor.GetAncestors().IsEmpty())
+}
+
+func TestCompositeProcessor(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var first, last []string
+	var record = func(names *[]string) mod.InspectionFunction {
+		return func(node any, name string) {
+			*names = append(*names, name)
+		}
+	}
+	var ignore = func(node any, name string) {}
+	var fail = func(node any, name string) {
+		if name == "Parameter" {
+			panic("The parameter could not be processed.")
+		}
+	}
+	var composite = mod.CompositeProcessor(
+		com.ListFromArray([]mod.Methodical{
+			mod.Inspector(record(&first), ignore),
+			mod.Inspector(fail, ignore),
+			mod.Inspector(record(&last), ignore),
+		}),
+	)
+	mod.Visitor(composite).VisitModel(model)
+	ass.Equal(t, "Model", first[0])
+	ass.Equal(t, first, last)
+	var failures = composite.GetFailures()
+	ass.Equal(t, []uint{2}, failures.GetKeys().AsArray())
+	ass.Equal(t, "The parameter could not be processed.", failures.GetValue(2))
+
+	// A processor that fails with an empty message is still disabled.
+	var calls int
+	var silent = func(node any, name string) {
+		calls++
+		panic("")
+	}
+	composite = mod.CompositeProcessor(
+		com.ListFromArray([]mod.Methodical{mod.Inspector(silent, ignore)}),
+	)
+	mod.Visitor(composite).VisitModel(model)
+	ass.Equal(t, 1, calls)
+	ass.Equal(t, []uint{1}, composite.GetFailures().GetKeys().AsArray())
+	ass.Equal(t, "The processor failed with: \"\"", composite.GetFailures().GetValue(1))
+}
+
+func TestConcreteSyntax(t *tes.T) {
+	var source = uti.ReadFile("./test/package_api.go")
+	source = sts.Replace(source, "\treg \"regexp\"", "\treg   \"regexp\"  ", 1)
+	source = sts.Replace(source, "// INSTANCE DECLARATIONS\n", "// INSTANCE DECLARATIONS\n\n", 1)
+	var concrete = mod.ParseConcrete(source)
+	var text string
+	var tokens = concrete.GetTokens().GetIterator()
+	for tokens.HasNext() {
+		var token = tokens.GetNext()
+		text += token.GetLeading() + token.GetToken().GetValue() + token.GetTrailing()
+	}
+	ass.Equal(t, source, text)
+	var model = concrete.GetModel()
+	ass.Equal(t, source, concrete.FormatModel(model))
+	var float = mod.Abstraction(nil, mod.Type(mod.Named("", "float64", nil)))
+	var edited = mod.Editor().WithAddedPrincipalMethod(
+		model,
+		"Angle",
+		mod.Method("", "GetDegrees", "(", nil, ")", mod.Result(float)),
+	)
+	var result = concrete.FormatModel(edited)
+	ass.Contains(t, result, "\tIsZero() bool\n\tGetDegrees() float64\n\n")
+	ass.Contains(t, result, "\treg   \"regexp\"  \n")
+	ass.Contains(t, result, "// INSTANCE DECLARATIONS\n\n\n")
+	ass.Equal(t, mod.FormatModel(edited), mod.FormatModel(mod.ParseSource(result)))
+
+	// The layout between two separate edits is preserved as well.
+	source = sts.Replace(source, "// FUNCTIONAL DECLARATIONS\n", "// FUNCTIONAL DECLARATIONS\n\n", 1)
+	concrete = mod.ParseConcrete(source)
+	model = concrete.GetModel()
+	edited = mod.Editor().WithAddedImportedPackage(model, mod.ImportedPackage("fmt", "\"fmt\""))
+	var boolean = mod.Abstraction(nil, mod.Type(mod.Named("", "bool", nil)))
+	edited = mod.Editor().WithAddedPrincipalMethod(
+		edited,
+		"Iterator",
+		mod.Method("", "IsFull", "(", nil, ")", mod.Result(boolean)),
+	)
+	result = concrete.FormatModel(edited)
+	ass.Contains(t, result, "\tfmt \"fmt\"\n")
+	ass.Contains(t, result, "\tGetNext() V\n\tIsFull() bool\n\n")
+	ass.Contains(t, result, "\treg   \"regexp\"  \n")
+	ass.Contains(t, result, "// FUNCTIONAL DECLARATIONS\n\n\n")
+	ass.Contains(t, result, "// INSTANCE DECLARATIONS\n\n\n")
+	ass.Equal(t, mod.FormatModel(edited), mod.FormatModel(mod.ParseSource(result)))
+
+	// A source with CR/LF line endings is reproduced exactly.
+	var crlf = sts.ReplaceAll(uti.ReadFile("./test/package_api.go"), "\n", "\r\n")
+	concrete = mod.ParseConcrete(crlf)
+	model = concrete.GetModel()
+	ass.Equal(t, crlf, concrete.FormatModel(model))
+	edited = mod.Editor().WithAddedPrincipalMethod(
+		model,
+		"Iterator",
+		mod.Method("", "IsFull", "(", nil, ")", mod.Result(boolean)),
+	)
+	result = concrete.FormatModel(edited)
+	ass.Contains(t, result, "\tGetNext() V\r\n\tIsFull() bool\r\n\r\n")
+	ass.NotContains(t, sts.ReplaceAll(result, "\r\n", ""), "\n")
+	ass.Equal(t, mod.FormatModel(edited), mod.FormatModel(mod.ParseSource(result)))
+}
+
+func TestInspect(t *tes.T) {
+	var model = mod.ParseSource(uti.ReadFile("./test/package_api.go"))
+	var constructors = mod.FindAll[mod.ConstructorMethodLike](model).AsArray()
+	ass.Equal(t, "Angle", constructors[0].GetName())
+	ass.True(t, mod.FindAll[mod.GetterMethodLike](model).GetSize() > 0)
+	var methods, getters int
+	mod.Inspect(model, func(node any, depth uint) bool {
+		switch node.(type) {
+		case mod.ModelLike:
+			ass.Equal(t, uint(0), depth)
+		case mod.ConstructorMethodLike:
+			methods++
+		case mod.GetterMethodLike:
+			getters++
+		case mod.InstanceSectionLike:
+			return false
+		}
+		return true
+	})
+	ass.Equal(t, len(constructors), methods)
+	ass.Equal(t, 0, getters)
+}
+
+func TestImporter(t *tes.T) {
+	var importer = mod.Importer()
+	var model = importer.ImportPackage("./generator")
+	ass.Equal(t, uti.ReadFile("./generator/package_api.go"), mod.FormatModel(model))
+	ass.True(t, importer.GetUnmapped().IsEmpty())
+
+	var directory = t.TempDir()
+	uti.WriteFile(directory+"/store.go", `// Package store provides a key value store.
+package store
+
+import "io"
+
+type Kind int
+
+const (
+	StringKind Kind = iota
+	NumberKind
+)
+
+type Config struct{}
+
+type Store interface {
+	io.Closer
+	Get(key string) ([]byte, error)
+	GetSize() int
+	Fixed() [4]byte
+}
+
+func NewStore() Store { return nil }
+`)
+	model = importer.ImportPackage(directory)
+	var source = mod.FormatModel(model)
+	ass.Contains(t, source, "\tiox \"io\"\n")
+	ass.Contains(t, source, "\tStringKind Kind = iota\n")
+	ass.Contains(t, source, "\t// Constructor Methods\n\tStore() StoreLike\n")
+	ass.Contains(t, source, "\t// Attribute Methods\n\tGetSize() int\n")
+	ass.Contains(t, source, "\t// Aspect Interfaces\n\tiox.Closer\n")
+	ass.Equal(
+		t,
+		[]string{
+			"Config: Structured types cannot be modeled.",
+			"StoreLike.Fixed: Fixed length arrays cannot be modeled.",
+		},
+		importer.GetUnmapped().AsArray(),
+	)
+
+	// Commands, external tests and excluded files are ignored, and a package
+	// without any classes cannot be modeled.
+	directory = t.TempDir()
+	uti.WriteFile(directory+"/kind.go", `// Package kind provides kinds.
+package kind
+
+type Kind int
+
+const (
+	StringKind Kind = iota
+	NumberKind
+)
+`)
+	uti.WriteFile(directory+"/generate.go", `//go:build ignore
+
+package main
+
+func main() {}
+`)
+	uti.WriteFile(directory+"/example.go", `package kind_test
+`)
+	model = importer.ImportPackage(directory)
+	ass.Nil(t, model)
+	ass.Equal(
+		t,
+		[]string{
+			"kind: The package does not declare any interfaces that can be modeled as classes.",
+		},
+		importer.GetUnmapped().AsArray(),
+	)
+}
+
+func TestConformance(t *tes.T) {
+	var checker = mod.ConformanceChecker()
+	ass.True(t, checker.CheckConformance("./lint").IsEmpty())
+
+	var directory = t.TempDir()
+	uti.WriteFile(directory+"/package_api.go", `/*
+Copyright
+*/
+
+/*
+Package "counter" provides a counter.
+*/
+package counter
+
+import (
+)
+
+// TYPE DECLARATIONS
+
+// FUNCTIONAL DECLARATIONS
+
+// CLASS DECLARATIONS
+
+/*
+CounterClassLike is a class interface.
+*/
+type CounterClassLike interface {
+	// Constructor Methods
+	Counter() CounterLike
+}
+
+// INSTANCE DECLARATIONS
+
+/*
+CounterLike is an instance interface.
+*/
+type CounterLike interface {
+	// Principal Methods
+	GetClass() CounterClassLike
+	Increment() uint
+	Reset()
+}
+
+// ASPECT DECLARATIONS
+`)
+	uti.WriteFile(directory+"/Counter.go", `package counter
+
+func NewCounter() CounterLike { return &counter_{} }
+
+type counter_ struct{ count int }
+
+func (v *counter_) GetClass() CounterClassLike { return nil }
+
+func (v *counter_) Increment() int { v.count++; return v.count }
+
+func (v *counter_) Decrement() int { v.count--; return v.count }
+`)
+	ass.Equal(
+		t,
+		[]string{
+			"The CounterClass() access function for the CounterClassLike interface is missing.",
+			"The counterClass_ type implementing the CounterClassLike interface is missing.",
+			"The counter_.Increment() method has a mismatched signature: expected () uint but found () int",
+			"The CounterLike.Reset() method is not implemented by the counter_ type.",
+			"The exported counter_.Decrement() method is not declared by the CounterLike interface.",
+			"The exported NewCounter symbol is not declared by the class model.",
+		},
+		checker.CheckConformance(directory).AsArray(),
+	)
+}
+
+func TestGrammarDrift(t *tes.T) {
+	var moduleName = "github.com/craterdog/go-class-model/v8"
+	var syntax = mod.ReadSyntax(uti.ReadFile("./syntax.cdsn"))
+	var generator = mod.Generator()
+	var generated = map[string]string{
+		"./grammar/CompositeProcessor.go": generator.GenerateCompositeProcessor(
+			moduleName,
+			syntax,
+		),
+		"./grammar/Decoder.go":   generator.GenerateDecoder(moduleName, syntax),
+		"./grammar/Encoder.go":   generator.GenerateEncoder(moduleName, syntax),
+		"./grammar/Inspector.go": generator.GenerateInspector(moduleName, syntax),
+		"./grammar/Parser.go":    generator.GenerateParser(moduleName, syntax),
+		"./grammar/Processor.go": generator.GenerateProcessor(moduleName, syntax),
+		"./grammar/Transformer.go": generator.GenerateTransformer(
+			moduleName,
+			syntax,
+		),
+		"./grammar/Visitor.go": generator.GenerateVisitor(moduleName, syntax),
+		"./model.schema.json":  generator.GenerateSchema(moduleName, syntax),
+	}
+	for filename, expected := range generated {
+		var actual = uti.ReadFile(filename)
+		ass.Equal(
+			t,
+			expected,
+			actual,
+			"%s has drifted from syntax.cdsn, run: go run ./cmd/gcmn generate",
+			filename,
+		)
+	}
+}
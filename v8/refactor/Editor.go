@@ -0,0 +1,626 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package refactor
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func EditorClass() EditorClassLike {
+	return editorClass()
+}
+
+// Constructor Methods
+
+func (c *editorClass_) Editor() EditorLike {
+	var instance = &editor_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *editor_) GetClass() EditorClassLike {
+	return editorClass()
+}
+
+func (v *editor_) WithAddedImportedPackage(
+	model ast.ModelLike,
+	importedPackage ast.ImportedPackageLike,
+) ast.ModelLike {
+	var packageDeclaration = model.GetPackageDeclaration()
+	var packageImports = packageDeclaration.GetPackageImports()
+	var importedPackages = com.List[ast.ImportedPackageLike]()
+	var importList = packageImports.GetOptionalImportList()
+	if uti.IsDefined(importList) {
+		importedPackages = com.ListFromSequence(importList.GetImportedPackages())
+	}
+	var slot uint
+	var iterator = importedPackages.GetIterator()
+	for iterator.HasNext() {
+		var existing = iterator.GetNext()
+		if existing.GetName() == importedPackage.GetName() {
+			var message = fmt.Sprintf(
+				"The model already imports a package named \"%s\".",
+				importedPackage.GetName(),
+			)
+			panic(message)
+		}
+		if existing.GetString() < importedPackage.GetString() {
+			slot++
+		}
+	}
+	importedPackages.InsertValue(slot, importedPackage)
+	packageImports = ast.PackageImportsClass().PackageImports(
+		packageImports.GetDelimiter1(),
+		packageImports.GetDelimiter2(),
+		ast.ImportListClass().ImportList(importedPackages),
+		packageImports.GetDelimiter3(),
+	)
+	packageDeclaration = ast.PackageDeclarationClass().PackageDeclaration(
+		packageDeclaration.GetLegalNotice(),
+		packageDeclaration.GetPackageHeader(),
+		packageImports,
+	)
+	return ast.ModelClass().Model(
+		packageDeclaration,
+		model.GetPrimitiveDeclarations(),
+		model.GetInterfaceDeclarations(),
+	)
+}
+
+func (v *editor_) WithAddedTypeDeclaration(
+	model ast.ModelLike,
+	typeDeclaration ast.TypeDeclarationLike,
+) ast.ModelLike {
+	var name = typeDeclaration.GetDeclaration().GetName()
+	v.checkUndeclared(model, name)
+	var primitiveDeclarations = model.GetPrimitiveDeclarations()
+	var typeSection = primitiveDeclarations.GetTypeSection()
+	var typeDeclarations = com.ListFromSequence(typeSection.GetTypeDeclarations())
+	var slot uint
+	var iterator = typeDeclarations.GetIterator()
+	for iterator.HasNext() {
+		if v.precedes(iterator.GetNext().GetDeclaration(), name, "") {
+			slot++
+		}
+	}
+	typeDeclarations.InsertValue(slot, typeDeclaration)
+	typeSection = ast.TypeSectionClass().TypeSection(
+		typeSection.GetDelimiter(),
+		typeDeclarations,
+	)
+	return v.withPrimitiveDeclarations(
+		model,
+		typeSection,
+		primitiveDeclarations.GetFunctionalSection(),
+	)
+}
+
+func (v *editor_) WithAddedFunctionalDeclaration(
+	model ast.ModelLike,
+	functionalDeclaration ast.FunctionalDeclarationLike,
+) ast.ModelLike {
+	var name = functionalDeclaration.GetDeclaration().GetName()
+	v.checkUndeclared(model, name)
+	var primitiveDeclarations = model.GetPrimitiveDeclarations()
+	var functionalSection = primitiveDeclarations.GetFunctionalSection()
+	var functionalDeclarations = com.ListFromSequence(
+		functionalSection.GetFunctionalDeclarations(),
+	)
+	var slot uint
+	var iterator = functionalDeclarations.GetIterator()
+	for iterator.HasNext() {
+		if v.precedes(iterator.GetNext().GetDeclaration(), name, "Function") {
+			slot++
+		}
+	}
+	functionalDeclarations.InsertValue(slot, functionalDeclaration)
+	functionalSection = ast.FunctionalSectionClass().FunctionalSection(
+		functionalSection.GetDelimiter(),
+		functionalDeclarations,
+	)
+	return v.withPrimitiveDeclarations(
+		model,
+		primitiveDeclarations.GetTypeSection(),
+		functionalSection,
+	)
+}
+
+func (v *editor_) WithAddedClassDeclaration(
+	model ast.ModelLike,
+	classDeclaration ast.ClassDeclarationLike,
+) ast.ModelLike {
+	var name = classDeclaration.GetDeclaration().GetName()
+	v.checkUndeclared(model, name)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var classSection = interfaceDeclarations.GetClassSection()
+	var classDeclarations = com.ListFromSequence(classSection.GetClassDeclarations())
+	var slot uint
+	var iterator = classDeclarations.GetIterator()
+	for iterator.HasNext() {
+		if v.precedes(iterator.GetNext().GetDeclaration(), name, "ClassLike") {
+			slot++
+		}
+	}
+	classDeclarations.InsertValue(slot, classDeclaration)
+	classSection = ast.ClassSectionClass().ClassSection(
+		classSection.GetDelimiter(),
+		classDeclarations,
+	)
+	return v.withInterfaceDeclarations(
+		model,
+		classSection,
+		interfaceDeclarations.GetInstanceSection(),
+		interfaceDeclarations.GetAspectSection(),
+	)
+}
+
+func (v *editor_) WithAddedInstanceDeclaration(
+	model ast.ModelLike,
+	instanceDeclaration ast.InstanceDeclarationLike,
+) ast.ModelLike {
+	var name = instanceDeclaration.GetDeclaration().GetName()
+	v.checkUndeclared(model, name)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var instanceSection = interfaceDeclarations.GetInstanceSection()
+	var instanceDeclarations = com.ListFromSequence(
+		instanceSection.GetInstanceDeclarations(),
+	)
+	var slot uint
+	var iterator = instanceDeclarations.GetIterator()
+	for iterator.HasNext() {
+		if v.precedes(iterator.GetNext().GetDeclaration(), name, "Like") {
+			slot++
+		}
+	}
+	instanceDeclarations.InsertValue(slot, instanceDeclaration)
+	instanceSection = ast.InstanceSectionClass().InstanceSection(
+		instanceSection.GetDelimiter(),
+		instanceDeclarations,
+	)
+	return v.withInterfaceDeclarations(
+		model,
+		interfaceDeclarations.GetClassSection(),
+		instanceSection,
+		interfaceDeclarations.GetAspectSection(),
+	)
+}
+
+func (v *editor_) WithAddedAspectDeclaration(
+	model ast.ModelLike,
+	aspectDeclaration ast.AspectDeclarationLike,
+) ast.ModelLike {
+	var name = aspectDeclaration.GetDeclaration().GetName()
+	v.checkUndeclared(model, name)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var aspectSection = interfaceDeclarations.GetAspectSection()
+	var aspectDeclarations = com.ListFromSequence(
+		aspectSection.GetAspectDeclarations(),
+	)
+	var slot uint
+	var iterator = aspectDeclarations.GetIterator()
+	for iterator.HasNext() {
+		if v.precedes(iterator.GetNext().GetDeclaration(), name, "") {
+			slot++
+		}
+	}
+	aspectDeclarations.InsertValue(slot, aspectDeclaration)
+	aspectSection = ast.AspectSectionClass().AspectSection(
+		aspectSection.GetDelimiter(),
+		aspectDeclarations,
+	)
+	return v.withInterfaceDeclarations(
+		model,
+		interfaceDeclarations.GetClassSection(),
+		interfaceDeclarations.GetInstanceSection(),
+		aspectSection,
+	)
+}
+
+func (v *editor_) WithAddedConstructorMethod(
+	model ast.ModelLike,
+	className string,
+	constructorMethod ast.ConstructorMethodLike,
+) ast.ModelLike {
+	var index, classDeclaration = v.getClassDeclaration(model, className)
+	var classMethods = classDeclaration.GetClassMethods()
+	var constructorSubsection = classMethods.GetConstructorSubsection()
+	var constructorMethods = com.ListFromSequence(
+		constructorSubsection.GetConstructorMethods(),
+	)
+	var iterator = constructorMethods.GetIterator()
+	for iterator.HasNext() {
+		v.checkMethod(
+			classDeclaration.GetDeclaration(),
+			iterator.GetNext().GetName(),
+			constructorMethod.GetName(),
+		)
+	}
+	constructorMethods.AppendValue(constructorMethod)
+	classMethods = ast.ClassMethodsClass().ClassMethods(
+		ast.ConstructorSubsectionClass().ConstructorSubsection(
+			constructorSubsection.GetDelimiter(),
+			constructorMethods,
+		),
+		classMethods.GetOptionalConstantSubsection(),
+		classMethods.GetOptionalFunctionSubsection(),
+	)
+	return v.withClassDeclaration(model, index, classDeclaration, classMethods)
+}
+
+func (v *editor_) WithAddedPrincipalMethod(
+	model ast.ModelLike,
+	className string,
+	method ast.MethodLike,
+) ast.ModelLike {
+	var index, instanceDeclaration = v.getInstanceDeclaration(model, className)
+	var instanceMethods = instanceDeclaration.GetInstanceMethods()
+	var principalSubsection = instanceMethods.GetPrincipalSubsection()
+	var principalMethods = com.ListFromSequence(
+		principalSubsection.GetPrincipalMethods(),
+	)
+	var iterator = principalMethods.GetIterator()
+	for iterator.HasNext() {
+		v.checkMethod(
+			instanceDeclaration.GetDeclaration(),
+			iterator.GetNext().GetMethod().GetName(),
+			method.GetName(),
+		)
+	}
+	principalMethods.AppendValue(ast.PrincipalMethodClass().PrincipalMethod(method))
+	instanceMethods = ast.InstanceMethodsClass().InstanceMethods(
+		ast.PrincipalSubsectionClass().PrincipalSubsection(
+			principalSubsection.GetDelimiter(),
+			principalMethods,
+		),
+		instanceMethods.GetOptionalAttributeSubsection(),
+		instanceMethods.GetOptionalAspectSubsection(),
+	)
+	return v.withInstanceDeclaration(model, index, instanceDeclaration, instanceMethods)
+}
+
+func (v *editor_) WithAddedAttributeMethod(
+	model ast.ModelLike,
+	className string,
+	attributeMethod ast.AttributeMethodLike,
+) ast.ModelLike {
+	var index, instanceDeclaration = v.getInstanceDeclaration(model, className)
+	var instanceMethods = instanceDeclaration.GetInstanceMethods()
+	var delimiter = "// Attribute Methods"
+	var attributeMethods = com.List[ast.AttributeMethodLike]()
+	var attributeSubsection = instanceMethods.GetOptionalAttributeSubsection()
+	if uti.IsDefined(attributeSubsection) {
+		delimiter = attributeSubsection.GetDelimiter()
+		attributeMethods = com.ListFromSequence(attributeSubsection.GetAttributeMethods())
+	}
+	var iterator = attributeMethods.GetIterator()
+	for iterator.HasNext() {
+		v.checkMethod(
+			instanceDeclaration.GetDeclaration(),
+			v.getAttributeName(iterator.GetNext()),
+			v.getAttributeName(attributeMethod),
+		)
+	}
+	attributeMethods.AppendValue(attributeMethod)
+	instanceMethods = ast.InstanceMethodsClass().InstanceMethods(
+		instanceMethods.GetPrincipalSubsection(),
+		ast.AttributeSubsectionClass().AttributeSubsection(
+			delimiter,
+			attributeMethods,
+		),
+		instanceMethods.GetOptionalAspectSubsection(),
+	)
+	return v.withInstanceDeclaration(model, index, instanceDeclaration, instanceMethods)
+}
+
+func (v *editor_) WithAddedAspectInterface(
+	model ast.ModelLike,
+	className string,
+	aspectInterface ast.AspectInterfaceLike,
+) ast.ModelLike {
+	var index, instanceDeclaration = v.getInstanceDeclaration(model, className)
+	var instanceMethods = instanceDeclaration.GetInstanceMethods()
+	var delimiter = "// Aspect Interfaces"
+	var aspectInterfaces = com.List[ast.AspectInterfaceLike]()
+	var aspectSubsection = instanceMethods.GetOptionalAspectSubsection()
+	if uti.IsDefined(aspectSubsection) {
+		delimiter = aspectSubsection.GetDelimiter()
+		aspectInterfaces = com.ListFromSequence(aspectSubsection.GetAspectInterfaces())
+	}
+	var formatter = gra.FormatterClass().Formatter()
+	var name = formatter.FormatAbstraction(aspectInterface.GetAbstraction())
+	var iterator = aspectInterfaces.GetIterator()
+	for iterator.HasNext() {
+		if formatter.FormatAbstraction(iterator.GetNext().GetAbstraction()) == name {
+			var message = fmt.Sprintf(
+				"The \"%s\" interface already declares a \"%s\" aspect interface.",
+				instanceDeclaration.GetDeclaration().GetName(),
+				name,
+			)
+			panic(message)
+		}
+	}
+	aspectInterfaces.AppendValue(aspectInterface)
+	instanceMethods = ast.InstanceMethodsClass().InstanceMethods(
+		instanceMethods.GetPrincipalSubsection(),
+		instanceMethods.GetOptionalAttributeSubsection(),
+		ast.AspectSubsectionClass().AspectSubsection(
+			delimiter,
+			aspectInterfaces,
+		),
+	)
+	return v.withInstanceDeclaration(model, index, instanceDeclaration, instanceMethods)
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *editor_) checkMethod(
+	declaration ast.DeclarationLike,
+	existingName string,
+	name string,
+) {
+	if existingName == name {
+		var message = fmt.Sprintf(
+			"The \"%s\" interface already declares a \"%s\" method.",
+			declaration.GetName(),
+			name,
+		)
+		panic(message)
+	}
+}
+
+func (v *editor_) checkUndeclared(
+	model ast.ModelLike,
+	name string,
+) {
+	var declarations = com.List[ast.DeclarationLike]()
+	var primitiveDeclarations = model.GetPrimitiveDeclarations()
+	var types = primitiveDeclarations.GetTypeSection().GetTypeDeclarations().GetIterator()
+	for types.HasNext() {
+		declarations.AppendValue(types.GetNext().GetDeclaration())
+	}
+	var functionals = primitiveDeclarations.GetFunctionalSection().GetFunctionalDeclarations().GetIterator()
+	for functionals.HasNext() {
+		declarations.AppendValue(functionals.GetNext().GetDeclaration())
+	}
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var classes = interfaceDeclarations.GetClassSection().GetClassDeclarations().GetIterator()
+	for classes.HasNext() {
+		declarations.AppendValue(classes.GetNext().GetDeclaration())
+	}
+	var instances = interfaceDeclarations.GetInstanceSection().GetInstanceDeclarations().GetIterator()
+	for instances.HasNext() {
+		declarations.AppendValue(instances.GetNext().GetDeclaration())
+	}
+	var aspects = interfaceDeclarations.GetAspectSection().GetAspectDeclarations().GetIterator()
+	for aspects.HasNext() {
+		declarations.AppendValue(aspects.GetNext().GetDeclaration())
+	}
+	var iterator = declarations.GetIterator()
+	for iterator.HasNext() {
+		if iterator.GetNext().GetName() == name {
+			var message = fmt.Sprintf(
+				"The model already declares \"%s\".",
+				name,
+			)
+			panic(message)
+		}
+	}
+}
+
+func (v *editor_) getAttributeName(
+	attributeMethod ast.AttributeMethodLike,
+) string {
+	var name string
+	switch actual := attributeMethod.GetAny().(type) {
+	case ast.GetterMethodLike:
+		name = actual.GetName()
+	case ast.SetterMethodLike:
+		name = actual.GetName()
+	}
+	return name
+}
+
+func (v *editor_) getClassDeclaration(
+	model ast.ModelLike,
+	className string,
+) (
+	index int,
+	classDeclaration ast.ClassDeclarationLike,
+) {
+	var classSection = model.GetInterfaceDeclarations().GetClassSection()
+	var iterator = classSection.GetClassDeclarations().GetIterator()
+	for iterator.HasNext() {
+		index++
+		classDeclaration = iterator.GetNext()
+		if classDeclaration.GetDeclaration().GetName() == className+"ClassLike" {
+			return
+		}
+	}
+	var message = fmt.Sprintf(
+		"The model does not declare the \"%sClassLike\" interface.",
+		className,
+	)
+	panic(message)
+}
+
+func (v *editor_) getInstanceDeclaration(
+	model ast.ModelLike,
+	className string,
+) (
+	index int,
+	instanceDeclaration ast.InstanceDeclarationLike,
+) {
+	var instanceSection = model.GetInterfaceDeclarations().GetInstanceSection()
+	var iterator = instanceSection.GetInstanceDeclarations().GetIterator()
+	for iterator.HasNext() {
+		index++
+		instanceDeclaration = iterator.GetNext()
+		if instanceDeclaration.GetDeclaration().GetName() == className+"Like" {
+			return
+		}
+	}
+	var message = fmt.Sprintf(
+		"The model does not declare the \"%sLike\" interface.",
+		className,
+	)
+	panic(message)
+}
+
+func (v *editor_) precedes(
+	declaration ast.DeclarationLike,
+	name string,
+	suffix string,
+) bool {
+	// Declarations are ordered the same way the validator orders them.
+	var existing = sts.TrimSuffix(declaration.GetName(), suffix)
+	return existing < sts.TrimSuffix(name, suffix)
+}
+
+func (v *editor_) withClassDeclaration(
+	model ast.ModelLike,
+	index int,
+	classDeclaration ast.ClassDeclarationLike,
+	classMethods ast.ClassMethodsLike,
+) ast.ModelLike {
+	classDeclaration = ast.ClassDeclarationClass().ClassDeclaration(
+		classDeclaration.GetDeclaration(),
+		classDeclaration.GetDelimiter1(),
+		classDeclaration.GetDelimiter2(),
+		classMethods,
+		classDeclaration.GetDelimiter3(),
+	)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var classSection = interfaceDeclarations.GetClassSection()
+	var classDeclarations = com.ListFromSequence(classSection.GetClassDeclarations())
+	classDeclarations.SetValue(index, classDeclaration)
+	classSection = ast.ClassSectionClass().ClassSection(
+		classSection.GetDelimiter(),
+		classDeclarations,
+	)
+	return v.withInterfaceDeclarations(
+		model,
+		classSection,
+		interfaceDeclarations.GetInstanceSection(),
+		interfaceDeclarations.GetAspectSection(),
+	)
+}
+
+func (v *editor_) withInstanceDeclaration(
+	model ast.ModelLike,
+	index int,
+	instanceDeclaration ast.InstanceDeclarationLike,
+	instanceMethods ast.InstanceMethodsLike,
+) ast.ModelLike {
+	instanceDeclaration = ast.InstanceDeclarationClass().InstanceDeclaration(
+		instanceDeclaration.GetDeclaration(),
+		instanceDeclaration.GetDelimiter1(),
+		instanceDeclaration.GetDelimiter2(),
+		instanceMethods,
+		instanceDeclaration.GetDelimiter3(),
+	)
+	var interfaceDeclarations = model.GetInterfaceDeclarations()
+	var instanceSection = interfaceDeclarations.GetInstanceSection()
+	var instanceDeclarations = com.ListFromSequence(
+		instanceSection.GetInstanceDeclarations(),
+	)
+	instanceDeclarations.SetValue(index, instanceDeclaration)
+	instanceSection = ast.InstanceSectionClass().InstanceSection(
+		instanceSection.GetDelimiter(),
+		instanceDeclarations,
+	)
+	return v.withInterfaceDeclarations(
+		model,
+		interfaceDeclarations.GetClassSection(),
+		instanceSection,
+		interfaceDeclarations.GetAspectSection(),
+	)
+}
+
+func (v *editor_) withInterfaceDeclarations(
+	model ast.ModelLike,
+	classSection ast.ClassSectionLike,
+	instanceSection ast.InstanceSectionLike,
+	aspectSection ast.AspectSectionLike,
+) ast.ModelLike {
+	return ast.ModelClass().Model(
+		model.GetPackageDeclaration(),
+		model.GetPrimitiveDeclarations(),
+		ast.InterfaceDeclarationsClass().InterfaceDeclarations(
+			classSection,
+			instanceSection,
+			aspectSection,
+		),
+	)
+}
+
+func (v *editor_) withPrimitiveDeclarations(
+	model ast.ModelLike,
+	typeSection ast.TypeSectionLike,
+	functionalSection ast.FunctionalSectionLike,
+) ast.ModelLike {
+	return ast.ModelClass().Model(
+		model.GetPackageDeclaration(),
+		ast.PrimitiveDeclarationsClass().PrimitiveDeclarations(
+			typeSection,
+			functionalSection,
+		),
+		model.GetInterfaceDeclarations(),
+	)
+}
+
+// Instance Structure
+
+type editor_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type editorClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func editorClass() *editorClass_ {
+	return editorClassReference_
+}
+
+var editorClassReference_ = &editorClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,207 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package refactor
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	reg "regexp"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func LicenserClass() LicenserClassLike {
+	return licenserClass()
+}
+
+// Constructor Methods
+
+func (c *licenserClass_) Licenser(
+	template string,
+	year uint,
+) LicenserLike {
+	if uti.IsUndefined(template) {
+		panic("The \"template\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(year) {
+		panic("The \"year\" attribute is required by this class.")
+	}
+	var instance = &licenser_{
+		// Initialize the instance attributes.
+		template_: sts.TrimRight(template, "\n") + "\n",
+		year_:     year,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *licenser_) GetClass() LicenserClassLike {
+	return licenserClass()
+}
+
+func (v *licenser_) CheckNotice(
+	model ast.ModelLike,
+) bool {
+	var notice = model.GetPackageDeclaration().GetLegalNotice().GetComment()
+	return notice == v.formatNotice(notice)
+}
+
+func (v *licenser_) UpdateNotice(
+	model ast.ModelLike,
+) ast.ModelLike {
+	var packageDeclaration = model.GetPackageDeclaration()
+	var notice = packageDeclaration.GetLegalNotice().GetComment()
+	var expected = v.formatNotice(notice)
+	if notice == expected {
+		return model
+	}
+	packageDeclaration = ast.PackageDeclarationClass().PackageDeclaration(
+		ast.LegalNoticeClass().LegalNotice(expected),
+		packageDeclaration.GetPackageHeader(),
+		packageDeclaration.GetPackageImports(),
+	)
+	return ast.ModelClass().Model(
+		packageDeclaration,
+		model.GetPrimitiveDeclarations(),
+		model.GetInterfaceDeclarations(),
+	)
+}
+
+// Attribute Methods
+
+func (v *licenser_) GetTemplate() string {
+	return v.template_
+}
+
+func (v *licenser_) GetYear() uint {
+	return v.year_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *licenser_) alignLine(
+	line string,
+	width int,
+) string {
+	// Only box lines with a closing border are realigned.
+	var runes = []rune(line)
+	var last = len(runes) - 1
+	var delta = len(runes) - width
+	if delta == 0 || last < 1 || runes[last] == ' ' || runes[last-1] != ' ' {
+		return line
+	}
+	var border = string(runes[last])
+	var content = string(runes[:last])
+	if delta < 0 {
+		return content + sts.Repeat(" ", -delta) + border
+	}
+	var trimmed = sts.TrimRight(content, " ")
+	var padding = len(content) - len(trimmed) - delta
+	if padding < 1 {
+		// The year range does not fit within the border.
+		return line
+	}
+	return trimmed + sts.Repeat(" ", padding) + border
+}
+
+func (v *licenser_) formatNotice(
+	notice string,
+) string {
+	var variable = licenserClass().variable_
+	var years = fmt.Sprintf("%d", v.year_)
+	var start = v.getStart(notice)
+	if uti.IsDefined(start) && start < years {
+		years = start + "-" + years
+	}
+	var lines = sts.Split(v.template_, "\n")
+	for index, line := range lines {
+		if !sts.Contains(line, variable) {
+			continue
+		}
+		var formatted = sts.ReplaceAll(line, variable, years)
+		lines[index] = v.alignLine(formatted, len([]rune(line)))
+	}
+	return sts.Join(lines, "\n")
+}
+
+func (v *licenser_) getStart(
+	notice string,
+) string {
+	// Locate the year range in the notice using the text that precedes the
+	// year variable in the template.
+	var variable = licenserClass().variable_
+	for _, line := range sts.Split(v.template_, "\n") {
+		var prefix, _, found = sts.Cut(line, variable)
+		if !found {
+			continue
+		}
+		prefix = sts.TrimLeftFunc(prefix, func(character rune) bool {
+			return !uni.IsLetter(character) && !uni.IsDigit(character)
+		})
+		var matcher = reg.MustCompile(
+			reg.QuoteMeta(prefix) + licenserClass().years_,
+		)
+		var matches = matcher.FindStringSubmatch(notice)
+		if len(matches) > 1 {
+			return matches[1]
+		}
+	}
+	return ""
+}
+
+// Instance Structure
+
+type licenser_ struct {
+	// Declare the instance attributes.
+	template_ string
+	year_     uint
+}
+
+// Class Structure
+
+type licenserClass_ struct {
+	// Declare the class constants.
+	variable_ string
+	years_    string
+}
+
+// Class Reference
+
+func licenserClass() *licenserClass_ {
+	return licenserClassReference_
+}
+
+var licenserClassReference_ = &licenserClass_{
+	// Initialize the class constants.
+	variable_: "<year>",
+	years_:    `([0-9]{4})(?:-[0-9]{4})?`,
+}
@@ -0,0 +1,262 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│              This class file was automatically generated using:              │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│ Updates to any section other than the Principal Methods may be overwritten.  │
+└──────────────────────────────────────────────────────────────────────────────┘
+*/
+
+package refactor
+
+import (
+	fmt "fmt"
+	ast "github.com/craterdog/go-class-model/v8/ast"
+	gra "github.com/craterdog/go-class-model/v8/grammar"
+	com "github.com/craterdog/go-essential-composites/v8"
+	uti "github.com/craterdog/go-essential-utilities/v8"
+	reg "regexp"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RenamerClass() RenamerClassLike {
+	return renamerClass()
+}
+
+// Constructor Methods
+
+func (c *renamerClass_) Renamer(
+	oldName string,
+	newName string,
+) RenamerLike {
+	if uti.IsUndefined(oldName) {
+		panic("The \"oldName\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(newName) {
+		panic("The \"newName\" attribute is required by this class.")
+	}
+	var instance = &renamer_{
+		// Initialize the instance attributes.
+		oldName_: oldName,
+		newName_: newName,
+	}
+	return instance
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *renamer_) GetClass() RenamerClassLike {
+	return renamerClass()
+}
+
+func (v *renamer_) RenameModel(
+	model ast.ModelLike,
+) ast.ModelLike {
+	v.checkNames(model)
+	var transformer = gra.TransformerClass().Transformer(v.transform)
+	return transformer.TransformModel(model)
+}
+
+// Attribute Methods
+
+func (v *renamer_) GetOldName() string {
+	return v.oldName_
+}
+
+func (v *renamer_) GetNewName() string {
+	return v.newName_
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+func (v *renamer_) checkNames(
+	model ast.ModelLike,
+) {
+	var names = map[string]bool{}
+	var preprocess = func(node any, name string) {
+		if name == "Declaration" {
+			var declaration = node.(ast.DeclarationLike)
+			names[declaration.GetName()] = true
+		}
+	}
+	var postprocess = func(node any, name string) {}
+	var inspector = gra.InspectorClass().Inspector(preprocess, postprocess)
+	gra.VisitorClass().Visitor(inspector).VisitModel(model)
+	var found bool
+	for _, suffix := range renamerClass().suffixes_ {
+		found = found || names[v.oldName_+suffix]
+		if names[v.newName_+suffix] {
+			var message = fmt.Sprintf(
+				"The new name \"%s\" collides with an existing declaration.",
+				v.newName_+suffix,
+			)
+			panic(message)
+		}
+	}
+	if !found {
+		var message = fmt.Sprintf(
+			"No declaration named after \"%s\" exists in the model.",
+			v.oldName_,
+		)
+		panic(message)
+	}
+}
+
+func (v *renamer_) renameComment(
+	comment string,
+) string {
+	var oldName = reg.QuoteMeta(v.oldName_)
+	var matcher = reg.MustCompile(`\b` + oldName + `(ClassLike|Like)?\b`)
+	comment = matcher.ReplaceAllString(comment, v.newName_+"${1}")
+	var oldKind = reg.QuoteMeta(uti.MakeSnakeCase(v.oldName_))
+	matcher = reg.MustCompile(`\b` + oldKind + `-like\b`)
+	return matcher.ReplaceAllString(comment, uti.MakeSnakeCase(v.newName_)+"-like")
+}
+
+func (v *renamer_) renameConstructor(
+	name string,
+) string {
+	if name == v.oldName_ {
+		return v.newName_
+	}
+	var suffix, found = sts.CutPrefix(name, v.oldName_)
+	if found && uni.IsUpper([]rune(suffix)[0]) {
+		// Constructors such as "AngleFromString" share the base name.
+		return v.newName_ + suffix
+	}
+	return name
+}
+
+func (v *renamer_) renameConstructors(
+	classMethods ast.ClassMethodsLike,
+) ast.ClassMethodsLike {
+	var constructorSubsection = classMethods.GetConstructorSubsection()
+	var constructorMethods = com.List[ast.ConstructorMethodLike]()
+	var iterator = constructorSubsection.GetConstructorMethods().GetIterator()
+	for iterator.HasNext() {
+		var constructorMethod = iterator.GetNext()
+		constructorMethod = ast.ConstructorMethodClass().ConstructorMethod(
+			constructorMethod.GetOptionalNote(),
+			v.renameConstructor(constructorMethod.GetName()),
+			constructorMethod.GetDelimiter1(),
+			constructorMethod.GetOptionalParameterList(),
+			constructorMethod.GetDelimiter2(),
+			constructorMethod.GetAbstraction(),
+		)
+		constructorMethods.AppendValue(constructorMethod)
+	}
+	constructorSubsection = ast.ConstructorSubsectionClass().ConstructorSubsection(
+		constructorSubsection.GetDelimiter(),
+		constructorMethods,
+	)
+	return ast.ClassMethodsClass().ClassMethods(
+		constructorSubsection,
+		classMethods.GetOptionalConstantSubsection(),
+		classMethods.GetOptionalFunctionSubsection(),
+	)
+}
+
+func (v *renamer_) renameType(
+	name string,
+) string {
+	for _, suffix := range renamerClass().suffixes_ {
+		if name == v.oldName_+suffix {
+			return v.newName_ + suffix
+		}
+	}
+	return name
+}
+
+func (v *renamer_) transform(
+	node any,
+	name string,
+) any {
+	switch actual := node.(type) {
+	case ast.ClassDeclarationLike:
+		// Only the constructors of the renamed class are renamed.
+		var declaration = actual.GetDeclaration()
+		if declaration.GetName() != v.newName_+"ClassLike" {
+			return actual
+		}
+		return ast.ClassDeclarationClass().ClassDeclaration(
+			declaration,
+			actual.GetDelimiter1(),
+			actual.GetDelimiter2(),
+			v.renameConstructors(actual.GetClassMethods()),
+			actual.GetDelimiter3(),
+		)
+	case ast.DeclarationLike:
+		return ast.DeclarationClass().Declaration(
+			v.renameComment(actual.GetComment()),
+			actual.GetDelimiter(),
+			v.renameType(actual.GetName()),
+			actual.GetOptionalConstraints(),
+		)
+	case ast.MapLike:
+		return ast.MapClass().Map(
+			actual.GetDelimiter1(),
+			actual.GetDelimiter2(),
+			v.renameType(actual.GetName()),
+			actual.GetDelimiter3(),
+		)
+	case ast.NamedLike:
+		var optionalPrefix = actual.GetOptionalPrefix()
+		if uti.IsDefined(optionalPrefix) {
+			return actual
+		}
+		return ast.NamedClass().Named(
+			optionalPrefix,
+			v.renameType(actual.GetName()),
+			actual.GetOptionalArguments(),
+		)
+	default:
+		return actual
+	}
+}
+
+// Instance Structure
+
+type renamer_ struct {
+	// Declare the instance attributes.
+	oldName_ string
+	newName_ string
+}
+
+// Class Structure
+
+type renamerClass_ struct {
+	// Declare the class constants.
+	suffixes_ []string
+}
+
+// Class Reference
+
+func renamerClass() *renamerClass_ {
+	return renamerClassReference_
+}
+
+var renamerClassReference_ = &renamerClass_{
+	// Initialize the class constants.
+	suffixes_: []string{"", "ClassLike", "Like"},
+}
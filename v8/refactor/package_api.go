@@ -0,0 +1,205 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2026 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+┌────────────────────────────────── WARNING ───────────────────────────────────┐
+│         This "package_api.go" file was automatically generated using:        │
+│            https://github.com/craterdog/go-development-tools/wiki            │
+│                                                                              │
+│                     Any updates to it may be overwritten.                    │
+└──────────────────────────────────────────────────────────────────────────────┘
+
+Package "refactor" provides the following classes that transform a class model
+into a new class model:
+  - Editor adds a single declaration, method or interface to a class model by
+    copying only the nodes on the path from the root of the model to the change.
+  - Licenser checks and updates the legal notice of a class model against a
+    template containing a year variable.
+  - Renamer renames a declaration and every identifier that is derived from its
+    name consistently throughout a class model.
+
+For detailed documentation on this package refer to the wiki:
+  - https://github.com/craterdog/go-class-model/wiki
+
+This package follows the Crater Dog Technologies™ Go Coding Conventions located
+here:
+  - https://github.com/craterdog/go-development-tools/wiki/Coding-Conventions
+
+Additional concrete implementations of the classes declared by this package can
+be developed and used seamlessly since the interface declarations only depend on
+other interfaces and intrinsic types—and the class implementations only depend
+on interfaces, not on each other.
+*/
+package refactor
+
+import (
+	ast "github.com/craterdog/go-class-model/v8/ast"
+)
+
+// TYPE DECLARATIONS
+
+// FUNCTIONAL DECLARATIONS
+
+// CLASS DECLARATIONS
+
+/*
+EditorClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+editor-like class.
+*/
+type EditorClassLike interface {
+	// Constructor Methods
+	Editor() EditorLike
+}
+
+/*
+LicenserClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+licenser-like class.
+*/
+type LicenserClassLike interface {
+	// Constructor Methods
+	Licenser(
+		template string,
+		year uint,
+	) LicenserLike
+}
+
+/*
+RenamerClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+renamer-like class.
+*/
+type RenamerClassLike interface {
+	// Constructor Methods
+	Renamer(
+		oldName string,
+		newName string,
+	) RenamerLike
+}
+
+// INSTANCE DECLARATIONS
+
+/*
+EditorLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete editor-like class.
+
+Each method returns a new model containing a single change.  The nodes along
+the path to the change are copied and all other nodes are shared with the
+original model, which is never modified.  New imported packages and
+declarations are inserted in alphabetical order, and new methods and aspect
+interfaces are appended to the end of their subsection.  Class and instance
+declarations are identified by their base name (e.g. "Angle" rather than
+"AngleLike").  A method panics if the change would introduce a duplicate name.
+*/
+type EditorLike interface {
+	// Principal Methods
+	GetClass() EditorClassLike
+	WithAddedImportedPackage(
+		model ast.ModelLike,
+		importedPackage ast.ImportedPackageLike,
+	) ast.ModelLike
+	WithAddedTypeDeclaration(
+		model ast.ModelLike,
+		typeDeclaration ast.TypeDeclarationLike,
+	) ast.ModelLike
+	WithAddedFunctionalDeclaration(
+		model ast.ModelLike,
+		functionalDeclaration ast.FunctionalDeclarationLike,
+	) ast.ModelLike
+	WithAddedClassDeclaration(
+		model ast.ModelLike,
+		classDeclaration ast.ClassDeclarationLike,
+	) ast.ModelLike
+	WithAddedInstanceDeclaration(
+		model ast.ModelLike,
+		instanceDeclaration ast.InstanceDeclarationLike,
+	) ast.ModelLike
+	WithAddedAspectDeclaration(
+		model ast.ModelLike,
+		aspectDeclaration ast.AspectDeclarationLike,
+	) ast.ModelLike
+	WithAddedConstructorMethod(
+		model ast.ModelLike,
+		className string,
+		constructorMethod ast.ConstructorMethodLike,
+	) ast.ModelLike
+	WithAddedPrincipalMethod(
+		model ast.ModelLike,
+		className string,
+		method ast.MethodLike,
+	) ast.ModelLike
+	WithAddedAttributeMethod(
+		model ast.ModelLike,
+		className string,
+		attributeMethod ast.AttributeMethodLike,
+	) ast.ModelLike
+	WithAddedAspectInterface(
+		model ast.ModelLike,
+		className string,
+		aspectInterface ast.AspectInterfaceLike,
+	) ast.ModelLike
+}
+
+/*
+LicenserLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete licenser-like class.
+
+The template is the complete legal notice comment with each occurrence of the
+"<year>" variable standing for the copyright years.  The expected notice keeps
+the start year found in the existing notice and ends with the specified year
+(e.g. "2009-2026"), or contains only the specified year if no start year was
+found.  Any box line whose width changes is realigned by adjusting the padding
+before its closing border.  The original model is never modified.
+*/
+type LicenserLike interface {
+	// Principal Methods
+	GetClass() LicenserClassLike
+	CheckNotice(
+		model ast.ModelLike,
+	) bool
+	UpdateNotice(
+		model ast.ModelLike,
+	) ast.ModelLike
+
+	// Attribute Methods
+	GetTemplate() string
+	GetYear() uint
+}
+
+/*
+RenamerLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete renamer-like class.
+
+The old and new names are base names (e.g. "Angle" rather than "AngleLike").
+The declarations named after the base name, their "ClassLike" and "Like"
+variants, the constructor methods that begin with the base name, every
+unprefixed reference to these names, and the mentions of them in declaration
+comments are all renamed.  The renaming is refused if the new names collide
+with any existing declaration, and the original model is never modified.
+*/
+type RenamerLike interface {
+	// Principal Methods
+	GetClass() RenamerClassLike
+	RenameModel(
+		model ast.ModelLike,
+	) ast.ModelLike
+
+	// Attribute Methods
+	GetOldName() string
+	GetNewName() string
+}
+
+// ASPECT DECLARATIONS
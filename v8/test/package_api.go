@@ -85,6 +85,39 @@ Regexp is a constrained type representing a compiled regular expression.
 */
 type Regexp *reg.Regexp
 
+/*
+Permissions is a constrained type representing a set of bit flags that can be
+combined to grant access to a resource.
+*/
+type Permissions uint8
+
+const (
+	ReadPermission Permissions = 1 << iota
+	WritePermission
+	ExecutePermission
+)
+
+/*
+Version is a constrained type representing the version of a published API.
+*/
+type Version string
+
+const (
+	MinimumVersion Version = "8.0"
+	CurrentVersion Version = "8.5"
+)
+
+/*
+Limit is a constrained type representing the maximum number of values that can
+be stored in a collection.
+*/
+type Limit uint32
+
+const (
+	DefaultLimit Limit = 1024
+	MaximumLimit Limit = 65536
+)
+
 // FUNCTIONAL DECLARATIONS
 
 /*
@@ -416,6 +449,22 @@ type Continuous interface {
 	IsInfinity() bool
 }
 
+/*
+Searchable[V any] is an aspect interface that declares a set of method
+signatures that must be supported by each instance of a searchable concrete
+class.  It embeds the Accessible[V any] and Sequential[V any] interfaces.
+*/
+type Searchable[V any] interface {
+	Accessible[V]
+	Sequential[V]
+	ContainsValue(
+		value V,
+	) bool
+	GetIndex(
+		value V,
+	) Ordinal
+}
+
 /*
 Sequential[V any] is an aspect interface that declares a set of method
 signatures that must be supported by each instance of a sequential concrete